@@ -0,0 +1,40 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"time"
+)
+
+// ConvertorCheckpoint is the key of the last input row whose output a convertor has saved, it allows an interrupted
+// conversion to resume on the same raw data, identified by RawDataVersion, and is removed once the conversion
+// completes
+type ConvertorCheckpoint struct {
+	CreatedAt     time.Time `json:"createdAt"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+	SubtaskName   string    `gorm:"primaryKey;type:varchar(255)" json:"subtaskName"`
+	RawDataParams string    `gorm:"primaryKey;column:raw_data_params;type:varchar(255)" json:"rawDataParams"`
+	LastKey       string    `gorm:"type:varchar(255)" json:"lastKey"`
+	Numeric       bool      `json:"numeric"`
+	// RawDataVersion is the row count and the latest id of the raw data the checkpoint was saved on
+	RawDataVersion string `gorm:"type:varchar(255)" json:"rawDataVersion"`
+}
+
+func (ConvertorCheckpoint) TableName() string {
+	return "_devlake_convertor_checkpoints"
+}
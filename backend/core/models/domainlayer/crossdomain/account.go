@@ -31,6 +31,8 @@ type Account struct {
 	Organization string `gorm:"type:varchar(255)"`
 	CreatedDate  *time.Time
 	Status       int
+	// Active tells whether the account is still active in the tool, null when the tool does not report it
+	Active *bool
 }
 
 func (Account) TableName() string {
@@ -23,8 +23,41 @@ import (
 	plugin "github.com/apache/incubator-devlake/core/plugin"
 	"github.com/apache/incubator-devlake/impls/dalgorm"
 	"reflect"
+	"regexp"
 )
 
+// TenantPrefixEnvStr is the setting namespacing the domain ids of a deployment shared by several tenants, it has
+// to be set before the first collection since the ids already converted are not rewritten
+const TenantPrefixEnvStr = "DOMAIN_ID_TENANT_PREFIX"
+
+var tenantPrefixPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,31}$`)
+
+// tenantPrefix is prepended to every domain id generated, empty leaves ids as they are
+var tenantPrefix string
+
+// Init sets the tenant prefix of the domain ids, made of at most 32 lowercase letters, digits, `-` and `_`
+func Init(prefix string) errors.Error {
+	if prefix != "" && !tenantPrefixPattern.MatchString(prefix) {
+		return errors.BadInput.New(fmt.Sprintf("invalid %s %s, it must match %s", TenantPrefixEnvStr, prefix, tenantPrefixPattern))
+	}
+	tenantPrefix = prefix
+	return nil
+}
+
+// TenantPrefix returns the tenant prefix of the domain ids, it is handed over to remote plugins which generate
+// their ids on their own
+func TenantPrefix() string {
+	return tenantPrefix
+}
+
+// WithTenantPrefix prepends the tenant prefix to a domain id built without a DomainIdGenerator, e.g. by migration scripts
+func WithTenantPrefix(id string) string {
+	if tenantPrefix == "" {
+		return id
+	}
+	return tenantPrefix + ":" + id
+}
+
 type DomainIdGenerator struct {
 	prefix string
 	pk     []reflect.StructField
@@ -59,7 +92,7 @@ func NewDomainIdGenerator(entityPtr interface{}) *DomainIdGenerator {
 	}
 
 	return &DomainIdGenerator{
-		prefix: fmt.Sprintf("%s:%s", pluginName, structName),
+		prefix: WithTenantPrefix(fmt.Sprintf("%s:%s", pluginName, structName)),
 		pk:     pk,
 	}
 }
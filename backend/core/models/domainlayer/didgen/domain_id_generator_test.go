@@ -74,3 +74,22 @@ func TestOriginKeyGenerator(t *testing.T) {
 		g.Generate("asdf")
 	})
 }
+
+func TestTenantPrefix(t *testing.T) {
+	var foo FooPlugin
+	assert.Nil(t, plugin.RegisterPlugin("fooplugin", &foo))
+	defer func() {
+		assert.Nil(t, Init(""))
+	}()
+
+	assert.Nil(t, Init("tenant-a"))
+	assert.Equal(t, "tenant-a:fooplugin:FooModel:2", NewDomainIdGenerator(&FooModel{}).Generate(uint(2)))
+	assert.Equal(t, "tenant-a", TenantPrefix())
+	assert.Equal(t, "tenant-a:jira:JiraBoard:1:2", WithTenantPrefix("jira:JiraBoard:1:2"))
+
+	assert.NotNil(t, Init("Tenant:A"))
+	assert.NotNil(t, Init("-tenant"))
+
+	assert.Nil(t, Init(""))
+	assert.Equal(t, "jira:JiraBoard:1:2", WithTenantPrefix("jira:JiraBoard:1:2"))
+}
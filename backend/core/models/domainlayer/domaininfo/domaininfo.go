@@ -85,5 +85,13 @@ func GetDomainTablesInfo() []dal.Tabler {
 		&ticket.Sprint{},
 		&ticket.SprintIssue{},
 		&ticket.IssueAssignee{},
+		&ticket.TicketProject{},
+		&ticket.BoardMember{},
+		&ticket.IssueParticipant{},
+		&ticket.Version{},
+		&ticket.IssueVersion{},
+		&ticket.IssueEvent{},
+		&ticket.IssueAttribute{},
+		&ticket.IssueRelationship{},
 	}
 }
@@ -33,6 +33,7 @@ type Board struct {
 	Url         string `gorm:"type:varchar(255)"`
 	CreatedDate *time.Time
 	Type        string `gorm:"type:varchar(255)"`
+	ProjectId   string `gorm:"type:varchar(255)"`
 }
 
 func (Board) TableName() string {
@@ -52,12 +52,131 @@ type Issue struct {
 	Severity                string `gorm:"type:varchar(255)"`
 	Component               string `gorm:"type:varchar(255)"`
 	OriginalProject         string `gorm:"type:varchar(255)"`
+	ResolutionDateMismatch  bool
+	TeamId                  *string `gorm:"type:varchar(255)"`
+	AcceptanceCriteria      string
+	HasAcceptanceCriteria   bool
+	Facets                  []string `gorm:"type:json;serializer:json"`
+	ProjectId               string   `gorm:"type:varchar(255)"`
+	// AssigneeSource tells where the assignee was taken from when it may be inferred from other fields
+	AssigneeSource string `gorm:"type:varchar(100)"`
+	BlockedMinutes int64
+	StartDate      *time.Time
+	// Progress is the percentage of done children of epics, null for issues without children
+	Progress *float64
+	// CombinedStatus is the status along with the resolution, like `Done (Won't Do)`, for the plugins supporting it
+	CombinedStatus string `gorm:"type:varchar(255)"`
+	// CycleTimeMinutes is the time from the start of the work to its end, null until the work ended
+	CycleTimeMinutes *int64
+	// SubtaskTodoCount, SubtaskInProgressCount and SubtaskDoneCount count the children of issues by standard
+	// status, null for issues without children
+	SubtaskTodoCount       *int
+	SubtaskInProgressCount *int
+	SubtaskDoneCount       *int
+	// ReassignmentCount is the number of times the issue changed hands after its initial assignment, it is only
+	// a minimum when ReassignmentCountIsMinimum is set because the history of the issue was incomplete
+	ReassignmentCount          int
+	ReassignmentCountIsMinimum bool
+	// CommentCount and LastCommentedDate summarize the comments of the issue, the latter is only the latest of
+	// the comments collected when CommentsTruncated is set
+	CommentCount      int
+	LastCommentedDate *time.Time
+	CommentsTruncated bool
+	// AwaitingConfirmation flags issues waiting for their assignee to confirm a change of their requirement, and
+	// DelayDays is how many days issues are behind their schedule, for the plugins supporting them
+	AwaitingConfirmation bool
+	DelayDays            int
+	// HierarchyLevel is the level of the type of the issue in the portfolio hierarchy, higher levels group lower
+	// ones, e.g. 0 for stories, 1 for epics and 2 for initiatives, null for plugins without hierarchy
+	HierarchyLevel *int
+	// HierarchyDepth is the number of ancestors of the issue following its parents, 0 for top-level issues, and
+	// RootIssueId the top-most of them, the issue itself when top-level. Null and empty for plugins without parents
+	HierarchyDepth *int
+	RootIssueId    string `gorm:"type:varchar(255)"`
+	// AgeDays is how many days the issue has been open, frozen when it got resolved, and StaleDays how many days
+	// an open issue went without any update. Both in calendar days, only Zentao computes them for its tasks so far,
+	// they stay null for the issues of the other plugins
+	AgeDays   *int
+	StaleDays *int
+	// OriginalKey is the key of the issue in the tool when IssueKey was rendered out of a key template, empty otherwise
+	OriginalKey string `gorm:"type:varchar(255)"`
+	// Revision is the version of the issue itself, StoryRevision and DesignRevision the versions of the requirement
+	// and of the design it was built from, for traceability. Null when not versioned
+	Revision       *int
+	StoryRevision  *int
+	DesignRevision *int
+	// TriageMinutes is the time from the creation of the issue until it first left its initial status, null while
+	// it never did. It is only provisional when TriageMinutesIsProvisional is set because the history was incomplete
+	TriageMinutes              *int64
+	TriageMinutesIsProvisional bool
+	// DodCompliant tells whether a done issue meets the definition of done of its plugin, DodViolations listing the
+	// rules it breaks. Both null for issues not done or without definition of done
+	DodCompliant  *bool
+	DodViolations []string `gorm:"type:json;serializer:json"`
+	// Mode and Vision discriminate the variants of issues for the plugins running several of them side by side, like
+	// the linear and multi-person tasks or the R&D and lite editions of Zentao. Null when the plugin has none
+	Mode   *string `gorm:"type:varchar(100)"`
+	Vision *string `gorm:"type:varchar(100)"`
+	// PriorityColor is the color of the priority as a lowercase `#rrggbb`, and PriorityOrder ranks priorities for
+	// sorting, lower first. Null for the plugins without them
+	PriorityColor *string `gorm:"type:varchar(20)"`
+	PriorityOrder *int
+	// BlockingChainDepth is the length of the longest chain of open issues blocking the issue, one blocking the
+	// other, 0 when nothing open blocks it, and BlockedByOpenIssue whether any does. Null for plugins without links
+	BlockingChainDepth *int
+	BlockedByOpenIssue *bool
+	// StdResolution is the standard resolution the resolution of the issue is mapped to by the scope config of its
+	// plugin, null when unmapped
+	StdResolution *string `gorm:"type:varchar(100)"`
+	// AuditAccountId is the account the latest audit run of the plugin found changing the issue, AuditChangeCount
+	// the number of fields it changed within the audit window. Null for the issues not flagged by an audit
+	AuditAccountId   *string `gorm:"type:varchar(255)"`
+	AuditChangeCount *int
+	// DueDate is when the issue is due, null for issues without due or plugins not supporting it
+	DueDate *time.Time
+	// ReworkCount is the number of times the issue went back to an earlier active stage, it is only a minimum when
+	// ReworkCountIsMinimum is set because the history of the issue was incomplete. Null for plugins not tracking it
+	ReworkCount          *int
+	ReworkCountIsMinimum *bool
+	// Categories are single-valued dimensions of the issue by category name, like `team`, read out of conventions
+	// of the plugin like prefixed labels. Null when it has none
+	Categories map[string]string `gorm:"type:json;serializer:json"`
+	// FirstResponseDate is when someone other than the creator of the issue first commented on it, and
+	// FirstResponseMinutes how long after the creation. Both null until then or for plugins not tracking it
+	FirstResponseDate    *time.Time
+	FirstResponseMinutes *int64
+	// PlanDurationDays and RealDurationDays are the planned and the actual durations of the issue in days, and
+	// PlanningAccuracy the real to planned ratio when both are positive. Null for plugins without them
+	PlanDurationDays *int
+	RealDurationDays *int
+	PlanningAccuracy *float64
+	// EscapedDefectCount is the number of defects escaped to production the issue caused, and
+	// EscapedDefectUnattributed tells whether an escaped defect could not be traced back to any source. Null for
+	// plugins not tracking escaped defects, EscapedDefectUnattributed also for issues which aren't escaped defects
+	EscapedDefectCount        *int
+	EscapedDefectUnattributed *bool
+	// RolledUpTimeSpentMinutes is the time logged on the issue and all its descendants following their parents,
+	// each counted once. Null for plugins not rolling up logged time
+	RolledUpTimeSpentMinutes *int64
+	// FlowActiveMinutes and FlowWaitingMinutes are the time the issue spent in active and in waiting statuses, and
+	// FlowEfficiency the ratio of the active time to its lead time, up to now for unresolved issues. They are only
+	// provisional when FlowEfficiencyIsProvisional is set because the history was incomplete. Null for plugins
+	// without them
+	FlowActiveMinutes           *int64
+	FlowWaitingMinutes          *int64
+	FlowEfficiency              *float64
+	FlowEfficiencyIsProvisional *bool
 }
 
 func (Issue) TableName() string {
 	return "issues"
 }
 
+const (
+	FACET_LABEL_PREFIX     = "label:"
+	FACET_COMPONENT_PREFIX = "component:"
+)
+
 const (
 	BUG         = "BUG"
 	REQUIREMENT = "REQUIREMENT"
@@ -0,0 +1,44 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ticket
+
+import (
+	"github.com/apache/incubator-devlake/core/models/common"
+)
+
+const (
+	// types of the attributes of issues, plugins may add their own such as the ids of custom fields
+	ISSUE_ATTRIBUTE_LABEL            = "label"
+	ISSUE_ATTRIBUTE_COMPONENT        = "component"
+	ISSUE_ATTRIBUTE_FIX_VERSION      = "fixVersion"
+	ISSUE_ATTRIBUTE_AFFECTED_VERSION = "affectedVersion"
+)
+
+// IssueAttribute gathers the labels, components, versions and other categorical values of issues into a single
+// table for generic faceting, the dedicated tables like issue_labels remain the source of truth
+type IssueAttribute struct {
+	IssueId       string `gorm:"primaryKey;type:varchar(255)"`
+	AttributeType string `gorm:"primaryKey;type:varchar(100)"`
+	Value         string `gorm:"primaryKey;type:varchar(255)"`
+
+	common.NoPKModel
+}
+
+func (IssueAttribute) TableName() string {
+	return "issue_attributes"
+}
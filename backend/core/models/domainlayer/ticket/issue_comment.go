@@ -30,6 +30,8 @@ type IssueComment struct {
 	AccountId   string `gorm:"type:varchar(255)"`
 	CreatedDate time.Time
 	UpdatedDate *time.Time
+	// IsEdited tells the comment was edited after its creation, null for the plugins not telling
+	IsEdited *bool
 }
 
 func (IssueComment) TableName() string {
@@ -0,0 +1,49 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ticket
+
+import (
+	"time"
+
+	"github.com/apache/incubator-devlake/core/models/domainlayer"
+)
+
+const (
+	// types of issue events
+	EVENT_STATUS_CHANGED   = "STATUS_CHANGED"
+	EVENT_ASSIGNEE_CHANGED = "ASSIGNEE_CHANGED"
+	EVENT_LABEL_CHANGED    = "LABEL_CHANGED"
+	EVENT_COMMENT_ADDED    = "COMMENT_ADDED"
+	EVENT_WORKLOG_ADDED    = "WORKLOG_ADDED"
+)
+
+// IssueEvent is an entry in the timeline of an issue, ordering the events of an issue by EventDate gives its
+// activity feed. Payload is a compact json object whose shape depends on EventType
+type IssueEvent struct {
+	domainlayer.DomainEntity
+	IssueId   string    `gorm:"index;type:varchar(255)"`
+	EventType string    `gorm:"type:varchar(100)"`
+	EventDate time.Time `gorm:"index"`
+	ActorId   string    `gorm:"type:varchar(255)"`
+	ActorName string    `gorm:"type:varchar(255)"`
+	Payload   string
+}
+
+func (IssueEvent) TableName() string {
+	return "issue_events"
+}
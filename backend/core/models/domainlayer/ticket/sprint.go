@@ -38,6 +38,11 @@ type Sprint struct {
 	EndedDate       *time.Time
 	CompletedDate   *time.Time
 	OriginalBoardID string `gorm:"type:varchar(255)"`
+	// the work of the sprint as of its completion, or as of now for sprints not completed yet
+	TotalIssues          int
+	CompletedIssues      int
+	TotalStoryPoints     float64
+	CompletedStoryPoints float64
 }
 
 func (Sprint) TableName() string {
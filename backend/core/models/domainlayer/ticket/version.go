@@ -0,0 +1,60 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ticket
+
+import (
+	"time"
+
+	"github.com/apache/incubator-devlake/core/models/common"
+	"github.com/apache/incubator-devlake/core/models/domainlayer"
+)
+
+const (
+	// types of the versions of issues
+	FIX_VERSION      = "FIX"
+	AFFECTED_VERSION = "AFFECTED"
+)
+
+// Version is a release of a ticket project
+type Version struct {
+	domainlayer.DomainEntity
+	ProjectId   string `gorm:"type:varchar(255)"`
+	Name        string `gorm:"type:varchar(255)"`
+	Description string
+	Archived    bool
+	Released    bool
+	ReleaseDate *time.Time
+}
+
+func (Version) TableName() string {
+	return "versions"
+}
+
+// IssueVersion joins issues to the versions they are fixed in or, for defects, found in, an issue may have several
+// of both
+type IssueVersion struct {
+	IssueId     string `gorm:"primaryKey;type:varchar(255)"`
+	VersionId   string `gorm:"primaryKey;type:varchar(255)"`
+	VersionType string `gorm:"primaryKey;type:varchar(20)"`
+
+	common.NoPKModel
+}
+
+func (IssueVersion) TableName() string {
+	return "issue_versions"
+}
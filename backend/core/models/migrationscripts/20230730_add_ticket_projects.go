@@ -0,0 +1,60 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrationscripts
+
+import (
+	"github.com/apache/incubator-devlake/core/context"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/migrationscripts/archived"
+	"github.com/apache/incubator-devlake/helpers/migrationhelper"
+)
+
+type issue20230730 struct {
+	ProjectId string `gorm:"type:varchar(255)"`
+}
+
+func (issue20230730) TableName() string {
+	return "issues"
+}
+
+type board20230730 struct {
+	ProjectId string `gorm:"type:varchar(255)"`
+}
+
+func (board20230730) TableName() string {
+	return "boards"
+}
+
+type addTicketProjects struct{}
+
+func (script *addTicketProjects) Up(basicRes context.BasicRes) errors.Error {
+	return migrationhelper.AutoMigrateTables(
+		basicRes,
+		&archived.TicketProject{},
+		&issue20230730{},
+		&board20230730{},
+	)
+}
+
+func (*addTicketProjects) Version() uint64 {
+	return 20230730100001
+}
+
+func (*addTicketProjects) Name() string {
+	return "add ticket_projects and project_id to issues and boards"
+}
@@ -0,0 +1,49 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrationscripts
+
+import (
+	"github.com/apache/incubator-devlake/core/context"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/helpers/migrationhelper"
+)
+
+type sprint20230804 struct {
+	TotalIssues          int
+	CompletedIssues      int
+	TotalStoryPoints     float64
+	CompletedStoryPoints float64
+}
+
+func (sprint20230804) TableName() string {
+	return "sprints"
+}
+
+type addSprintReportToSprints struct{}
+
+func (script *addSprintReportToSprints) Up(basicRes context.BasicRes) errors.Error {
+	return migrationhelper.AutoMigrateTables(basicRes, &sprint20230804{})
+}
+
+func (*addSprintReportToSprints) Version() uint64 {
+	return 20230804100001
+}
+
+func (*addSprintReportToSprints) Name() string {
+	return "add total/completed issues and story points to sprints"
+}
@@ -0,0 +1,50 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrationscripts
+
+import (
+	"time"
+
+	"github.com/apache/incubator-devlake/core/context"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/helpers/migrationhelper"
+)
+
+type issue20230809 struct {
+	CommentCount      int
+	LastCommentedDate *time.Time
+	CommentsTruncated bool
+}
+
+func (issue20230809) TableName() string {
+	return "issues"
+}
+
+type addCommentSummaryToIssues struct{}
+
+func (script *addCommentSummaryToIssues) Up(basicRes context.BasicRes) errors.Error {
+	return migrationhelper.AutoMigrateTables(basicRes, &issue20230809{})
+}
+
+func (*addCommentSummaryToIssues) Version() uint64 {
+	return 20230809100001
+}
+
+func (*addCommentSummaryToIssues) Name() string {
+	return "add comment_count, last_commented_date and comments_truncated to issues"
+}
@@ -0,0 +1,49 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrationscripts
+
+import (
+	"github.com/apache/incubator-devlake/core/context"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/helpers/migrationhelper"
+)
+
+type issue20230905 struct {
+	FlowActiveMinutes           *int64
+	FlowWaitingMinutes          *int64
+	FlowEfficiency              *float64
+	FlowEfficiencyIsProvisional *bool
+}
+
+func (issue20230905) TableName() string {
+	return "issues"
+}
+
+type addFlowEfficiencyToIssues struct{}
+
+func (script *addFlowEfficiencyToIssues) Up(basicRes context.BasicRes) errors.Error {
+	return migrationhelper.AutoMigrateTables(basicRes, &issue20230905{})
+}
+
+func (*addFlowEfficiencyToIssues) Version() uint64 {
+	return 20230905100001
+}
+
+func (*addFlowEfficiencyToIssues) Name() string {
+	return "add flow_active_minutes, flow_waiting_minutes, flow_efficiency and flow_efficiency_is_provisional to issues"
+}
@@ -0,0 +1,46 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrationscripts
+
+import (
+	"github.com/apache/incubator-devlake/core/context"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/helpers/migrationhelper"
+)
+
+type convertorCheckpoint20230907 struct {
+	RawDataVersion string `gorm:"type:varchar(255)"`
+}
+
+func (convertorCheckpoint20230907) TableName() string {
+	return "_devlake_convertor_checkpoints"
+}
+
+type addRawDataVersionToConvertorCheckpoints struct{}
+
+func (script *addRawDataVersionToConvertorCheckpoints) Up(basicRes context.BasicRes) errors.Error {
+	return migrationhelper.AutoMigrateTables(basicRes, &convertorCheckpoint20230907{})
+}
+
+func (*addRawDataVersionToConvertorCheckpoints) Version() uint64 {
+	return 20230907100001
+}
+
+func (*addRawDataVersionToConvertorCheckpoints) Name() string {
+	return "add raw_data_version to _devlake_convertor_checkpoints"
+}
@@ -86,5 +86,51 @@ func All() []plugin.MigrationScript {
 		new(modifyPrLabelsAndComments),
 		new(renameFinishedCommitsDiffs),
 		new(addUpdatedDateToIssueComments),
+		new(addResolutionDateMismatchToIssues),
+		new(addTeamIdToIssues),
+		new(addAcceptanceCriteriaToIssues),
+		new(addConvertorCheckpoints),
+		new(addFacetsToIssues),
+		new(addTicketProjects),
+		new(addAssigneeSourceToIssues),
+		new(addBlockedMinutesToIssues),
+		new(addStartDateToIssues),
+		new(addProgressToIssues),
+		new(addSprintReportToSprints),
+		new(addCombinedStatusToIssues),
+		new(addCycleTimeToIssues),
+		new(addSubtaskCountsToIssues),
+		new(addReassignmentCountToIssues),
+		new(addCommentSummaryToIssues),
+		new(addWorkflowSignalsToIssues),
+		new(addHierarchyLevelToIssues),
+		new(addBoardMembers),
+		new(addIssueParticipants),
+		new(addVersions),
+		new(addIssueEvents),
+		new(addAgeToIssues),
+		new(addOriginalKeyToIssues),
+		new(addActiveToAccounts),
+		new(addHierarchyDepthToIssues),
+		new(addRevisionsToIssues),
+		new(addTriageMinutesToIssues),
+		new(addDodComplianceToIssues),
+		new(addModeAndVisionToIssues),
+		new(addIsEditedToIssueComments),
+		new(addPriorityColorAndOrderToIssues),
+		new(addBlockingChainToIssues),
+		new(addStdResolutionToIssues),
+		new(addAuditToIssues),
+		new(addDueDateToIssues),
+		new(addReworkCountToIssues),
+		new(addCategoriesToIssues),
+		new(addFirstResponseToIssues),
+		new(addPlanningAccuracyToIssues),
+		new(addEscapedDefectsToIssues),
+		new(addRolledUpTimeSpentToIssues),
+		new(addFlowEfficiencyToIssues),
+		new(addIssueAttributes),
+		new(addRawDataVersionToConvertorCheckpoints),
+		new(addIssueRelationships),
 	}
 }
@@ -101,6 +101,22 @@ type SubTaskMeta struct {
 	Dependencies     []*SubTaskMeta
 	DependencyTables []string
 	ProductTables    []string
+	// RunsWhenStaged keeps the subtask running when the task data stages its raw data, which only collectors and
+	// the subtasks inspecting the staged raw data should, any other subtask would write the production tables
+	RunsWhenStaged bool
+}
+
+// RawDataStager is implemented by task data able to run in staging mode, where collectors write into staging raw
+// tables leaving the production data untouched
+type RawDataStager interface {
+	IsRawDataStaged() bool
+}
+
+// IsSkippedWhenStaged tells whether the subtask is to be skipped for the task data, that is when the data is staged
+// and the subtask does not run on staged raw data
+func (meta *SubTaskMeta) IsSkippedWhenStaged(taskData interface{}) bool {
+	stager, ok := taskData.(RawDataStager)
+	return ok && stager.IsRawDataStaged() && !meta.RunsWhenStaged
 }
 
 // PluginTask Implement this interface to let framework run tasks for you
@@ -0,0 +1,39 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type stagedTaskData bool
+
+func (data stagedTaskData) IsRawDataStaged() bool {
+	return bool(data)
+}
+
+func TestIsSkippedWhenStaged(t *testing.T) {
+	collector := &SubTaskMeta{Name: "collect", RunsWhenStaged: true}
+	convertor := &SubTaskMeta{Name: "convert"}
+	assert.False(t, collector.IsSkippedWhenStaged(stagedTaskData(true)))
+	assert.True(t, convertor.IsSkippedWhenStaged(stagedTaskData(true)))
+	assert.False(t, convertor.IsSkippedWhenStaged(stagedTaskData(false)))
+	assert.False(t, convertor.IsSkippedWhenStaged(struct{}{}))
+}
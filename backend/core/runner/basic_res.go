@@ -22,6 +22,7 @@ import (
 	"github.com/apache/incubator-devlake/core/config"
 	"github.com/apache/incubator-devlake/core/context"
 	"github.com/apache/incubator-devlake/core/log"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
 	"github.com/apache/incubator-devlake/core/plugin"
 	contextimpl "github.com/apache/incubator-devlake/impls/context"
 	"github.com/apache/incubator-devlake/impls/dalgorm"
@@ -50,6 +51,9 @@ func CreateAppBasicRes() context.BasicRes {
 		panic(err)
 	}
 	dalgorm.Init(cfg.GetString(plugin.EncodeKeyEnvStr))
+	if err := didgen.Init(cfg.GetString(didgen.TenantPrefixEnvStr)); err != nil {
+		panic(err)
+	}
 	return CreateBasicRes(cfg, logger, db)
 }
 
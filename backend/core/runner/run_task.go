@@ -244,6 +244,12 @@ func RunPluginSubTasks(
 		return errors.Default.Wrap(err, fmt.Sprintf("error preparing task data for %s", task.Plugin))
 	}
 	taskCtx.SetData(taskData)
+	// subtasks not running on staged raw data would write the production tables
+	for _, subtaskMeta := range subtaskMetas {
+		if subtasksFlag[subtaskMeta.Name] && subtaskMeta.IsSkippedWhenStaged(taskData) {
+			steps--
+		}
+	}
 
 	// execute subtasks in order
 	taskCtx.SetProgress(0, steps)
@@ -258,6 +264,10 @@ func RunPluginSubTasks(
 			// subtask was disabled
 			continue
 		}
+		if subtaskMeta.IsSkippedWhenStaged(taskData) {
+			logger.Info("skipping subtask %s on staged raw data", subtaskMeta.Name)
+			continue
+		}
 
 		// run subtask
 		logger.Info("executing subtask %s", subtaskMeta.Name)
@@ -213,6 +213,10 @@ func (t *DataFlowTester) FlushTabler(dst schema.Tabler) {
 
 // Subtask executes specified subtasks
 func (t *DataFlowTester) Subtask(subtaskMeta plugin.SubTaskMeta, taskData interface{}) {
+	// like the runner, subtasks not running on staged raw data are skipped
+	if subtaskMeta.IsSkippedWhenStaged(taskData) {
+		return
+	}
 	subtaskCtx := t.SubtaskContext(taskData)
 	err := subtaskMeta.EntryPoint(subtaskCtx)
 	if err != nil {
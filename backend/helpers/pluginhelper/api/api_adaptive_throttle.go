@@ -0,0 +1,114 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxAdaptiveTickInterval bounds how far adaptive throttling may slow an api client down, so a skewed reset time
+// can not stall a collection
+const maxAdaptiveTickInterval = time.Minute
+
+// RateLimitHeaders names the response headers through which an api reports its rate limit budget
+type RateLimitHeaders struct {
+	// Remaining is the number of requests left in the current window
+	Remaining string
+	// Limit is the total number of requests allowed in a window, optional
+	Limit string
+	// Reset tells when the current window ends, as epoch seconds, seconds from now or a timestamp, optional
+	Reset string
+}
+
+// adaptiveThrottle paces requests by the rate limit budget reported in responses
+type adaptiveThrottle struct {
+	headers        RateLimitHeaders
+	staticInterval time.Duration
+	mu             sync.Mutex
+}
+
+// tickInterval calculates the interval between requests that spreads the remaining budget over the rest of the
+// window, it never goes below the static interval. Returns false if the response carries no budget at all
+func (t *adaptiveThrottle) tickInterval(res *http.Response, now time.Time) (time.Duration, bool) {
+	remaining, err := strconv.Atoi(strings.TrimSpace(res.Header.Get(t.headers.Remaining)))
+	if t.headers.Remaining == "" || err != nil {
+		return 0, false
+	}
+	if remaining < 0 {
+		remaining = 0
+	}
+	interval := t.staticInterval
+	if resetAt := parseRateLimitReset(res.Header.Get(t.headers.Reset), now); resetAt != nil && resetAt.After(now) {
+		window := resetAt.Sub(now)
+		if remaining == 0 {
+			interval = window
+		} else if spread := window / time.Duration(remaining); spread > interval {
+			interval = spread
+		}
+	} else if limit, err := strconv.Atoi(strings.TrimSpace(res.Header.Get(t.headers.Limit))); err == nil && limit > 0 {
+		// without a reset time, slow down in proportion to the budget used up
+		if remaining == 0 {
+			interval = maxAdaptiveTickInterval
+		} else if scaled := t.staticInterval * time.Duration(limit) / time.Duration(remaining); scaled > interval {
+			interval = scaled
+		}
+	}
+	if interval > maxAdaptiveTickInterval {
+		interval = maxAdaptiveTickInterval
+	}
+	return interval, true
+}
+
+// rateLimitResetLayouts are the timestamp layouts of reset headers besides the ones known to ConvertStringToTime,
+// Jira Cloud for one reports minutes precision like 2023-08-16T10:10Z
+var rateLimitResetLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04Z07:00",
+	http.TimeFormat,
+}
+
+// parseRateLimitReset parses a reset header value, numbers above a billion are taken as epoch seconds and
+// smaller ones as seconds from now
+func parseRateLimitReset(value string, now time.Time) *time.Time {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		var resetAt time.Time
+		if seconds > 1e9 {
+			resetAt = time.Unix(seconds, 0)
+		} else {
+			resetAt = now.Add(time.Duration(seconds) * time.Second)
+		}
+		return &resetAt
+	}
+	for _, layout := range rateLimitResetLayouts {
+		if resetAt, err := time.Parse(layout, value); err == nil {
+			return &resetAt
+		}
+	}
+	if resetAt, err := ConvertStringToTime(value); err == nil {
+		return &resetAt
+	}
+	return nil
+}
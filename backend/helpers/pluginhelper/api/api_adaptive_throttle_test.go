@@ -0,0 +1,93 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdaptiveThrottleTickInterval(t *testing.T) {
+	now := time.Date(2023, 8, 16, 10, 0, 0, 0, time.UTC)
+	throttle := &adaptiveThrottle{
+		headers: RateLimitHeaders{
+			Remaining: "X-RateLimit-Remaining",
+			Limit:     "X-RateLimit-Limit",
+			Reset:     "X-RateLimit-Reset",
+		},
+		staticInterval: 100 * time.Millisecond,
+	}
+	response := func(headers map[string]string) *http.Response {
+		res := &http.Response{Header: http.Header{}}
+		for k, v := range headers {
+			res.Header.Set(k, v)
+		}
+		return res
+	}
+
+	// headers absent
+	_, ok := throttle.tickInterval(response(nil), now)
+	assert.False(t, ok)
+
+	// plenty of budget keeps the static pace
+	interval, ok := throttle.tickInterval(response(map[string]string{
+		"X-RateLimit-Remaining": "1000",
+		"X-RateLimit-Reset":     "2023-08-16T10:01Z",
+	}), now)
+	assert.True(t, ok)
+	assert.Equal(t, 100*time.Millisecond, interval)
+
+	// low budget is spread over the rest of the window
+	interval, _ = throttle.tickInterval(response(map[string]string{
+		"X-RateLimit-Remaining": "60",
+		"X-RateLimit-Reset":     "60",
+	}), now)
+	assert.Equal(t, time.Second, interval)
+
+	// exhausted budget waits until the window ends, bounded by the maximum
+	interval, _ = throttle.tickInterval(response(map[string]string{
+		"X-RateLimit-Remaining": "0",
+		"X-RateLimit-Reset":     "30",
+	}), now)
+	assert.Equal(t, 30*time.Second, interval)
+	interval, _ = throttle.tickInterval(response(map[string]string{
+		"X-RateLimit-Remaining": "0",
+		"X-RateLimit-Reset":     "3600",
+	}), now)
+	assert.Equal(t, maxAdaptiveTickInterval, interval)
+
+	// without a reset time the pace scales with the budget used up
+	interval, _ = throttle.tickInterval(response(map[string]string{
+		"X-RateLimit-Remaining": "25",
+		"X-RateLimit-Limit":     "100",
+	}), now)
+	assert.Equal(t, 400*time.Millisecond, interval)
+}
+
+func TestParseRateLimitReset(t *testing.T) {
+	now := time.Date(2023, 8, 16, 10, 0, 0, 0, time.UTC)
+	assert.Nil(t, parseRateLimitReset("", now))
+	assert.Nil(t, parseRateLimitReset("soon", now))
+	assert.Equal(t, now.Add(30*time.Second), *parseRateLimitReset("30", now))
+	assert.Equal(t, now.Add(time.Hour).Unix(), parseRateLimitReset("1692183600", now).Unix())
+	assert.Equal(t, now.Add(10*time.Minute).Unix(), parseRateLimitReset("2023-08-16T10:10Z", now).Unix())
+	assert.Equal(t, now.Add(time.Minute).Unix(), parseRateLimitReset("2023-08-16T10:01:00+00:00", now).Unix())
+}
@@ -45,6 +45,10 @@ type ApiAsyncClient struct {
 	maxRetry     int
 	numOfWorkers int
 	logger       log.Logger
+	// requestBudget caps the number of requests submitted by DoAsync, nil for no cap
+	requestBudget *RequestBudget
+	// throttle adapts the pace of requests to the rate limit budget reported by the api, nil to keep a static pace
+	throttle *adaptiveThrottle
 }
 
 const defaultTimeout = 120 * time.Second
@@ -125,11 +129,11 @@ func CreateAsyncApiClient(
 
 	// finally, wrap around api client with async sematic
 	return &ApiAsyncClient{
-		apiClient,
-		scheduler,
-		retry,
-		numOfWorkers,
-		logger,
+		ApiClient:       apiClient,
+		WorkerScheduler: scheduler,
+		maxRetry:        retry,
+		numOfWorkers:    numOfWorkers,
+		logger:          logger,
 	}, nil
 }
 
@@ -145,6 +149,69 @@ func (apiClient *ApiAsyncClient) SetMaxRetry(
 	apiClient.maxRetry = maxRetry
 }
 
+// SetRequestBudget caps the number of requests submitted through DoAsync, requests beyond the budget are skipped
+// without error. Retries do not count. The budget may be shared by several clients, nil removes the cap
+func (apiClient *ApiAsyncClient) SetRequestBudget(budget *RequestBudget) {
+	apiClient.requestBudget = budget
+}
+
+// GetRequestBudget returns the request budget of the client, nil when there is no cap
+func (apiClient *ApiAsyncClient) GetRequestBudget() *RequestBudget {
+	return apiClient.requestBudget
+}
+
+// IsBudgetExhausted tells whether the request budget is used up, so any further request would be skipped
+func (apiClient *ApiAsyncClient) IsBudgetExhausted() bool {
+	return apiClient.requestBudget.IsExhausted()
+}
+
+// takeBudget consumes one request from the budget, returns false if the budget is used up
+func (apiClient *ApiAsyncClient) takeBudget() bool {
+	budget := apiClient.requestBudget
+	if budget.take() {
+		return true
+	}
+	if budget.markExhausted() {
+		apiClient.logger.Info("request budget of %d requests is exhausted, skipping the remaining requests", budget.limit)
+	}
+	return false
+}
+
+// SetAdaptiveThrottling makes the client pace its requests by the rate limit budget reported in the given response
+// headers, slowing down as the remaining budget drops and returning to the static rate once the budget recovers or
+// the headers are absent. It only changes the pace, the number of workers sending requests in parallel stays the same
+func (apiClient *ApiAsyncClient) SetAdaptiveThrottling(headers RateLimitHeaders) {
+	apiClient.throttle = &adaptiveThrottle{
+		headers:        headers,
+		staticInterval: apiClient.GetTickInterval(),
+	}
+}
+
+// adaptThrottle resets the tick interval according to the rate limit budget of the response
+func (apiClient *ApiAsyncClient) adaptThrottle(res *http.Response) {
+	throttle := apiClient.throttle
+	if throttle == nil || res == nil {
+		return
+	}
+	interval, ok := throttle.tickInterval(res, time.Now())
+	if !ok {
+		interval = throttle.staticInterval
+	}
+	throttle.mu.Lock()
+	defer throttle.mu.Unlock()
+	current := apiClient.GetTickInterval()
+	// ignore changes within 10 percent to avoid resetting the ticker on every response
+	diff := interval - current
+	if diff < 0 {
+		diff = -diff
+	}
+	if diff*10 <= current {
+		return
+	}
+	apiClient.logger.Debug("rate limit budget changed, tick interval goes from %s to %s", current, interval)
+	apiClient.Reset(interval)
+}
+
 // DoAsync would carry out an asynchronous request
 func (apiClient *ApiAsyncClient) DoAsync(
 	method string,
@@ -155,6 +222,9 @@ func (apiClient *ApiAsyncClient) DoAsync(
 	handler common.ApiAsyncCallback,
 	retry int,
 ) {
+	if !apiClient.takeBudget() {
+		return
+	}
 	var request func() errors.Error
 	request = func() errors.Error {
 		var err error
@@ -163,6 +233,9 @@ func (apiClient *ApiAsyncClient) DoAsync(
 
 		apiClient.logger.Debug("endpoint: %s  method: %s  header: %s  body: %s query: %s", path, method, header, body, query)
 		res, err = apiClient.Do(method, path, query, body, header)
+		if err == nil {
+			apiClient.adaptThrottle(res)
+		}
 		if err == ErrIgnoreAndContinue {
 			// make sure defer func got be executed
 			err = nil //nolint
@@ -245,6 +318,25 @@ func (apiClient *ApiAsyncClient) GetNumOfWorkers() int {
 	return apiClient.numOfWorkers
 }
 
+// budgetedApiClient is implemented by api clients enforcing a request budget
+type budgetedApiClient interface {
+	GetRequestBudget() *RequestBudget
+}
+
+// requestBudgetOf returns the request budget enforced by the api client, nil when there is none
+func requestBudgetOf(apiClient interface{}) *RequestBudget {
+	budgeted, ok := apiClient.(budgetedApiClient)
+	if !ok {
+		return nil
+	}
+	return budgeted.GetRequestBudget()
+}
+
+// isBudgetExhausted tells whether the api client enforces a request budget which is used up
+func isBudgetExhausted(apiClient interface{}) bool {
+	return requestBudgetOf(apiClient).IsExhausted()
+}
+
 // RateLimitedApiClient FIXME ...
 type RateLimitedApiClient interface {
 	DoGetAsync(path string, query url.Values, header http.Header, handler common.ApiAsyncCallback)
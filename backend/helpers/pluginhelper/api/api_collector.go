@@ -49,6 +49,8 @@ type RequestData struct {
 	InputJSON []byte
 	// equal to the return value from GetNextPageCustomData when PageSize>0 and not the first request
 	CustomData interface{}
+	// emptyRetried marks the request has been retried once for returning an unexpected empty page
+	emptyRetried bool
 }
 
 // AsyncResponseHandler FIXME ...
@@ -70,6 +72,12 @@ type ApiCollectorArgs struct {
 	GetTotalPages func(res *http.Response, args *ApiCollectorArgs) (int, errors.Error)
 	// PageSize tells ApiCollector the page size
 	PageSize int
+	// GetTotalCount is to tell `ApiCollector` total number of records reported by a response, it is used to
+	// validate pages returning no item
+	GetTotalCount func(res *http.Response) (int, errors.Error)
+	// RetryOnEmpty requests a page once more when it returns no item while `GetTotalCount` reports records
+	// beyond the page offset, it has no effect without `GetTotalCount`
+	RetryOnEmpty bool
 	// GetNextPageCustomData indicate if this collection request each page in order and build query by the prev request
 	GetNextPageCustomData func(prevReqData *RequestData, prevPageResponse *http.Response) (interface{}, errors.Error)
 	// Incremental indicate if this is an incremental collection, the existing data won't get deleted if it was true
@@ -105,6 +113,11 @@ func NewApiCollector(args ApiCollectorArgs) (*ApiCollector, errors.Error) {
 	if err != nil {
 		return nil, errors.Default.Wrap(err, "Couldn't resolve raw subtask args")
 	}
+	// staging collections always start over, into the staging raw table
+	if IsRawDataStaged(args.Ctx) {
+		rawDataSubTask.table = StagingRawTable(rawDataSubTask.table)
+		args.Incremental = false
+	}
 	// TODO: check if args.Table is valid when this is a http GET request
 	if args.UrlTemplate == "" && args.Method == "" {
 		return nil, errors.Default.New("UrlTemplate is required")
@@ -151,6 +164,12 @@ func (collector *ApiCollector) Execute() errors.Error {
 	logger := collector.args.Ctx.GetLogger()
 	logger.Info("start api collection")
 
+	// leave the data collected by previous runs untouched when there is nothing left to collect it again
+	if isBudgetExhausted(collector.args.ApiClient) {
+		logger.Info("request budget is exhausted, skipping api collection")
+		return nil
+	}
+
 	// make sure table is created
 	db := collector.args.Ctx.GetDal()
 	err := collector.ensureRawTable(collector.table)
@@ -184,6 +203,16 @@ func (collector *ApiCollector) Execute() errors.Error {
 		}
 	}
 
+	// requests refused from here on leave the raw data of the collection incomplete
+	budget := requestBudgetOf(collector.args.ApiClient)
+	refusedBefore := budget.refusals()
+	defer func() {
+		if budget.refusals() > refusedBefore {
+			logger.Warn(nil, "request budget was used up during the collection of %s", collector.table)
+			budget.markCutShort(collector.table)
+		}
+	}()
+
 	collector.args.Ctx.SetProgress(0, -1)
 	if collector.args.Input != nil {
 		iterator := collector.args.Input
@@ -438,9 +467,11 @@ func (collector *ApiCollector) fetchAsync(reqData *RequestData, handler func(int
 		res.Body = io.NopCloser(bytes.NewBuffer(body))
 		// convert body to array of RawJSON
 		items, err := collector.args.ResponseParser(res)
+		finished := false
 		if err != nil {
 			if errors.Is(err, ErrFinishCollect) {
 				logger.Info("a fetch stop by parser, reqInput: #%s", reqData.Params)
+				finished = true
 				handler = nil
 			} else {
 				return errors.Default.Wrap(err, fmt.Sprintf("error parsing response from %s", apiUrl))
@@ -449,6 +480,22 @@ func (collector *ApiCollector) fetchAsync(reqData *RequestData, handler func(int
 		// save to db
 		count := len(items)
 		if count == 0 {
+			if !finished && collector.args.RetryOnEmpty && collector.args.GetTotalCount != nil && !reqData.emptyRetried {
+				res.Body = io.NopCloser(bytes.NewBuffer(body))
+				total, err := collector.args.GetTotalCount(res)
+				if err != nil {
+					return errors.Default.Wrap(err, fmt.Sprintf("error getting total count from %s", apiUrl))
+				}
+				if total > reqData.Pager.Skip {
+					logger.Warn(nil, "got an empty page from %s %v while %d records were reported, retrying", apiUrl, apiQuery, total)
+					reqData.emptyRetried = true
+					collector.args.ApiClient.NextTick(func() errors.Error {
+						collector.fetchAsync(reqData, handler)
+						return nil
+					})
+					return nil
+				}
+			}
 			collector.args.Ctx.IncProgress(1)
 			return nil
 		}
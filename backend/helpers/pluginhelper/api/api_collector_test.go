@@ -19,7 +19,11 @@ package api
 
 import (
 	"bytes"
+	"encoding/json"
+	"github.com/apache/incubator-devlake/core/dal"
 	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/log"
+	"github.com/apache/incubator-devlake/core/plugin"
 	"github.com/apache/incubator-devlake/helpers/pluginhelper/common"
 	"github.com/apache/incubator-devlake/helpers/unithelper"
 	mockdal "github.com/apache/incubator-devlake/mocks/core/dal"
@@ -28,6 +32,7 @@ import (
 	"net/http"
 	"net/url"
 	"testing"
+	"text/template"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -105,3 +110,113 @@ func TestFetchPageUndetermined(t *testing.T) {
 
 	mockDal.AssertExpectations(t)
 }
+
+type retryTestApiClient struct {
+	RateLimitedApiClient
+	bodies []string
+	err    errors.Error
+	urls   []string
+}
+
+func (c *retryTestApiClient) DoGetAsync(path string, query url.Values, header http.Header, handler common.ApiAsyncCallback) {
+	body := c.bodies[len(c.urls)]
+	c.urls = append(c.urls, path)
+	res := &http.Response{
+		Request: &http.Request{
+			URL: &url.URL{Path: path},
+		},
+		Body: ioutil.NopCloser(bytes.NewBufferString(body)),
+	}
+	if err := handler(res); err != nil {
+		c.err = err
+	}
+}
+
+func (c *retryTestApiClient) NextTick(task func() errors.Error) {
+	if err := task(); err != nil {
+		c.err = err
+	}
+}
+
+type retryTestDal struct {
+	dal.Dal
+	saved int
+}
+
+func (d *retryTestDal) Create(entity interface{}, _ ...dal.Clause) errors.Error {
+	d.saved += len(entity.([]*RawData))
+	return nil
+}
+
+type retryTestContext struct {
+	plugin.SubTaskContext
+	db       *retryTestDal
+	progress int
+}
+
+func (c *retryTestContext) GetDal() dal.Dal          { return c.db }
+func (c *retryTestContext) GetLogger() log.Logger    { return &extractorTestLogger{} }
+func (c *retryTestContext) IncProgress(quantity int) { c.progress += quantity }
+
+func TestFetchAsyncRetryOnEmpty(t *testing.T) {
+	getTotalCount := func(res *http.Response) (int, errors.Error) {
+		var body struct {
+			Total int `json:"total"`
+		}
+		err := UnmarshalResponse(res, &body)
+		return body.Total, err
+	}
+	parser := func(res *http.Response) ([]json.RawMessage, errors.Error) {
+		var body struct {
+			Items []json.RawMessage `json:"items"`
+		}
+		err := UnmarshalResponse(res, &body)
+		return body.Items, err
+	}
+	fetch := func(retryOnEmpty bool, bodies ...string) (*retryTestApiClient, *retryTestContext, int) {
+		ctx := &retryTestContext{db: &retryTestDal{}}
+		client := &retryTestApiClient{bodies: bodies}
+		args := &ApiCollectorArgs{
+			RawDataSubTaskArgs: RawDataSubTaskArgs{Ctx: ctx},
+			ApiClient:          client,
+			GetTotalCount:      getTotalCount,
+			RetryOnEmpty:       retryOnEmpty,
+			ResponseParser:     parser,
+		}
+		collector := &ApiCollector{
+			RawDataSubTask: &RawDataSubTask{args: &args.RawDataSubTaskArgs, table: "_raw_test", params: `{}`},
+			args:           args,
+			urlTemplate:    template.Must(template.New("").Parse("items")),
+		}
+		handled := 0
+		collector.fetchAsync(&RequestData{Pager: &Pager{Page: 1, Size: 2}}, func(count int, body []byte, res *http.Response) errors.Error {
+			handled += count
+			return nil
+		})
+		return client, ctx, handled
+	}
+
+	// an empty page while records are reported gets requested once more
+	client, ctx, handled := fetch(true, `{"items":[],"total":2}`, `{"items":[1,2],"total":2}`)
+	assert.Nil(t, client.err)
+	assert.Equal(t, []string{"items", "items"}, client.urls)
+	assert.Equal(t, 2, ctx.db.saved)
+	assert.Equal(t, 2, handled)
+	assert.Equal(t, 1, ctx.progress)
+
+	// but only once
+	client, ctx, handled = fetch(true, `{"items":[],"total":2}`, `{"items":[],"total":2}`)
+	assert.Nil(t, client.err)
+	assert.Len(t, client.urls, 2)
+	assert.Equal(t, 0, ctx.db.saved)
+	assert.Equal(t, 0, handled)
+	assert.Equal(t, 1, ctx.progress)
+
+	// an empty page is expected when no record is reported
+	client, _, _ = fetch(true, `{"items":[],"total":0}`)
+	assert.Len(t, client.urls, 1)
+
+	// and never retried unless asked to
+	client, _, _ = fetch(false, `{"items":[],"total":2}`)
+	assert.Len(t, client.urls, 1)
+}
@@ -36,6 +36,7 @@ type ApiCollectorStateManager struct {
 	// *ApiCollector
 	// *GraphqlCollector
 	subtasks     []plugin.SubTask
+	apiClients   []RateLimitedApiClient
 	LatestState  models.CollectorLatestState
 	TimeAfter    *time.Time
 	ExecuteStart time.Time
@@ -75,7 +76,7 @@ func (m *ApiCollectorStateManager) IsIncremental() bool {
 	prevTimeAfter := m.LatestState.TimeAfter
 	currTimeAfter := m.TimeAfter
 
-	if prevSyncTime == nil {
+	if prevSyncTime == nil || IsRawDataStaged(m.Ctx) {
 		return false
 	}
 	if currTimeAfter != nil {
@@ -92,6 +93,7 @@ func (m *ApiCollectorStateManager) InitCollector(args ApiCollectorArgs) errors.E
 		return err
 	}
 	m.subtasks = append(m.subtasks, apiCollector)
+	m.apiClients = append(m.apiClients, args.ApiClient)
 	return nil
 }
 
@@ -115,6 +117,19 @@ func (m *ApiCollectorStateManager) Execute() errors.Error {
 		}
 	}
 
+	// the collection is incomplete, the next run has to start over from the same state
+	for _, apiClient := range m.apiClients {
+		if isBudgetExhausted(apiClient) {
+			m.Ctx.GetLogger().Info("request budget is exhausted, keeping the collector state of the previous run")
+			return nil
+		}
+	}
+
+	// staging collections must not affect the incremental collections of production data
+	if IsRawDataStaged(m.Ctx) {
+		return nil
+	}
+
 	db := m.Ctx.GetDal()
 	m.LatestState.LatestSuccessStart = &m.ExecuteStart
 	m.LatestState.TimeAfter = m.TimeAfter
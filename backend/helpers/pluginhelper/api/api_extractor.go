@@ -19,11 +19,13 @@ package api
 
 import (
 	"reflect"
+	"sync"
 
 	"github.com/apache/incubator-devlake/core/dal"
 	"github.com/apache/incubator-devlake/core/errors"
 	"github.com/apache/incubator-devlake/core/models/common"
 	plugin "github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/core/utils"
 )
 
 // ApiExtractorArgs FIXME ...
@@ -32,6 +34,10 @@ type ApiExtractorArgs struct {
 	Params    interface{}
 	Extract   func(row *RawData) ([]interface{}, errors.Error)
 	BatchSize int
+	// Concurrency is the number of goroutines running `Extract`, defaults to 1. Only set it when `Extract` is safe
+	// to call concurrently, EXTRACTOR_CONCURRENCY then overrides it. It gets clamped by DB_MAX_CONNS since every
+	// worker might hold a db connection while saving
+	Concurrency int
 }
 
 // ApiExtractor helps you extract Raw Data from api responses to Tool Layer Data
@@ -74,9 +80,23 @@ func (extractor *ApiExtractor) Execute() errors.Error {
 	// load data from database
 	db := extractor.args.Ctx.GetDal()
 	logger := extractor.args.Ctx.GetLogger()
+	if IsRawDataStaged(extractor.args.Ctx) {
+		logger.Info("raw data is staged, skipping extraction")
+		return nil
+	}
+	// the collection of non-incremental data deleted the raw data of the previous run, extracting the partial
+	// data would replace the complete tool data of the previous run
+	if isRawDataCutShort(extractor.args.Ctx, extractor.table) {
+		logger.Warn(nil, "collection of %s was cut short by the request budget, skipping extraction", extractor.table)
+		return nil
+	}
 	if !db.HasTable(extractor.table) {
 		return nil
 	}
+	concurrency, err := extractor.getConcurrency()
+	if err != nil {
+		return err
+	}
 	clauses := []dal.Clause{
 		dal.From(extractor.table),
 		dal.Where("params = ?", extractor.params),
@@ -93,14 +113,29 @@ func (extractor *ApiExtractor) Execute() errors.Error {
 	}
 	logger.Info("get data from %s where params=%s and got %d", extractor.table, extractor.params, count)
 	defer cursor.Close()
-	row := &RawData{}
 
 	// batch save divider
 	divider := NewBatchSaveDivider(extractor.args.Ctx, extractor.args.BatchSize, extractor.table, extractor.params)
 
 	// prgress
 	extractor.args.Ctx.SetProgress(0, -1)
+	if concurrency > 1 {
+		err = extractor.extractConcurrently(cursor, divider, concurrency)
+	} else {
+		err = extractor.extract(cursor, divider)
+	}
+	if err != nil {
+		return err
+	}
+
+	// save the last batches
+	return divider.Close()
+}
+
+func (extractor *ApiExtractor) extract(cursor dal.Rows, divider *BatchSaveDivider) errors.Error {
+	db := extractor.args.Ctx.GetDal()
 	ctx := extractor.args.Ctx.GetContext()
+	row := &RawData{}
 	// iterate all rows
 	for cursor.Next() {
 		select {
@@ -108,7 +143,7 @@ func (extractor *ApiExtractor) Execute() errors.Error {
 			return errors.Convert(ctx.Err())
 		default:
 		}
-		err = db.Fetch(cursor, row)
+		err := db.Fetch(cursor, row)
 		if err != nil {
 			return errors.Default.Wrap(err, "error fetching row")
 		}
@@ -117,29 +152,138 @@ func (extractor *ApiExtractor) Execute() errors.Error {
 		if err != nil {
 			return errors.Default.Wrap(err, "error calling plugin Extract implementation")
 		}
-		for _, result := range results {
-			// get the batch operator for the specific type
-			batch, err := divider.ForType(reflect.TypeOf(result))
-			if err != nil {
-				return errors.Default.Wrap(err, "error getting batch from result")
-			}
-			// set raw data origin field
-			setRawDataOrigin(result, common.RawDataOrigin{
-				RawDataTable:  extractor.table,
-				RawDataId:     row.ID,
-				RawDataParams: row.Params,
-			})
-			// records get saved into db when slots were max outed
-			err = batch.Add(result)
-			if err != nil {
-				return errors.Default.Wrap(err, "error adding result to batch")
-			}
+		err = extractor.save(divider, row, results)
+		if err != nil {
+			return err
 		}
 		extractor.args.Ctx.IncProgress(1)
 	}
+	return nil
+}
 
-	// save the last batches
-	return divider.Close()
+// extractConcurrently feeds the rows to a pool of workers running `Extract`, results are saved one worker at a time
+// since the divider is not thread-safe
+func (extractor *ApiExtractor) extractConcurrently(cursor dal.Rows, divider *BatchSaveDivider, concurrency int) errors.Error {
+	db := extractor.args.Ctx.GetDal()
+	ctx := extractor.args.Ctx.GetContext()
+	rows := make(chan *RawData, concurrency)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var firstErr errors.Error
+	failed := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return firstErr != nil
+	}
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for row := range rows {
+				if failed() {
+					continue
+				}
+				results, err := extractor.args.Extract(row)
+				mu.Lock()
+				if err != nil {
+					err = errors.Default.Wrap(err, "error calling plugin Extract implementation")
+				} else if firstErr == nil {
+					err = extractor.save(divider, row, results)
+					extractor.args.Ctx.IncProgress(1)
+				}
+				if err != nil && firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	var err errors.Error
+	for err == nil && cursor.Next() && !failed() {
+		select {
+		case <-ctx.Done():
+			err = errors.Convert(ctx.Err())
+			continue
+		default:
+		}
+		row := &RawData{}
+		err = db.Fetch(cursor, row)
+		if err != nil {
+			err = errors.Default.Wrap(err, "error fetching row")
+			continue
+		}
+		rows <- row
+	}
+	close(rows)
+	wg.Wait()
+	if err != nil {
+		return err
+	}
+	return firstErr
+}
+
+func (extractor *ApiExtractor) save(divider *BatchSaveDivider, row *RawData, results []interface{}) errors.Error {
+	for _, result := range results {
+		// get the batch operator for the specific type
+		batch, err := divider.ForType(reflect.TypeOf(result))
+		if err != nil {
+			return errors.Default.Wrap(err, "error getting batch from result")
+		}
+		// set raw data origin field
+		setRawDataOrigin(result, common.RawDataOrigin{
+			RawDataTable:  extractor.table,
+			RawDataId:     row.ID,
+			RawDataParams: row.Params,
+		})
+		// records get saved into db when slots were max outed
+		err = batch.Add(result)
+		if err != nil {
+			return errors.Default.Wrap(err, "error adding result to batch")
+		}
+	}
+	return nil
+}
+
+func (extractor *ApiExtractor) getConcurrency() (int, errors.Error) {
+	concurrency := extractor.args.Concurrency
+	if concurrency <= 1 {
+		return 1, nil
+	}
+	configured, err := utils.StrToIntOr(extractor.args.Ctx.GetConfig("EXTRACTOR_CONCURRENCY"), 0)
+	if err != nil {
+		return 0, errors.BadInput.Wrap(err, "failed to parse EXTRACTOR_CONCURRENCY")
+	}
+	if configured > 0 {
+		concurrency = configured
+	}
+	dbMaxConns, err := utils.StrToIntOr(extractor.args.Ctx.GetConfig("DB_MAX_CONNS"), 100)
+	if err != nil {
+		return 0, errors.BadInput.Wrap(err, "failed to parse DB_MAX_CONNS")
+	}
+	clamped := clampExtractorConcurrency(concurrency, dbMaxConns)
+	if clamped < concurrency {
+		extractor.args.Ctx.GetLogger().Info(
+			"extractor concurrency clamped from %d to %d, workers may use at most half of DB_MAX_CONNS=%d minus the raw data cursor",
+			concurrency, clamped, dbMaxConns,
+		)
+	}
+	return clamped, nil
+}
+
+// clampExtractorConcurrency leaves half of the db connections to other subtasks, one connection is held by the
+// raw data cursor
+func clampExtractorConcurrency(concurrency, dbMaxConns int) int {
+	limit := (dbMaxConns - 1) / 2
+	if limit < 1 {
+		limit = 1
+	}
+	if concurrency > limit {
+		return limit
+	}
+	if concurrency < 1 {
+		return 1
+	}
+	return concurrency
 }
 
 var _ plugin.SubTask = (*ApiExtractor)(nil)
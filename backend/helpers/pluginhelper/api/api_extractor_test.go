@@ -0,0 +1,215 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	gocontext "context"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/log"
+	"github.com/apache/incubator-devlake/core/models/common"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClampExtractorConcurrency(t *testing.T) {
+	tests := []struct {
+		name        string
+		concurrency int
+		dbMaxConns  int
+		want        int
+	}{
+		{"unset", 0, 100, 1},
+		{"negative", -3, 100, 1},
+		{"sequential", 1, 100, 1},
+		{"within limit", 8, 100, 8},
+		{"at limit", 49, 100, 49},
+		{"above limit", 50, 100, 49},
+		{"tiny pool", 10, 2, 1},
+		{"no pool", 10, 0, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, clampExtractorConcurrency(tt.concurrency, tt.dbMaxConns))
+		})
+	}
+}
+
+type extractorTestRecord struct {
+	common.RawDataOrigin
+	ID int `gorm:"primaryKey"`
+}
+
+type extractorTestLogger struct {
+	log.Logger
+}
+
+func (l *extractorTestLogger) Nested(string) log.Logger            { return l }
+func (l *extractorTestLogger) Debug(string, ...interface{})        {}
+func (l *extractorTestLogger) Info(string, ...interface{})         {}
+func (l *extractorTestLogger) Warn(error, string, ...interface{})  {}
+func (l *extractorTestLogger) Error(error, string, ...interface{}) {}
+
+type extractorTestRows struct {
+	dal.Rows
+	count int
+	next  int
+}
+
+func (r *extractorTestRows) Next() bool {
+	if r.next >= r.count {
+		return false
+	}
+	r.next++
+	return true
+}
+
+type extractorTestDal struct {
+	dal.Dal
+	mutex sync.Mutex
+	saved []int
+}
+
+func (d *extractorTestDal) Fetch(cursor dal.Rows, dst interface{}) errors.Error {
+	row := dst.(*RawData)
+	row.ID = uint64(cursor.(*extractorTestRows).next)
+	row.Data = json.RawMessage(`{}`)
+	return nil
+}
+
+func (d *extractorTestDal) GetPrimaryKeyFields(t reflect.Type) []reflect.StructField {
+	field, _ := t.Elem().FieldByName("ID")
+	return []reflect.StructField{field}
+}
+
+func (d *extractorTestDal) Delete(interface{}, ...dal.Clause) errors.Error {
+	return nil
+}
+
+func (d *extractorTestDal) CreateOrUpdate(entity interface{}, _ ...dal.Clause) errors.Error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	for _, record := range entity.([]*extractorTestRecord) {
+		d.saved = append(d.saved, record.ID)
+	}
+	return nil
+}
+
+type extractorTestContext struct {
+	plugin.SubTaskContext
+	config   map[string]string
+	db       *extractorTestDal
+	mutex    sync.Mutex
+	progress int
+}
+
+func (c *extractorTestContext) GetDal() dal.Dal                    { return c.db }
+func (c *extractorTestContext) GetLogger() log.Logger              { return &extractorTestLogger{} }
+func (c *extractorTestContext) GetContext() gocontext.Context      { return gocontext.Background() }
+func (c *extractorTestContext) GetConfig(name string) string       { return c.config[name] }
+func (c *extractorTestContext) SetProgress(current int, total int) {}
+func (c *extractorTestContext) IncProgress(quantity int) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.progress += quantity
+}
+
+func newExtractorForTest(concurrency int, extract func(row *RawData) ([]interface{}, errors.Error)) (*ApiExtractor, *extractorTestContext) {
+	ctx := &extractorTestContext{db: &extractorTestDal{}}
+	args := &ApiExtractorArgs{
+		RawDataSubTaskArgs: RawDataSubTaskArgs{Ctx: ctx},
+		Extract:            extract,
+		BatchSize:          7,
+		Concurrency:        concurrency,
+	}
+	return &ApiExtractor{
+		RawDataSubTask: &RawDataSubTask{args: &args.RawDataSubTaskArgs, table: "_raw_test", params: `{"id":1}`},
+		args:           args,
+	}, ctx
+}
+
+func TestApiExtractor_GetConcurrency(t *testing.T) {
+	tests := []struct {
+		name        string
+		concurrency int
+		config      map[string]string
+		want        int
+	}{
+		{"sequential by default", 0, nil, 1},
+		{"sequential", 1, nil, 1},
+		{"opted in", 4, nil, 4},
+		{"capped by the default pool", 80, nil, 49},
+		{"configured", 4, map[string]string{"EXTRACTOR_CONCURRENCY": "8"}, 8},
+		{"configured for extractors not safe to run concurrently", 0, map[string]string{"EXTRACTOR_CONCURRENCY": "8"}, 1},
+		{"configured, capped by the pool", 4, map[string]string{"EXTRACTOR_CONCURRENCY": "30", "DB_MAX_CONNS": "21"}, 10},
+		{"opted in, capped by the pool", 16, map[string]string{"DB_MAX_CONNS": "9"}, 4},
+		{"configured to zero", 4, map[string]string{"EXTRACTOR_CONCURRENCY": "0"}, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			extractor, ctx := newExtractorForTest(tt.concurrency, nil)
+			ctx.config = tt.config
+			got, err := extractor.getConcurrency()
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	extractor, ctx := newExtractorForTest(4, nil)
+	ctx.config = map[string]string{"EXTRACTOR_CONCURRENCY": "many"}
+	_, err := extractor.getConcurrency()
+	assert.NotNil(t, err)
+}
+
+func TestApiExtractor_ExtractConcurrently(t *testing.T) {
+	extractor, ctx := newExtractorForTest(4, func(row *RawData) ([]interface{}, errors.Error) {
+		return []interface{}{&extractorTestRecord{ID: int(row.ID)}}, nil
+	})
+	divider := NewBatchSaveDivider(ctx, extractor.args.BatchSize, extractor.table, extractor.params)
+	err := extractor.extractConcurrently(&extractorTestRows{count: 100}, divider, 4)
+	assert.Nil(t, err)
+	assert.Nil(t, divider.Close())
+
+	sort.Ints(ctx.db.saved)
+	assert.Len(t, ctx.db.saved, 100)
+	for i, id := range ctx.db.saved {
+		assert.Equal(t, i+1, id)
+	}
+	assert.Equal(t, 100, ctx.progress)
+}
+
+func TestApiExtractor_ExtractConcurrentlyStopsOnError(t *testing.T) {
+	extractor, ctx := newExtractorForTest(4, func(row *RawData) ([]interface{}, errors.Error) {
+		if row.ID == 10 {
+			return nil, errors.Default.New("bad row")
+		}
+		return []interface{}{&extractorTestRecord{ID: int(row.ID)}}, nil
+	})
+	divider := NewBatchSaveDivider(ctx, extractor.args.BatchSize, extractor.table, extractor.params)
+	rows := &extractorTestRows{count: 1000}
+	err := extractor.extractConcurrently(rows, divider, 4)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "bad row")
+	assert.Less(t, rows.next, 1000)
+}
@@ -90,6 +90,17 @@ func (d *BatchSaveDivider) ForType(rowType reflect.Type) (*BatchSave, errors.Err
 	return batch, nil
 }
 
+// Flush saves the cached records of all batches
+func (d *BatchSaveDivider) Flush() errors.Error {
+	for _, batch := range d.batches {
+		err := batch.Flush()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Close all batches so the rest records get saved into db
 func (d *BatchSaveDivider) Close() errors.Error {
 	for _, batch := range d.batches {
@@ -0,0 +1,122 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+)
+
+// BatchUpdateSize is the number of rows a BatchUpdater updates with a single statement
+const BatchUpdateSize = 500
+
+// BatchUpdater updates columns of rows picked by a key column. Rows getting the same values are updated together,
+// with one statement per BatchUpdateSize rows, which suits columns taking a handful of distinct values like
+// flags, counts or statuses. Pointer values are compared by the values they point to
+type BatchUpdater struct {
+	db        dal.Dal
+	table     interface{}
+	keyColumn string
+	scope     []dal.Clause
+	groups    map[string]*batchUpdateGroup
+}
+
+type batchUpdateGroup struct {
+	set  []dal.DalSet
+	keys []interface{}
+}
+
+// NewBatchUpdater creates a BatchUpdater of the table, a model or a table name, picking rows by keyColumn. The scope
+// clauses narrow the rows down further, for keys only unique along with other columns like the connection id
+func NewBatchUpdater(db dal.Dal, table interface{}, keyColumn string, scope ...dal.Clause) *BatchUpdater {
+	return &BatchUpdater{
+		db:        db,
+		table:     table,
+		keyColumn: keyColumn,
+		scope:     scope,
+		groups:    make(map[string]*batchUpdateGroup),
+	}
+}
+
+// Add queues the update of the row, the rows getting the same values get updated once BatchUpdateSize of them
+// are queued
+func (u *BatchUpdater) Add(key interface{}, set ...dal.DalSet) errors.Error {
+	groupKey := batchUpdateGroupKey(set)
+	group, ok := u.groups[groupKey]
+	if !ok {
+		group = &batchUpdateGroup{set: set}
+		u.groups[groupKey] = group
+	}
+	group.keys = append(group.keys, key)
+	if len(group.keys) < BatchUpdateSize {
+		return nil
+	}
+	return u.update(group)
+}
+
+// Flush updates the rows still queued
+func (u *BatchUpdater) Flush() errors.Error {
+	groupKeys := make([]string, 0, len(u.groups))
+	for groupKey := range u.groups {
+		groupKeys = append(groupKeys, groupKey)
+	}
+	sort.Strings(groupKeys)
+	for _, groupKey := range groupKeys {
+		err := u.update(u.groups[groupKey])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (u *BatchUpdater) update(group *batchUpdateGroup) errors.Error {
+	if len(group.keys) == 0 {
+		return nil
+	}
+	clauses := append([]dal.Clause{dal.Where(fmt.Sprintf("%s IN ?", u.keyColumn), group.keys)}, u.scope...)
+	err := u.db.UpdateColumns(u.table, group.set, clauses...)
+	group.keys = group.keys[:0]
+	return err
+}
+
+// batchUpdateGroupKey identifies the values set, the columns and their values in order
+func batchUpdateGroupKey(set []dal.DalSet) string {
+	parts := make([]string, len(set))
+	for i, s := range set {
+		value := reflect.ValueOf(s.Value)
+		if value.Kind() == reflect.Ptr {
+			if value.IsNil() {
+				parts[i] = fmt.Sprintf("%s=<nil>", s.ColumnName)
+				continue
+			}
+			value = value.Elem()
+		}
+		if !value.IsValid() {
+			parts[i] = fmt.Sprintf("%s=<nil>", s.ColumnName)
+			continue
+		}
+		parts[i] = fmt.Sprintf("%s=%T:%v", s.ColumnName, value.Interface(), value.Interface())
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,100 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"testing"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type batchUpdaterTestDal struct {
+	dal.Dal
+	updates []batchUpdaterTestUpdate
+}
+
+type batchUpdaterTestUpdate struct {
+	set   []dal.DalSet
+	keys  []interface{}
+	scope []dal.Clause
+}
+
+func (d *batchUpdaterTestDal) UpdateColumns(_ interface{}, set []dal.DalSet, clauses ...dal.Clause) errors.Error {
+	where := clauses[0].Data.(dal.DalClause)
+	keys := where.Params[0].([]interface{})
+	update := batchUpdaterTestUpdate{set: set, keys: append([]interface{}{}, keys...)}
+	if len(clauses) > 1 {
+		update.scope = clauses[1:]
+	}
+	d.updates = append(d.updates, update)
+	return nil
+}
+
+func TestBatchUpdater(t *testing.T) {
+	db := &batchUpdaterTestDal{}
+	updater := NewBatchUpdater(db, "issues", "id")
+	one, two := 1.5, 1.5
+	assert.Nil(t, updater.Add("a", dal.DalSet{ColumnName: "depth", Value: 1}))
+	assert.Nil(t, updater.Add("b", dal.DalSet{ColumnName: "depth", Value: 2}))
+	assert.Nil(t, updater.Add("c", dal.DalSet{ColumnName: "depth", Value: 1}))
+	// pointers are grouped by the values they point to
+	assert.Nil(t, updater.Add("d", dal.DalSet{ColumnName: "progress", Value: &one}))
+	assert.Nil(t, updater.Add("e", dal.DalSet{ColumnName: "progress", Value: &two}))
+	assert.Nil(t, updater.Add("f", dal.DalSet{ColumnName: "progress", Value: (*float64)(nil)}))
+	assert.Empty(t, db.updates)
+
+	assert.Nil(t, updater.Flush())
+	assert.Equal(t, []batchUpdaterTestUpdate{
+		{set: []dal.DalSet{{ColumnName: "depth", Value: 1}}, keys: []interface{}{"a", "c"}},
+		{set: []dal.DalSet{{ColumnName: "depth", Value: 2}}, keys: []interface{}{"b"}},
+		{set: []dal.DalSet{{ColumnName: "progress", Value: (*float64)(nil)}}, keys: []interface{}{"f"}},
+		{set: []dal.DalSet{{ColumnName: "progress", Value: &one}}, keys: []interface{}{"d", "e"}},
+	}, db.updates)
+
+	// nothing left once flushed
+	assert.Nil(t, updater.Flush())
+	assert.Len(t, db.updates, 4)
+}
+
+func TestBatchUpdaterFullBatch(t *testing.T) {
+	db := &batchUpdaterTestDal{}
+	updater := NewBatchUpdater(db, "issues", "id")
+	for i := 0; i < BatchUpdateSize+1; i++ {
+		assert.Nil(t, updater.Add(i, dal.DalSet{ColumnName: "blocked", Value: true}))
+	}
+	assert.Len(t, db.updates, 1)
+	assert.Len(t, db.updates[0].keys, BatchUpdateSize)
+	assert.Nil(t, updater.Flush())
+	assert.Equal(t, []interface{}{BatchUpdateSize}, db.updates[1].keys)
+}
+
+func TestBatchUpdaterScope(t *testing.T) {
+	db := &batchUpdaterTestDal{}
+	updater := NewBatchUpdater(db, "_tool_tasks", "id", dal.Where("connection_id = ?", 1))
+	assert.Nil(t, updater.Add(7, dal.DalSet{ColumnName: "overdue", Value: true}))
+	assert.Nil(t, updater.Flush())
+	assert.Equal(t, []batchUpdaterTestUpdate{
+		{
+			set:   []dal.DalSet{{ColumnName: "overdue", Value: true}},
+			keys:  []interface{}{7},
+			scope: []dal.Clause{dal.Where("connection_id = ?", 1)},
+		},
+	}, db.updates)
+}
@@ -40,9 +40,15 @@ func (ba *BasicAuth) GetEncodedToken() string {
 	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%v:%v", ba.Username, ba.Password)))
 }
 
-// SetupAuthentication sets up the request headers for authentication
+// SetupAuthentication sets up the request headers for authentication, a Password referencing a secret is resolved
+// for every request and never stored
 func (ba *BasicAuth) SetupAuthentication(request *http.Request) errors.Error {
-	request.Header.Set("Authorization", fmt.Sprintf("Basic %v", ba.GetEncodedToken()))
+	password, err := ResolveSecret(ba.Password)
+	if err != nil {
+		return err
+	}
+	resolved := BasicAuth{Username: ba.Username, Password: password}
+	request.Header.Set("Authorization", fmt.Sprintf("Basic %v", resolved.GetEncodedToken()))
 	return nil
 }
 
@@ -59,9 +65,14 @@ type AccessToken struct {
 	Token string `mapstructure:"token" validate:"required" json:"token" gorm:"serializer:encdec"`
 }
 
-// SetupAuthentication sets up the request headers for authentication
+// SetupAuthentication sets up the request headers for authentication, a Token referencing a secret is resolved
+// for every request and never stored
 func (at *AccessToken) SetupAuthentication(request *http.Request) errors.Error {
-	request.Header.Set("Authorization", fmt.Sprintf("Bearer %v", at.Token))
+	token, err := ResolveSecret(at.Token)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Authorization", fmt.Sprintf("Bearer %v", token))
 	return nil
 }
 
@@ -0,0 +1,151 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models"
+)
+
+// ConvertorCheckpoint makes a DataConverter resumable, the input must be ordered by a unique key so that the
+// conversion can carry on after the key of the last input row saved by a failed run. The checkpoint is only
+// resumed on the raw data it was saved on, it gets discarded once the raw data was collected again.
+//
+//	checkpoint, err := api.NewConvertorCheckpoint(rawDataSubTaskArgs, "_tool_jira_issues.issue_id", "IssueId")
+//	cursor, err := db.Cursor(append(clauses, checkpoint.Clauses()...)...)
+//	converter, err := api.NewDataConverter(api.DataConverterArgs{..., Checkpoint: checkpoint})
+type ConvertorCheckpoint struct {
+	db     dal.Dal
+	column string
+	field  string
+	state  *models.ConvertorCheckpoint
+	resume bool
+}
+
+// NewConvertorCheckpoint loads the checkpoint of the subtask for the scope of `args`, `column` is the key column of
+// the input query and `field` the matching field of the input row
+func NewConvertorCheckpoint(args RawDataSubTaskArgs, column, field string) (*ConvertorCheckpoint, errors.Error) {
+	rawDataSubTask, err := NewRawDataSubTask(args)
+	if err != nil {
+		return nil, err
+	}
+	ctx := args.Ctx
+	db := ctx.GetDal()
+	rawDataVersion, err := getRawDataVersion(db, rawDataSubTask.table, rawDataSubTask.params)
+	if err != nil {
+		return nil, err
+	}
+	state := &models.ConvertorCheckpoint{}
+	err = db.First(state, dal.Where("subtask_name = ? AND raw_data_params = ?", ctx.GetName(), rawDataSubTask.params))
+	if err != nil {
+		if !db.IsErrorNotFound(err) {
+			return nil, errors.Default.Wrap(err, "failed to load convertor checkpoint")
+		}
+		state = &models.ConvertorCheckpoint{SubtaskName: ctx.GetName(), RawDataParams: rawDataSubTask.params}
+	} else if state.RawDataVersion != rawDataVersion {
+		// outputs saved before the checkpoint are stale, the whole input must be converted again
+		ctx.GetLogger().Info("discarding convertor checkpoint %s saved on other raw data", state.LastKey)
+		state.LastKey = ""
+	}
+	state.RawDataVersion = rawDataVersion
+	return &ConvertorCheckpoint{
+		db:     db,
+		column: column,
+		field:  field,
+		state:  state,
+		resume: state.LastKey != "",
+	}, nil
+}
+
+// Resuming tells whether a previous run left a checkpoint
+func (c *ConvertorCheckpoint) Resuming() bool {
+	return c.resume
+}
+
+// LastKey returns the key the conversion resumes after
+func (c *ConvertorCheckpoint) LastKey() string {
+	return c.state.LastKey
+}
+
+// Clauses orders the input by the key, skipping the rows converted already when resuming
+func (c *ConvertorCheckpoint) Clauses() []dal.Clause {
+	clauses := []dal.Clause{dal.Orderby(c.column + " ASC")}
+	if !c.resume {
+		return clauses
+	}
+	var lastKey interface{} = c.state.LastKey
+	if c.state.Numeric {
+		if key, err := strconv.ParseInt(c.state.LastKey, 10, 64); err == nil {
+			lastKey = key
+		}
+	}
+	return append(clauses, dal.Where(c.column+" > ?", lastKey))
+}
+
+// getRawDataVersion identifies the raw data of the scope by its row count and latest id, collecting the raw data
+// again, even incrementally, inserts new rows and thus changes it
+func getRawDataVersion(db dal.Dal, table, params string) (string, errors.Error) {
+	if !db.HasTable(table) {
+		return "", nil
+	}
+	var versions []struct {
+		Total int64
+		MaxId uint64
+	}
+	err := db.All(&versions,
+		dal.Select("COUNT(*) AS total, COALESCE(MAX(id), 0) AS max_id"),
+		dal.From(table),
+		dal.Where("params = ?", params),
+	)
+	if err != nil {
+		return "", errors.Default.Wrap(err, "failed to load raw data version")
+	}
+	if len(versions) == 0 {
+		return "", nil
+	}
+	return fmt.Sprintf("%d:%d", versions[0].Total, versions[0].MaxId), nil
+}
+
+// save records the key of the input row, all outputs up to the row must have been flushed
+func (c *ConvertorCheckpoint) save(inputRow interface{}) errors.Error {
+	value := reflect.Indirect(reflect.ValueOf(inputRow)).FieldByName(c.field)
+	if !value.IsValid() {
+		return errors.Default.New(fmt.Sprintf("input row has no checkpoint field %s", c.field))
+	}
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		c.state.Numeric = true
+	default:
+		c.state.Numeric = false
+	}
+	c.state.LastKey = fmt.Sprint(value.Interface())
+	return c.db.CreateOrUpdate(c.state)
+}
+
+// clear removes the checkpoint after a successful conversion
+func (c *ConvertorCheckpoint) clear() errors.Error {
+	return c.db.Delete(&models.ConvertorCheckpoint{}, dal.Where(
+		"subtask_name = ? AND raw_data_params = ?", c.state.SubtaskName, c.state.RawDataParams,
+	))
+}
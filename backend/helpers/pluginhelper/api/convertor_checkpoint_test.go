@@ -0,0 +1,148 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/log"
+	"github.com/apache/incubator-devlake/core/models"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/stretchr/testify/assert"
+)
+
+var errCheckpointNotFound = errors.NotFound.New("record not found")
+
+type checkpointTestDal struct {
+	dal.Dal
+	rawTotal   int64
+	rawMaxId   uint64
+	checkpoint *models.ConvertorCheckpoint
+}
+
+func (d *checkpointTestDal) HasTable(interface{}) bool {
+	return true
+}
+
+func (d *checkpointTestDal) All(dst interface{}, _ ...dal.Clause) errors.Error {
+	versions := reflect.ValueOf(dst).Elem()
+	version := reflect.New(versions.Type().Elem()).Elem()
+	version.FieldByName("Total").SetInt(d.rawTotal)
+	version.FieldByName("MaxId").SetUint(d.rawMaxId)
+	versions.Set(reflect.Append(versions, version))
+	return nil
+}
+
+func (d *checkpointTestDal) First(dst interface{}, _ ...dal.Clause) errors.Error {
+	if d.checkpoint == nil {
+		return errCheckpointNotFound
+	}
+	*dst.(*models.ConvertorCheckpoint) = *d.checkpoint
+	return nil
+}
+
+func (d *checkpointTestDal) IsErrorNotFound(err error) bool {
+	return err == errCheckpointNotFound
+}
+
+func (d *checkpointTestDal) CreateOrUpdate(entity interface{}, _ ...dal.Clause) errors.Error {
+	saved := *entity.(*models.ConvertorCheckpoint)
+	d.checkpoint = &saved
+	return nil
+}
+
+func (d *checkpointTestDal) Delete(interface{}, ...dal.Clause) errors.Error {
+	d.checkpoint = nil
+	return nil
+}
+
+type checkpointTestContext struct {
+	plugin.SubTaskContext
+	db *checkpointTestDal
+}
+
+func (c *checkpointTestContext) GetDal() dal.Dal       { return c.db }
+func (c *checkpointTestContext) GetName() string       { return "convertIssues" }
+func (c *checkpointTestContext) GetLogger() log.Logger { return &extractorTestLogger{} }
+
+type checkpointTestRow struct {
+	IssueId uint64
+}
+
+func newCheckpointForTest(t *testing.T, db *checkpointTestDal) *ConvertorCheckpoint {
+	checkpoint, err := NewConvertorCheckpoint(RawDataSubTaskArgs{
+		Ctx:    &checkpointTestContext{db: db},
+		Params: map[string]uint64{"ConnectionId": 1, "BoardId": 2},
+		Table:  "jira_api_issues",
+	}, "_tool_jira_issues.issue_id", "IssueId")
+	assert.Nil(t, err)
+	return checkpoint
+}
+
+func TestConvertorCheckpoint_SaveAndClear(t *testing.T) {
+	db := &checkpointTestDal{rawTotal: 3, rawMaxId: 12}
+	checkpoint := newCheckpointForTest(t, db)
+	assert.False(t, checkpoint.Resuming())
+	assert.Equal(t, []dal.Clause{dal.Orderby("_tool_jira_issues.issue_id ASC")}, checkpoint.Clauses())
+
+	assert.Nil(t, checkpoint.save(&checkpointTestRow{IssueId: 42}))
+	assert.Equal(t, "convertIssues", db.checkpoint.SubtaskName)
+	assert.Equal(t, `{"BoardId":2,"ConnectionId":1}`, db.checkpoint.RawDataParams)
+	assert.Equal(t, "42", db.checkpoint.LastKey)
+	assert.True(t, db.checkpoint.Numeric)
+	assert.Equal(t, "3:12", db.checkpoint.RawDataVersion)
+
+	assert.Nil(t, checkpoint.clear())
+	assert.Nil(t, db.checkpoint)
+}
+
+func TestConvertorCheckpoint_Resume(t *testing.T) {
+	db := &checkpointTestDal{rawTotal: 3, rawMaxId: 12, checkpoint: &models.ConvertorCheckpoint{
+		SubtaskName:    "convertIssues",
+		LastKey:        "42",
+		Numeric:        true,
+		RawDataVersion: "3:12",
+	}}
+	checkpoint := newCheckpointForTest(t, db)
+	assert.True(t, checkpoint.Resuming())
+	assert.Equal(t, "42", checkpoint.LastKey())
+	assert.Equal(t, []dal.Clause{
+		dal.Orderby("_tool_jira_issues.issue_id ASC"),
+		dal.Where("_tool_jira_issues.issue_id > ?", int64(42)),
+	}, checkpoint.Clauses())
+}
+
+func TestConvertorCheckpoint_DiscardedOnNewRawData(t *testing.T) {
+	db := &checkpointTestDal{rawTotal: 3, rawMaxId: 15, checkpoint: &models.ConvertorCheckpoint{
+		SubtaskName:    "convertIssues",
+		LastKey:        "42",
+		Numeric:        true,
+		RawDataVersion: "3:12",
+	}}
+	checkpoint := newCheckpointForTest(t, db)
+	assert.False(t, checkpoint.Resuming())
+	assert.Len(t, checkpoint.Clauses(), 1)
+
+	// the next save records the new raw data
+	assert.Nil(t, checkpoint.save(&checkpointTestRow{IssueId: 7}))
+	assert.Equal(t, "7", db.checkpoint.LastKey)
+	assert.Equal(t, "3:15", db.checkpoint.RawDataVersion)
+}
@@ -36,6 +36,7 @@ type DataConvertHandler func(row interface{}) ([]interface{}, errors.Error)
 //				RawDataSubTaskArgs: args about raw data task
 //				Convert: 			main function including conversion logic
 //				BatchSize: 			batch size
+//				Checkpoint: 		optional, makes the conversion resumable
 type DataConverterArgs struct {
 	RawDataSubTaskArgs
 	// Domain layer entity Id prefix, i.e. `jira:JiraIssue:1`, `github:GithubIssue`
@@ -43,6 +44,8 @@ type DataConverterArgs struct {
 	Input        dal.Rows
 	Convert      DataConvertHandler
 	BatchSize    int
+	// Checkpoint is saved every `BatchSize` input rows, the Input must be built with `Checkpoint.Clauses()`
+	Checkpoint *ConvertorCheckpoint
 }
 
 // DataConverter helps you convert Data from Tool Layer Tables to Domain Layer Tables
@@ -76,12 +79,23 @@ func NewDataConverter(args DataConverterArgs) (*DataConverter, errors.Error) {
 // It loads data from Tool Layer Tables using `Ctx.GetDal()`, convert Data using `converter.args.Convert` handler
 // Then save data to Domain Layer Tables using BatchSaveDivider
 func (converter *DataConverter) Execute() errors.Error {
+	if IsRawDataStaged(converter.args.Ctx) {
+		converter.args.Ctx.GetLogger().Info("raw data is staged, skipping conversion")
+		return nil
+	}
 	// load data from database
 	db := converter.args.Ctx.GetDal()
 
 	// batch save divider
 	RAW_DATA_ORIGIN := "RawDataOrigin"
-	divider := NewBatchSaveDivider(converter.args.Ctx, converter.args.BatchSize, converter.table, converter.params)
+	checkpoint := converter.args.Checkpoint
+	table := converter.table
+	if checkpoint != nil && checkpoint.Resuming() {
+		// outputs of the rows before the checkpoint were saved by the interrupted run, they must not be deleted
+		converter.args.Ctx.GetLogger().Info("resuming conversion after checkpoint %s", checkpoint.LastKey())
+		table = ""
+	}
+	divider := NewBatchSaveDivider(converter.args.Ctx, converter.args.BatchSize, table, converter.params)
 
 	// set progress
 	converter.args.Ctx.SetProgress(0, -1)
@@ -89,6 +103,7 @@ func (converter *DataConverter) Execute() errors.Error {
 	cursor := converter.args.Input
 	defer cursor.Close()
 	ctx := converter.args.Ctx.GetContext()
+	processed := 0
 	// iterate all rows
 	for cursor.Next() {
 		select {
@@ -125,10 +140,25 @@ func (converter *DataConverter) Execute() errors.Error {
 			}
 		}
 		converter.args.Ctx.IncProgress(1)
+		processed++
+		if checkpoint != nil && processed%converter.args.BatchSize == 0 {
+			err = divider.Flush()
+			if err != nil {
+				return err
+			}
+			err = checkpoint.save(inputRow)
+			if err != nil {
+				return errors.Default.Wrap(err, "error saving convertor checkpoint")
+			}
+		}
 	}
 
 	// save the last batches
-	return divider.Close()
+	err := divider.Close()
+	if err != nil || checkpoint == nil {
+		return err
+	}
+	return checkpoint.clear()
 }
 
 // Check if DataConverter implements SubTask interface
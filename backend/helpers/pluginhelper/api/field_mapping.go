@@ -0,0 +1,179 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apache/incubator-devlake/core/errors"
+	"golang.org/x/exp/slices"
+)
+
+// FieldMappings maps domain fields to the source fields they are read from, like `{"storyPoint": "customfield_10016"}`,
+// so that plugins pick a new source field out of configuration only. Nested source fields are reached with dots,
+// e.g. `customfield_10020.value`. Each plugin applies the domain fields it supports
+type FieldMappings map[string]string
+
+const (
+	FieldMappingStoryPoint = "storyPoint"
+	FieldMappingStartDate  = "startDate"
+	FieldMappingTeamId     = "teamId"
+)
+
+var fieldMappingTargets = []string{FieldMappingStoryPoint, FieldMappingStartDate, FieldMappingTeamId}
+
+// fieldValueKeys are the keys holding the value of object fields, like the options or users of Jira custom fields,
+// in the order they are looked up
+var fieldValueKeys = []string{"value", "name", "displayName", "title", "id"}
+
+// Validate rejects mappings to unknown domain fields or from empty source fields
+func (m FieldMappings) Validate() errors.Error {
+	for target, source := range m {
+		if !slices.Contains(fieldMappingTargets, target) {
+			return errors.BadInput.New("unknown target in fieldMappings: " + target)
+		}
+		if strings.TrimSpace(source) == "" {
+			return errors.BadInput.New("empty source field in fieldMappings for " + target)
+		}
+	}
+	return nil
+}
+
+// FieldResolver reads the domain fields of FieldMappings out of the fields of a source record, coercing the values
+// to the type of the domain field
+type FieldResolver struct {
+	mappings FieldMappings
+	fields   map[string]interface{}
+}
+
+// NewFieldResolver creates a resolver for one source record
+func NewFieldResolver(mappings FieldMappings, fields map[string]interface{}) *FieldResolver {
+	return &FieldResolver{
+		mappings: mappings,
+		fields:   fields,
+	}
+}
+
+// Value returns the value of the source field mapped to target, nil if target isn't mapped or the field is missing
+func (r *FieldResolver) Value(target string) interface{} {
+	source, ok := r.mappings[target]
+	if !ok {
+		return nil
+	}
+	var value interface{} = r.fields
+	for _, key := range strings.Split(source, ".") {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = object[key]
+	}
+	return value
+}
+
+// String returns the mapped value as a string, the second return value is false if it is missing or empty
+func (r *FieldResolver) String(target string) (string, bool) {
+	return coerceString(r.Value(target))
+}
+
+// Float returns the mapped value as a number, the second return value is false if it is missing or not numeric
+func (r *FieldResolver) Float(target string) (float64, bool) {
+	return coerceFloat(r.Value(target))
+}
+
+// Time returns the mapped value as a time, the second return value is false if it is missing or not a time
+func (r *FieldResolver) Time(target string) (*time.Time, bool) {
+	return coerceTime(r.Value(target))
+}
+
+func coerceString(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		v = strings.TrimSpace(v)
+		return v, v != ""
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case int:
+		return strconv.Itoa(v), true
+	case int64:
+		return strconv.FormatInt(v, 10), true
+	case json.Number:
+		return v.String(), true
+	case bool:
+		return strconv.FormatBool(v), true
+	case map[string]interface{}:
+		for _, key := range fieldValueKeys {
+			if s, ok := coerceString(v[key]); ok {
+				return s, true
+			}
+		}
+	case []interface{}:
+		// multi-value fields resolve to their first value
+		for _, item := range v {
+			if s, ok := coerceString(item); ok {
+				return s, true
+			}
+		}
+	}
+	return "", false
+}
+
+func coerceFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case bool, nil:
+		return 0, false
+	}
+	s, ok := coerceString(value)
+	if !ok {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, false
+	}
+	return f, true
+}
+
+func coerceTime(value interface{}) (*time.Time, bool) {
+	switch v := value.(type) {
+	case time.Time:
+		return &v, true
+	case *time.Time:
+		return v, v != nil
+	case string, map[string]interface{}, []interface{}:
+		s, ok := coerceString(v)
+		if !ok {
+			return nil, false
+		}
+		t, err := ConvertStringToTime(s)
+		if err != nil {
+			return nil, false
+		}
+		return &t, true
+	}
+	return nil, false
+}
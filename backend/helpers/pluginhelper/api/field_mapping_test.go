@@ -0,0 +1,89 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldMappingsValidate(t *testing.T) {
+	assert.Nil(t, FieldMappings{FieldMappingStoryPoint: "customfield_10016"}.Validate())
+	assert.Nil(t, FieldMappings(nil).Validate())
+	assert.NotNil(t, FieldMappings{"storyPoints": "customfield_10016"}.Validate())
+	assert.NotNil(t, FieldMappings{FieldMappingStartDate: " "}.Validate())
+	assert.Nil(t, FieldMappings{FieldMappingTeamId: "customfield_10001"}.Validate())
+}
+
+func TestFieldResolver(t *testing.T) {
+	var fields map[string]interface{}
+	err := json.Unmarshal([]byte(`{
+		"customfield_1": "5",
+		"customfield_2": 3.5,
+		"customfield_3": {"self": "https://jira", "value": "Platform", "id": "10100"},
+		"customfield_4": [{"name": "Sprint 1"}, {"name": "Sprint 2"}],
+		"customfield_5": "2023-08-01T10:00:00.000+0800",
+		"customfield_6": "not a number",
+		"nested": {"team": {"id": "team-1"}}
+	}`), &fields)
+	assert.Nil(t, err)
+
+	resolve := func(source string) *FieldResolver {
+		return NewFieldResolver(FieldMappings{
+			FieldMappingStoryPoint: source,
+			FieldMappingStartDate:  source,
+			FieldMappingTeamId:     source,
+		}, fields)
+	}
+
+	f, ok := resolve("customfield_1").Float(FieldMappingStoryPoint)
+	assert.True(t, ok)
+	assert.Equal(t, 5.0, f)
+	f, ok = resolve("customfield_2").Float(FieldMappingStoryPoint)
+	assert.True(t, ok)
+	assert.Equal(t, 3.5, f)
+	_, ok = resolve("customfield_6").Float(FieldMappingStoryPoint)
+	assert.False(t, ok)
+
+	s, ok := resolve("customfield_2").String(FieldMappingStoryPoint)
+	assert.True(t, ok)
+	assert.Equal(t, "3.5", s)
+	s, _ = resolve("customfield_3").String(FieldMappingStoryPoint)
+	assert.Equal(t, "Platform", s)
+	s, _ = resolve("customfield_4").String(FieldMappingStoryPoint)
+	assert.Equal(t, "Sprint 1", s)
+	s, _ = resolve("nested.team").String(FieldMappingTeamId)
+	assert.Equal(t, "team-1", s)
+
+	date, ok := resolve("customfield_5").Time(FieldMappingStartDate)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2023, 8, 1, 2, 0, 0, 0, time.UTC), date.UTC())
+	_, ok = resolve("customfield_6").Time(FieldMappingStartDate)
+	assert.False(t, ok)
+
+	// unmapped targets and missing fields resolve to nothing
+	_, ok = NewFieldResolver(FieldMappings{}, fields).String(FieldMappingStoryPoint)
+	assert.False(t, ok)
+	_, ok = resolve("customfield_404").Float(FieldMappingStoryPoint)
+	assert.False(t, ok)
+	_, ok = resolve("customfield_1.value").String(FieldMappingStoryPoint)
+	assert.False(t, ok)
+}
@@ -0,0 +1,137 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+)
+
+// RAW_STAGING_SUFFIX is appended to the raw tables receiving the data collected in staging mode
+const RAW_STAGING_SUFFIX = "_staging"
+
+// RawDataStager is implemented by task data able to run in staging mode, where collectors write into staging raw
+// tables, `_raw_<table>_staging`, leaving the production raw tables and their collector states untouched, and
+// the subtasks other than collectors are skipped, see plugin.SubTaskMeta.RunsWhenStaged
+type RawDataStager = plugin.RawDataStager
+
+// IsRawDataStaged tells whether the subtask runs in staging mode
+func IsRawDataStaged(ctx plugin.SubTaskContext) bool {
+	stager, ok := ctx.GetData().(RawDataStager)
+	return ok && stager.IsRawDataStaged()
+}
+
+// StagingRawTable returns the staging raw table of a raw table
+func StagingRawTable(table string) string {
+	return table + RAW_STAGING_SUFFIX
+}
+
+// RawDataDiff counts the entities of a raw table which changed between the production and staging raw data
+type RawDataDiff struct {
+	Table     string
+	Params    string
+	Added     int
+	Changed   int
+	Removed   int
+	Unchanged int
+}
+
+// DiffRawData compares the staging raw data of the given raw table and params against the production one. Rows are
+// matched by the `id`, or `key`, of their data, rows without any are matched by their whole data
+func DiffRawData(db dal.Dal, table string, params string) (*RawDataDiff, errors.Error) {
+	diff := &RawDataDiff{Table: table, Params: params}
+	production := make(map[string]string)
+	if db.HasTable(table) {
+		err := scanRawData(db, table, params, func(key, hash string) {
+			production[key] = hash
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	seen := make(map[string]bool)
+	err := scanRawData(db, StagingRawTable(table), params, func(key, hash string) {
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		previous, ok := production[key]
+		switch {
+		case !ok:
+			diff.Added++
+		case previous != hash:
+			diff.Changed++
+		default:
+			diff.Unchanged++
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	for key := range production {
+		if !seen[key] {
+			diff.Removed++
+		}
+	}
+	return diff, nil
+}
+
+func scanRawData(db dal.Dal, table string, params string, scan func(key, hash string)) errors.Error {
+	cursor, err := db.Cursor(
+		dal.Select("id, data"),
+		dal.From(table),
+		dal.Where("params = ?", params),
+		dal.Orderby("id"),
+	)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+	for cursor.Next() {
+		row := &RawData{}
+		err = db.Fetch(cursor, row)
+		if err != nil {
+			return err
+		}
+		scan(rawDataKey(row.Data))
+	}
+	return nil
+}
+
+// rawDataKey returns the key identifying the entity of a raw row along with the hash of its data
+func rawDataKey(data []byte) (string, string) {
+	hash := fmt.Sprintf("%x", sha256.Sum256(data))
+	var entity struct {
+		Id  interface{} `json:"id"`
+		Key interface{} `json:"key"`
+	}
+	if json.Unmarshal(data, &entity) == nil {
+		if entity.Id != nil {
+			return fmt.Sprintf("id:%v", entity.Id), hash
+		}
+		if entity.Key != nil {
+			return fmt.Sprintf("key:%v", entity.Key), hash
+		}
+	}
+	return "hash:" + hash, hash
+}
@@ -0,0 +1,42 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRawDataKey(t *testing.T) {
+	key, hash := rawDataKey([]byte(`{"id":"10001","fields":{"summary":"a"}}`))
+	assert.Equal(t, "id:10001", key)
+	_, changedHash := rawDataKey([]byte(`{"id":"10001","fields":{"summary":"b"}}`))
+	assert.NotEqual(t, hash, changedHash)
+
+	key, _ = rawDataKey([]byte(`{"id":42,"name":"sprint"}`))
+	assert.Equal(t, "id:42", key)
+	key, _ = rawDataKey([]byte(`{"key":"DL-1"}`))
+	assert.Equal(t, "key:DL-1", key)
+	key, hash = rawDataKey([]byte(`[1,2]`))
+	assert.Equal(t, "hash:"+hash, key)
+}
+
+func TestStagingRawTable(t *testing.T) {
+	assert.Equal(t, "_raw_jira_api_issues_staging", StagingRawTable("_raw_jira_api_issues"))
+}
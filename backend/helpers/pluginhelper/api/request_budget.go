@@ -0,0 +1,106 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/apache/incubator-devlake/core/plugin"
+)
+
+// RequestBudget counts the requests submitted against a cap, all the clients sharing it draw from the same count.
+// It also records the raw tables whose collection got cut short by the cap, as their raw data is incomplete
+type RequestBudget struct {
+	limit     int64
+	submitted int64
+	refused   int64
+	exhausted int32
+	mutex     sync.Mutex
+	cutShort  map[string]bool
+}
+
+// NewRequestBudget creates a RequestBudget of `limit` requests, nil for a non-positive limit meaning no cap
+func NewRequestBudget(limit int) *RequestBudget {
+	if limit <= 0 {
+		return nil
+	}
+	return &RequestBudget{limit: int64(limit)}
+}
+
+// IsExhausted tells whether the budget is used up, a nil budget never is
+func (b *RequestBudget) IsExhausted() bool {
+	return b != nil && atomic.LoadInt64(&b.submitted) >= b.limit
+}
+
+// take consumes one request from the budget, returns false if the budget is used up
+func (b *RequestBudget) take() bool {
+	if b == nil {
+		return true
+	}
+	if atomic.AddInt64(&b.submitted, 1) <= b.limit {
+		return true
+	}
+	atomic.AddInt64(&b.refused, 1)
+	return false
+}
+
+// refusals returns the number of requests refused so far
+func (b *RequestBudget) refusals() int64 {
+	if b == nil {
+		return 0
+	}
+	return atomic.LoadInt64(&b.refused)
+}
+
+// markCutShort records that the collection into the raw table missed requests refused by the budget
+func (b *RequestBudget) markCutShort(table string) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if b.cutShort == nil {
+		b.cutShort = make(map[string]bool)
+	}
+	b.cutShort[table] = true
+}
+
+// IsCutShort tells whether the collection into the raw table missed requests refused by the budget, a nil budget
+// never cuts anything short
+func (b *RequestBudget) IsCutShort(table string) bool {
+	if b == nil {
+		return false
+	}
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.cutShort[table]
+}
+
+// markExhausted returns true only the first time it is called, so the exhaustion gets logged once
+func (b *RequestBudget) markExhausted() bool {
+	return atomic.CompareAndSwapInt32(&b.exhausted, 0, 1)
+}
+
+// RequestBudgetHolder is implemented by task data whose api client enforces a request budget
+type RequestBudgetHolder interface {
+	GetRequestBudget() *RequestBudget
+}
+
+// isRawDataCutShort tells whether the collection into the raw table was cut short by the request budget of the task
+func isRawDataCutShort(ctx plugin.SubTaskContext, table string) bool {
+	holder, ok := ctx.GetData().(RequestBudgetHolder)
+	return ok && holder.GetRequestBudget().IsCutShort(table)
+}
@@ -0,0 +1,83 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"testing"
+
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestBudget(t *testing.T) {
+	var unlimited *RequestBudget
+	assert.Nil(t, NewRequestBudget(0))
+	assert.True(t, unlimited.take())
+	assert.False(t, unlimited.IsExhausted())
+
+	budget := NewRequestBudget(2)
+	assert.True(t, budget.take())
+	assert.False(t, budget.IsExhausted())
+	assert.True(t, budget.take())
+	assert.True(t, budget.IsExhausted())
+	assert.False(t, budget.take())
+	assert.True(t, budget.markExhausted())
+	assert.False(t, budget.markExhausted())
+	assert.Equal(t, int64(1), budget.refusals())
+}
+
+func TestRequestBudgetCutShort(t *testing.T) {
+	var unlimited *RequestBudget
+	assert.False(t, unlimited.IsCutShort("_raw_jira_api_issues"))
+	assert.Equal(t, int64(0), unlimited.refusals())
+
+	budget := NewRequestBudget(1)
+	assert.False(t, budget.IsCutShort("_raw_jira_api_issues"))
+	budget.markCutShort("_raw_jira_api_issues")
+	assert.True(t, budget.IsCutShort("_raw_jira_api_issues"))
+	assert.False(t, budget.IsCutShort("_raw_jira_api_worklogs"))
+}
+
+type requestBudgetTestData struct {
+	budget *RequestBudget
+}
+
+func (d *requestBudgetTestData) GetRequestBudget() *RequestBudget {
+	return d.budget
+}
+
+type requestBudgetTestContext struct {
+	plugin.SubTaskContext
+	data interface{}
+}
+
+func (c *requestBudgetTestContext) GetData() interface{} {
+	return c.data
+}
+
+func TestIsRawDataCutShort(t *testing.T) {
+	budget := NewRequestBudget(1)
+	budget.markCutShort("_raw_jira_api_issues")
+	ctx := &requestBudgetTestContext{data: &requestBudgetTestData{budget: budget}}
+	assert.True(t, isRawDataCutShort(ctx, "_raw_jira_api_issues"))
+	assert.False(t, isRawDataCutShort(ctx, "_raw_jira_api_worklogs"))
+
+	// task data without a budget
+	assert.False(t, isRawDataCutShort(&requestBudgetTestContext{data: &requestBudgetTestData{}}, "_raw_jira_api_issues"))
+	assert.False(t, isRawDataCutShort(&requestBudgetTestContext{data: struct{}{}}, "_raw_jira_api_issues"))
+}
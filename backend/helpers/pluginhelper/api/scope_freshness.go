@@ -0,0 +1,83 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/apache/incubator-devlake/core/errors"
+)
+
+// ScopeFreshnessBreach is a scope whose latest collection is older than its freshness SLA, LagMinutes is nil
+// when the scope was never collected
+type ScopeFreshnessBreach struct {
+	ScopeId    string     `json:"scopeId"`
+	ScopeName  string     `json:"scopeName"`
+	LastRun    *time.Time `json:"lastRun"`
+	LagMinutes *int       `json:"lagMinutes"`
+	SlaMinutes int        `json:"slaMinutes"`
+}
+
+// FreshnessAlert is the payload posted to the freshness webhook of a connection
+type FreshnessAlert struct {
+	Plugin       string                  `json:"plugin"`
+	ConnectionId uint64                  `json:"connectionId"`
+	CheckedAt    time.Time               `json:"checkedAt"`
+	Breaches     []*ScopeFreshnessBreach `json:"breaches"`
+}
+
+// CheckScopeFreshness tells whether a scope last collected at lastRun breaches its SLA at now, along with how
+// many minutes old its data is. Scopes without SLA never breach, scopes never collected always do
+func CheckScopeFreshness(now time.Time, lastRun *time.Time, sla time.Duration) (*int, bool) {
+	if lastRun == nil {
+		return nil, sla > 0
+	}
+	lag := now.Sub(*lastRun)
+	lagMinutes := int(lag.Minutes())
+	return &lagMinutes, sla > 0 && lag > sla
+}
+
+// freshnessAlertClient posts the freshness alerts, a webhook not responding must not hold the collection up
+var freshnessAlertClient = &http.Client{Timeout: 30 * time.Second}
+
+// PostFreshnessAlert posts the alert as JSON to the webhook, any response but a 2xx fails
+func PostFreshnessAlert(ctx context.Context, webhookUrl string, alert *FreshnessAlert) errors.Error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return errors.Convert(err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookUrl, bytes.NewReader(body))
+	if err != nil {
+		return errors.BadInput.Wrap(err, "invalid freshness webhook url")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := freshnessAlertClient.Do(req)
+	if err != nil {
+		return errors.Default.Wrap(err, "failed to post the freshness alert")
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return errors.HttpStatus(res.StatusCode).New(fmt.Sprintf("freshness webhook responded %d", res.StatusCode))
+	}
+	return nil
+}
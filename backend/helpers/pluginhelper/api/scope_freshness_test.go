@@ -0,0 +1,87 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckScopeFreshness(t *testing.T) {
+	now := time.Date(2023, 8, 10, 12, 0, 0, 0, time.UTC)
+	lastRun := now.Add(-90 * time.Minute)
+
+	lag, breached := CheckScopeFreshness(now, &lastRun, 2*time.Hour)
+	assert.Equal(t, 90, *lag)
+	assert.False(t, breached)
+
+	lag, breached = CheckScopeFreshness(now, &lastRun, time.Hour)
+	assert.Equal(t, 90, *lag)
+	assert.True(t, breached)
+
+	_, breached = CheckScopeFreshness(now, &lastRun, 0)
+	assert.False(t, breached)
+
+	lag, breached = CheckScopeFreshness(now, nil, time.Hour)
+	assert.Nil(t, lag)
+	assert.True(t, breached)
+}
+
+func TestPostFreshnessAlert(t *testing.T) {
+	var received FreshnessAlert
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&received))
+		if received.ConnectionId == 2 {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	alert := &FreshnessAlert{
+		Plugin:       "jira",
+		ConnectionId: 1,
+		Breaches:     []*ScopeFreshnessBreach{{ScopeId: "8", SlaMinutes: 60}},
+	}
+	assert.Nil(t, PostFreshnessAlert(context.Background(), server.URL, alert))
+	assert.Equal(t, "8", received.Breaches[0].ScopeId)
+
+	alert.ConnectionId = 2
+	assert.NotNil(t, PostFreshnessAlert(context.Background(), server.URL, alert))
+}
+
+func TestPostFreshnessAlertTimeout(t *testing.T) {
+	unblock := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-unblock
+	}))
+	defer server.Close()
+	defer close(unblock)
+
+	client := freshnessAlertClient
+	freshnessAlertClient = &http.Client{Timeout: 50 * time.Millisecond}
+	defer func() { freshnessAlertClient = client }()
+
+	assert.NotNil(t, PostFreshnessAlert(context.Background(), server.URL, &FreshnessAlert{Plugin: "jira"}))
+}
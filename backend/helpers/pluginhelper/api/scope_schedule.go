@@ -0,0 +1,54 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"time"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models"
+	"github.com/apache/incubator-devlake/core/plugin"
+)
+
+// IsScopeDue tells whether a scope refreshed every interval is due at now, given the time it was last collected.
+// Scopes without interval or never collected are always due
+func IsScopeDue(now time.Time, lastRun *time.Time, interval time.Duration) bool {
+	if interval <= 0 || lastRun == nil {
+		return true
+	}
+	return !now.Before(lastRun.Add(interval))
+}
+
+// GetScopeLastRun returns the start of the latest successful collection of the raw table for the scope, nil if
+// it was never collected
+func GetScopeLastRun(db dal.Dal, rawTable string, scopeParams interface{}) (*time.Time, errors.Error) {
+	latestState := &models.CollectorLatestState{}
+	err := db.First(latestState, dal.Where(
+		`raw_data_table = ? AND raw_data_params = ?`,
+		"_raw_"+rawTable,
+		plugin.MarshalScopeParams(scopeParams),
+	))
+	if err != nil {
+		if db.IsErrorNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return latestState.LatestSuccessStart, nil
+}
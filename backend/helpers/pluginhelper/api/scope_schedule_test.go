@@ -0,0 +1,35 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsScopeDue(t *testing.T) {
+	now := time.Date(2023, 8, 10, 12, 0, 0, 0, time.UTC)
+	lastRun := now.Add(-10 * time.Minute)
+	assert.True(t, IsScopeDue(now, nil, 15*time.Minute))
+	assert.True(t, IsScopeDue(now, &lastRun, 0))
+	assert.False(t, IsScopeDue(now, &lastRun, 15*time.Minute))
+	assert.True(t, IsScopeDue(now, &lastRun, 10*time.Minute))
+	assert.True(t, IsScopeDue(now, &lastRun, 5*time.Minute))
+}
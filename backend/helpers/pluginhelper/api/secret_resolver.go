@@ -0,0 +1,187 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/apache/incubator-devlake/core/config"
+	"github.com/apache/incubator-devlake/core/errors"
+)
+
+// SecretReferencePrefix marks a credential as a reference to a secret kept in Vault rather than the secret itself,
+// e.g. `vault:secret/data/devlake/jira#token` for the key `token` of the secret at `secret/data/devlake/jira`
+const SecretReferencePrefix = "vault:"
+
+// SecretResolver fetches the secret a reference points to
+type SecretResolver interface {
+	Resolve(reference string) (string, errors.Error)
+}
+
+var (
+	secretResolver     SecretResolver
+	secretResolverOnce sync.Once
+)
+
+// SetSecretResolver replaces the Vault resolver built from the config, mostly for tests
+func SetSecretResolver(resolver SecretResolver) {
+	secretResolverOnce.Do(func() {})
+	secretResolver = resolver
+}
+
+func getSecretResolver() SecretResolver {
+	secretResolverOnce.Do(func() {
+		v := config.GetConfig()
+		if v == nil {
+			return
+		}
+		secretResolver = NewVaultSecretResolver(
+			v.GetString("VAULT_ADDR"),
+			v.GetString("VAULT_TOKEN"),
+			v.GetString("VAULT_NAMESPACE"),
+			time.Duration(v.GetInt("VAULT_CACHE_SECONDS"))*time.Second,
+		)
+	})
+	return secretResolver
+}
+
+// IsSecretReference tells whether the credential is a reference to a secret
+func IsSecretReference(value string) bool {
+	return strings.HasPrefix(value, SecretReferencePrefix)
+}
+
+// ResolveSecret returns the credential itself, or the secret it references. Resolved secrets are only handed
+// to the caller and never written back to the connection
+func ResolveSecret(value string) (string, errors.Error) {
+	if !IsSecretReference(value) {
+		return value, nil
+	}
+	resolver := getSecretResolver()
+	if resolver == nil {
+		return "", errors.Default.New("credential references a secret but no secret storage was configured")
+	}
+	return resolver.Resolve(strings.TrimPrefix(value, SecretReferencePrefix))
+}
+
+type cachedSecret struct {
+	value     string
+	expiresAt time.Time
+}
+
+// VaultSecretResolver reads secrets from a HashiCorp Vault KV engine, both version 1 and 2 are supported
+type VaultSecretResolver struct {
+	address   string
+	token     string
+	namespace string
+	cacheTTL  time.Duration
+	client    *http.Client
+	cache     map[string]cachedSecret
+	mutex     sync.Mutex
+}
+
+// NewVaultSecretResolver creates a VaultSecretResolver, secrets are kept in memory for cacheTTL to spare Vault a
+// request for every API call, 0 disables the cache
+func NewVaultSecretResolver(address, token, namespace string, cacheTTL time.Duration) *VaultSecretResolver {
+	return &VaultSecretResolver{
+		address:   strings.TrimSuffix(address, "/"),
+		token:     token,
+		namespace: namespace,
+		cacheTTL:  cacheTTL,
+		client:    &http.Client{Timeout: 10 * time.Second},
+		cache:     make(map[string]cachedSecret),
+	}
+}
+
+// Resolve fetches the key of the secret referenced as `path#key`
+func (r *VaultSecretResolver) Resolve(reference string) (string, errors.Error) {
+	path, key, found := strings.Cut(reference, "#")
+	if !found || path == "" || key == "" {
+		return "", errors.BadInput.New(fmt.Sprintf("invalid secret reference %s, expected %spath#key", reference, SecretReferencePrefix))
+	}
+	if r.address == "" {
+		return "", errors.Default.New("VAULT_ADDR is required to resolve secret references")
+	}
+	// the lock only guards the cache, concurrent misses may fetch the same secret but never wait on each other
+	r.mutex.Lock()
+	cached, ok := r.cache[reference]
+	r.mutex.Unlock()
+	if ok && time.Now().Before(cached.expiresAt) {
+		return cached.value, nil
+	}
+	value, err := r.fetch(strings.TrimPrefix(path, "/"), key)
+	if err != nil {
+		return "", err
+	}
+	if r.cacheTTL > 0 {
+		r.mutex.Lock()
+		r.cache[reference] = cachedSecret{value: value, expiresAt: time.Now().Add(r.cacheTTL)}
+		r.mutex.Unlock()
+	}
+	return value, nil
+}
+
+func (r *VaultSecretResolver) fetch(path, key string) (string, errors.Error) {
+	req, e := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/v1/%s", r.address, path), nil)
+	if e != nil {
+		return "", errors.Convert(e)
+	}
+	req.Header.Set("X-Vault-Token", r.token)
+	if r.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", r.namespace)
+	}
+	res, e := r.client.Do(req)
+	if e != nil {
+		return "", errors.Default.Wrap(e, fmt.Sprintf("failed to read secret %s from vault", path))
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return "", errors.HttpStatus(res.StatusCode).New(fmt.Sprintf("failed to read secret %s from vault, status code: %d", path, res.StatusCode))
+	}
+	body, e := io.ReadAll(res.Body)
+	if e != nil {
+		return "", errors.Convert(e)
+	}
+	return extractVaultSecret(body, key)
+}
+
+// extractVaultSecret picks the key out of a Vault read response, KV version 2 nests the secret in `data.data`
+func extractVaultSecret(body []byte, key string) (string, errors.Error) {
+	var response struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	if e := json.Unmarshal(body, &response); e != nil {
+		return "", errors.Default.Wrap(e, "failed to decode vault response")
+	}
+	data := response.Data
+	if nested, ok := data["data"].(map[string]interface{}); ok {
+		if _, isMetadata := data["metadata"]; isMetadata {
+			data = nested
+		}
+	}
+	value, ok := data[key].(string)
+	if !ok {
+		return "", errors.NotFound.New(fmt.Sprintf("key %s not found in vault secret", key))
+	}
+	return value, nil
+}
@@ -0,0 +1,116 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractVaultSecret(t *testing.T) {
+	value, err := extractVaultSecret([]byte(`{"data":{"data":{"token":"kv2"},"metadata":{"version":1}}}`), "token")
+	assert.Nil(t, err)
+	assert.Equal(t, "kv2", value)
+
+	value, err = extractVaultSecret([]byte(`{"data":{"token":"kv1"}}`), "token")
+	assert.Nil(t, err)
+	assert.Equal(t, "kv1", value)
+
+	_, err = extractVaultSecret([]byte(`{"data":{"password":"kv1"}}`), "token")
+	assert.NotNil(t, err)
+}
+
+func TestVaultSecretResolver(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("X-Vault-Token") != "root" || r.URL.Path != "/v1/secret/data/devlake/jira" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"data":{"token":"s3cr3t"},"metadata":{"version":1}}}`))
+	}))
+	defer server.Close()
+
+	resolver := NewVaultSecretResolver(server.URL, "root", "", time.Minute)
+	value, err := resolver.Resolve("secret/data/devlake/jira#token")
+	assert.Nil(t, err)
+	assert.Equal(t, "s3cr3t", value)
+	_, err = resolver.Resolve("secret/data/devlake/jira#token")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, requests)
+
+	_, err = resolver.Resolve("secret/data/devlake/jira")
+	assert.NotNil(t, err)
+	_, err = resolver.Resolve("secret/data/devlake/other#token")
+	assert.NotNil(t, err)
+}
+
+func TestVaultSecretResolverFetchesConcurrently(t *testing.T) {
+	// the slow secret is only served once the fast one was requested, which a lock held while fetching prevents
+	fastRequested := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/secret/slow" {
+			select {
+			case <-fastRequested:
+			case <-time.After(5 * time.Second):
+				w.WriteHeader(http.StatusGatewayTimeout)
+				return
+			}
+		} else {
+			close(fastRequested)
+		}
+		_, _ = w.Write([]byte(`{"data":{"token":"s3cr3t"}}`))
+	}))
+	defer server.Close()
+
+	resolver := NewVaultSecretResolver(server.URL, "root", "", time.Minute)
+	slowErr := make(chan error)
+	go func() {
+		_, err := resolver.Resolve("secret/slow#token")
+		slowErr <- err
+	}()
+	// give the slow request a head start
+	time.Sleep(100 * time.Millisecond)
+	_, err := resolver.Resolve("secret/fast#token")
+	assert.Nil(t, err)
+	assert.Nil(t, <-slowErr)
+}
+
+func TestAccessTokenResolvesSecretReference(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"token":"s3cr3t"}}`))
+	}))
+	defer server.Close()
+	SetSecretResolver(NewVaultSecretResolver(server.URL, "root", "", 0))
+	defer SetSecretResolver(nil)
+
+	accessToken := &AccessToken{Token: "vault:secret/devlake#token"}
+	req, _ := http.NewRequest(http.MethodGet, "http://localhost", nil)
+	assert.Nil(t, accessToken.SetupAuthentication(req))
+	assert.Equal(t, "Bearer s3cr3t", req.Header.Get("Authorization"))
+	assert.Equal(t, "vault:secret/devlake#token", accessToken.Token)
+
+	plain := &AccessToken{Token: "plain"}
+	assert.Nil(t, plain.SetupAuthentication(req))
+	assert.Equal(t, "Bearer plain", req.Header.Get("Authorization"))
+}
@@ -52,6 +52,7 @@ type WorkerScheduler struct {
 	mu           sync.Mutex
 	counter      int32
 	logger       log.Logger
+	tickMu       sync.RWMutex
 	tickInterval time.Duration
 }
 
@@ -177,12 +178,16 @@ func (s *WorkerScheduler) WaitAsync() errors.Error {
 
 // Reset stops a WorkScheduler and resets its period to the specified duration.
 func (s *WorkerScheduler) Reset(interval time.Duration) {
+	s.tickMu.Lock()
+	defer s.tickMu.Unlock()
 	s.tickInterval = interval
 	s.ticker.Reset(interval)
 }
 
 // GetTickInterval returns current tick interval of the WorkScheduler
 func (s *WorkerScheduler) GetTickInterval() time.Duration {
+	s.tickMu.RLock()
+	defer s.tickMu.RUnlock()
 	return s.tickInterval
 }
 
@@ -31,5 +31,6 @@ func DummySubTaskContext(db dal.Dal) *mockplugin.SubTaskContext {
 	mockCtx.On("SetProgress", mock.Anything, mock.Anything)
 	mockCtx.On("IncProgress", mock.Anything, mock.Anything)
 	mockCtx.On("GetName").Return("test")
+	mockCtx.On("GetData").Return(nil)
 	return mockCtx
 }
@@ -36,5 +36,5 @@ func GetFileStream(path string) (io.Writer, errors.Error) {
 	if err != nil {
 		return nil, errors.Convert(err)
 	}
-	return io.MultiWriter(os.Stdout, file), nil
+	return NewRedactingWriter(io.MultiWriter(os.Stdout, file)), nil
 }
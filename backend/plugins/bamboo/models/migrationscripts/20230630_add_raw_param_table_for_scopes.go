@@ -24,6 +24,7 @@ import (
 	"github.com/apache/incubator-devlake/core/context"
 	"github.com/apache/incubator-devlake/core/dal"
 	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
 	"github.com/apache/incubator-devlake/core/plugin"
 	"github.com/apache/incubator-devlake/helpers/migrationhelper"
 )
@@ -63,7 +64,7 @@ func (script *addRawParamTableForScope) Up(basicRes context.BasicRes) errors.Err
 				{ColumnName: "_raw_data_table", Value: src.RawDataTable},
 				{ColumnName: "_raw_data_params", Value: src.RawDataParams},
 			}
-			where := dal.Where("id = ?", fmt.Sprintf("bamboo:BambooProject:%v:%v", src.ConnectionId, src.ProjectKey))
+			where := dal.Where("id = ?", didgen.WithTenantPrefix(fmt.Sprintf("bamboo:BambooProject:%v:%v", src.ConnectionId, src.ProjectKey)))
 			errors.Must(db.UpdateColumns("repos", updateSet, where))
 			errors.Must(db.UpdateColumns("boards", updateSet, where))
 			errors.Must(db.UpdateColumns("cicd_scopes", updateSet, where))
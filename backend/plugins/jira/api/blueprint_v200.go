@@ -28,6 +28,7 @@ import (
 	"github.com/apache/incubator-devlake/core/utils"
 	helper "github.com/apache/incubator-devlake/helpers/pluginhelper/api"
 	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/apache/incubator-devlake/plugins/jira/tasks"
 )
 
 func MakeDataSourcePipelinePlanV200(subtaskMetas []plugin.SubTaskMeta, connectionId uint64, bpScopes []*plugin.BlueprintScopeV200, syncPolicy *plugin.BlueprintSyncPolicy) (plugin.PipelinePlan, []plugin.Scope, errors.Error) {
@@ -65,10 +66,17 @@ func makeDataSourcePipelinePlanV200(
 		}
 
 		// get scope config from db
-		_, scopeConfig, err := scopeHelper.DbHelper().GetScopeAndConfig(connectionId, bpScope.Id)
+		jiraBoard, scopeConfig, err := scopeHelper.DbHelper().GetScopeAndConfig(connectionId, bpScope.Id)
+		if err != nil {
+			return nil, err
+		}
+		due, err := isBoardDue(jiraBoard, time.Now())
 		if err != nil {
 			return nil, err
 		}
+		if !due {
+			continue
+		}
 
 		subtasks, err := helper.MakePipelinePlanSubtasks(subtaskMetas, scopeConfig.Entities)
 		if err != nil {
@@ -82,7 +90,14 @@ func makeDataSourcePipelinePlanV200(
 		plan[i] = stage
 	}
 
-	return plan, nil
+	// boards not due leave their stage empty
+	nonEmptyPlan := make(plugin.PipelinePlan, 0, len(plan))
+	for _, stage := range plan {
+		if len(stage) > 0 {
+			nonEmptyPlan = append(nonEmptyPlan, stage)
+		}
+	}
+	return nonEmptyPlan, nil
 }
 
 func makeScopesV200(
@@ -111,3 +126,15 @@ func makeScopesV200(
 	}
 	return scopes, nil
 }
+
+// isBoardDue tells whether the refresh interval of the board elapsed since its latest collection
+func isBoardDue(jiraBoard *models.JiraBoard, now time.Time) (bool, errors.Error) {
+	if jiraBoard.RefreshIntervalMinutes <= 0 {
+		return true, nil
+	}
+	lastRun, err := helper.GetScopeLastRun(basicRes.GetDal(), tasks.RAW_ISSUE_TABLE, jiraBoard.ScopeParams())
+	if err != nil {
+		return false, err
+	}
+	return helper.IsScopeDue(now, lastRun, time.Duration(jiraBoard.RefreshIntervalMinutes)*time.Minute), nil
+}
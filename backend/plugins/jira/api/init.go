@@ -32,6 +32,7 @@ var scopeHelper *api.ScopeApiHelper[models.JiraConnection, models.JiraBoard, mod
 var remoteHelper *api.RemoteApiHelper[models.JiraConnection, models.JiraBoard, apiv2models.Board, api.NoRemoteGroupResponse]
 var basicRes context.BasicRes
 var scHelper *api.ScopeConfigHelper[models.JiraScopeConfig]
+var subtaskMetas []plugin.SubTaskMeta
 
 func Init(br context.BasicRes, p plugin.PluginMeta) {
 
@@ -67,4 +68,7 @@ func Init(br context.BasicRes, p plugin.PluginMeta) {
 		vld,
 		p.Name(),
 	)
+	if pluginTask, ok := p.(plugin.PluginTask); ok {
+		subtaskMetas = pluginTask.SubTaskMetas()
+	}
 }
@@ -0,0 +1,154 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/dbhelper"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/gocarina/gocsv"
+)
+
+const maxMemory = 32 << 20 // 32 MB
+
+type labelTeamMapping struct {
+	Label    string `csv:"label"`
+	TeamId   string `csv:"teamId"`
+	Priority int    `csv:"priority"`
+}
+
+// GetLabelTeamMappings returns the label to team mappings of the connection in csv format
+// @Summary      Get label-team-mappings.csv file
+// @Description  get the label to team mappings of the connection as a csv file
+// @Tags 		 plugins/jira
+// @Param        connectionId path int true "connectionId"
+// @Produce      text/csv
+// @Success      200
+// @Failure 400  {object} shared.ApiBody "Bad Request"
+// @Failure 500  {object} shared.ApiBody "Internal Error"
+// @Router       /plugins/jira/connections/{connectionId}/label-team-mappings.csv [GET]
+func GetLabelTeamMappings(input *plugin.ApiResourceInput) (*plugin.ApiResourceOutput, errors.Error) {
+	connectionId, e := strconv.ParseUint(input.Params["connectionId"], 10, 64)
+	if e != nil || connectionId == 0 {
+		return nil, errors.BadInput.New("invalid connectionId")
+	}
+	var mappings []*models.JiraLabelTeamMapping
+	err := basicRes.GetDal().All(&mappings,
+		dal.Where("connection_id = ?", connectionId),
+		dal.Orderby("priority, label"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	rows := make([]*labelTeamMapping, 0, len(mappings))
+	for _, m := range mappings {
+		rows = append(rows, &labelTeamMapping{Label: m.Label, TeamId: m.TeamId, Priority: m.Priority})
+	}
+	blob, err := errors.Convert01(gocsv.MarshalBytes(rows))
+	if err != nil {
+		return nil, err
+	}
+	return &plugin.ApiResourceOutput{
+		Status: http.StatusOK,
+		File: &plugin.OutputFile{
+			ContentType: "text/csv",
+			Data:        blob,
+		},
+	}, nil
+}
+
+// PutLabelTeamMappings replaces the label to team mappings of the connection with the uploaded csv file, taken
+// into account by the next collection
+// @Summary      Upload label-team-mappings.csv file
+// @Description  replace the label to team mappings of the connection with a csv file of label, teamId and priority
+// @Tags 		 plugins/jira
+// @Param        connectionId path int true "connectionId"
+// @Accept       multipart/form-data
+// @Param        file formData file true "select file to upload"
+// @Produce      json
+// @Success      200
+// @Failure 400  {object} shared.ApiBody "Bad Request"
+// @Failure 500  {object} shared.ApiBody "Internal Error"
+// @Router       /plugins/jira/connections/{connectionId}/label-team-mappings.csv [PUT]
+func PutLabelTeamMappings(input *plugin.ApiResourceInput) (out *plugin.ApiResourceOutput, err errors.Error) {
+	connectionId, e := strconv.ParseUint(input.Params["connectionId"], 10, 64)
+	if e != nil || connectionId == 0 {
+		return nil, errors.BadInput.New("invalid connectionId")
+	}
+	var rows []*labelTeamMapping
+	err = unmarshalCsv(input.Request, &rows)
+	if err != nil {
+		return nil, err
+	}
+	mappings := make([]*models.JiraLabelTeamMapping, 0, len(rows))
+	labels := make(map[string]bool, len(rows))
+	for _, row := range rows {
+		if row.Label == "" || row.TeamId == "" {
+			return nil, errors.BadInput.New("label and teamId are required")
+		}
+		if labels[row.Label] {
+			return nil, errors.BadInput.New(fmt.Sprintf("label %s is mapped more than once", row.Label))
+		}
+		labels[row.Label] = true
+		mappings = append(mappings, &models.JiraLabelTeamMapping{
+			ConnectionId: connectionId,
+			Label:        row.Label,
+			TeamId:       row.TeamId,
+			Priority:     row.Priority,
+		})
+	}
+	// the mappings get replaced as a whole or not at all
+	txHelper := dbhelper.NewTxHelper(basicRes, &err)
+	defer txHelper.End()
+	tx := txHelper.Begin()
+	err = tx.Delete(&models.JiraLabelTeamMapping{}, dal.Where("connection_id = ?", connectionId))
+	if err != nil {
+		return nil, err
+	}
+	for _, mapping := range mappings {
+		err = tx.CreateOrUpdate(mapping)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &plugin.ApiResourceOutput{Status: http.StatusOK}, nil
+}
+
+func unmarshalCsv(r *http.Request, items interface{}) errors.Error {
+	if r == nil {
+		return errors.Default.New("request is nil")
+	}
+	if r.MultipartForm == nil {
+		if err := r.ParseMultipartForm(maxMemory); err != nil {
+			return errors.BadInput.Wrap(err, "failed to parse the uploaded file")
+		}
+	}
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		return errors.BadInput.Wrap(err, "file is required")
+	}
+	defer file.Close()
+	return errors.BadInput.WrapRaw(gocsv.UnmarshalCSV(csv.NewReader(file), items))
+}
@@ -0,0 +1,213 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	helper "github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+const (
+	planPhaseCollect = "COLLECT"
+	planPhaseExtract = "EXTRACT"
+	planPhaseConvert = "CONVERT"
+)
+
+var planPhases = []string{planPhaseCollect, planPhaseExtract, planPhaseConvert}
+
+type planTemplateReq struct {
+	ScopeIds      []string                `json:"scopeIds"`
+	ScopeConfigId uint64                  `json:"scopeConfigId"`
+	ScopeConfig   *models.JiraScopeConfig `json:"scopeConfig"`
+	TimeAfter     *time.Time              `json:"timeAfter"`
+}
+
+type planTemplateSubtask struct {
+	Name        string   `json:"name"`
+	Phase       string   `json:"phase"`
+	Description string   `json:"description"`
+	DomainTypes []string `json:"domainTypes"`
+	DependsOn   []string `json:"dependsOn"`
+}
+
+type planTemplateResp struct {
+	Plan     plugin.PipelinePlan   `json:"plan"`
+	Subtasks []planTemplateSubtask `json:"subtasks"`
+}
+
+// MakePlanTemplate generates the pipeline plan for a set of boards sharing one scope config without running it
+// @Summary generate a pipeline plan template for Jira
+// @Description generate the subtask plan of the given boards with a shared scope config, so it can be reviewed before execution
+// @Tags plugins/jira
+// @Accept application/json
+// @Param connectionId path int true "connectionId"
+// @Param body body planTemplateReq true "boards and the shared scope config, either by id or inline"
+// @Success 200  {object} planTemplateResp
+// @Failure 400  {object} shared.ApiBody "Bad Request"
+// @Failure 500  {object} shared.ApiBody "Internal Error"
+// @Router /plugins/jira/connections/{connectionId}/plan-template [POST]
+func MakePlanTemplate(input *plugin.ApiResourceInput) (*plugin.ApiResourceOutput, errors.Error) {
+	connectionId, e := strconv.ParseUint(input.Params["connectionId"], 10, 64)
+	if e != nil || connectionId == 0 {
+		return nil, errors.BadInput.New("invalid connectionId")
+	}
+	var req planTemplateReq
+	err := helper.Decode(input.Body, &req, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.ScopeIds) == 0 {
+		return nil, errors.BadInput.New("scopeIds is required")
+	}
+	err = scopeHelper.DbHelper().VerifyConnection(connectionId)
+	if err != nil {
+		return nil, err
+	}
+	for _, scopeId := range req.ScopeIds {
+		_, err = scopeHelper.DbHelper().GetScope(connectionId, scopeId)
+		if err != nil {
+			return nil, errors.BadInput.Wrap(err, "unknown board "+scopeId)
+		}
+	}
+	scopeConfig := req.ScopeConfig
+	if scopeConfig == nil {
+		if req.ScopeConfigId == 0 {
+			return nil, errors.BadInput.New("either scopeConfigId or scopeConfig is required")
+		}
+		scopeConfig, err = scopeHelper.DbHelper().GetScopeConfig(req.ScopeConfigId)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		err = scopeConfig.Validate()
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp, err := makePlanTemplate(subtaskMetas, connectionId, req.ScopeIds, scopeConfig, req.TimeAfter)
+	if err != nil {
+		return nil, err
+	}
+	return &plugin.ApiResourceOutput{Body: resp, Status: http.StatusOK}, nil
+}
+
+// makePlanTemplate builds one stage per board, all of them sharing the same subtasks and scope config, the subtasks
+// keep the order in which they are registered since that is the order they are executed in
+func makePlanTemplate(
+	subtaskMetas []plugin.SubTaskMeta,
+	connectionId uint64,
+	scopeIds []string,
+	scopeConfig *models.JiraScopeConfig,
+	timeAfter *time.Time,
+) (*planTemplateResp, errors.Error) {
+	entities := scopeConfig.Entities
+	if len(entities) == 0 {
+		// an empty list means every domain type, spell them out so the plan shows what is going to run
+		entities = plugin.DOMAIN_TYPES
+	}
+	subtasks, err := helper.MakePipelinePlanSubtasks(subtaskMetas, entities)
+	if err != nil {
+		return nil, err
+	}
+	plan := make(plugin.PipelinePlan, 0, len(scopeIds))
+	for _, scopeId := range scopeIds {
+		options := map[string]interface{}{
+			"connectionId": connectionId,
+			"scopeId":      scopeId,
+			"scopeConfig":  scopeConfig,
+		}
+		if timeAfter != nil {
+			options["timeAfter"] = timeAfter.Format(time.RFC3339)
+		}
+		plan = append(plan, plugin.PipelineStage{
+			{
+				Plugin:   "jira",
+				Subtasks: subtasks,
+				Options:  options,
+			},
+		})
+	}
+	return &planTemplateResp{
+		Plan:     plan,
+		Subtasks: describePlanSubtasks(subtaskMetas, subtasks),
+	}, nil
+}
+
+// describePlanSubtasks annotates the selected subtasks with their phase and the earlier subtasks producing the data
+// they consume, e.g. extractIssues depends on collectIssues and convertIssues depends on extractIssues
+func describePlanSubtasks(subtaskMetas []plugin.SubTaskMeta, subtasks []string) []planTemplateSubtask {
+	selected := make(map[string]bool, len(subtasks))
+	for _, name := range subtasks {
+		selected[name] = true
+	}
+	// the last selected subtask of each phase by the resource it handles
+	producers := make(map[string]map[string]string)
+	result := make([]planTemplateSubtask, 0, len(subtasks))
+	for _, meta := range subtaskMetas {
+		if !selected[meta.Name] {
+			continue
+		}
+		phase, resource := splitSubtaskName(meta.Name)
+		dependsOn := make([]string, 0)
+		for _, p := range planPhases {
+			if p == phase {
+				break
+			}
+			if producer, ok := producers[p][resource]; ok {
+				dependsOn = append(dependsOn, producer)
+			}
+		}
+		if len(dependsOn) > 1 {
+			// only the closest upstream subtask is a direct dependency
+			dependsOn = dependsOn[len(dependsOn)-1:]
+		}
+		if phase != "" {
+			if producers[phase] == nil {
+				producers[phase] = make(map[string]string)
+			}
+			producers[phase][resource] = meta.Name
+		}
+		result = append(result, planTemplateSubtask{
+			Name:        meta.Name,
+			Phase:       phase,
+			Description: meta.Description,
+			DomainTypes: meta.DomainTypes,
+			DependsOn:   dependsOn,
+		})
+	}
+	return result
+}
+
+// splitSubtaskName splits a subtask name like collectIssueTypes into its phase and resource, the phase is empty if
+// the name does not start with one
+func splitSubtaskName(name string) (string, string) {
+	lower := strings.ToLower(name)
+	for _, phase := range planPhases {
+		if strings.HasPrefix(lower, strings.ToLower(phase)) {
+			return phase, lower[len(phase):]
+		}
+	}
+	return "", lower
+}
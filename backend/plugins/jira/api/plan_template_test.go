@@ -0,0 +1,56 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"testing"
+
+	"github.com/apache/incubator-devlake/core/models/common"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMakePlanTemplate(t *testing.T) {
+	subtaskMetas := []plugin.SubTaskMeta{
+		{Name: "collectIssues", EnabledByDefault: true, DomainTypes: []string{plugin.DOMAIN_TYPE_TICKET}},
+		{Name: "extractIssues", EnabledByDefault: true, DomainTypes: []string{plugin.DOMAIN_TYPE_TICKET}},
+		{Name: "extractIssueMentions", EnabledByDefault: false, DomainTypes: []string{plugin.DOMAIN_TYPE_TICKET}},
+		{Name: "collectDevelopmentPanel", EnabledByDefault: true, DomainTypes: []string{plugin.DOMAIN_TYPE_CROSS}},
+		{Name: "convertIssues", EnabledByDefault: true, DomainTypes: []string{plugin.DOMAIN_TYPE_TICKET}},
+	}
+	scopeConfig := &models.JiraScopeConfig{
+		ScopeConfig: common.ScopeConfig{Entities: []string{plugin.DOMAIN_TYPE_TICKET}},
+	}
+	resp, err := makePlanTemplate(subtaskMetas, 1, []string{"10", "11"}, scopeConfig, nil)
+	assert.Nil(t, err)
+	assert.Len(t, resp.Plan, 2)
+	assert.Equal(t, []string{"collectIssues", "extractIssues", "convertIssues"}, resp.Plan[0][0].Subtasks)
+	assert.Equal(t, "11", resp.Plan[1][0].Options["scopeId"])
+	assert.Equal(t, scopeConfig, resp.Plan[1][0].Options["scopeConfig"])
+	assert.Equal(t, []planTemplateSubtask{
+		{Name: "collectIssues", Phase: planPhaseCollect, DomainTypes: []string{plugin.DOMAIN_TYPE_TICKET}, DependsOn: []string{}},
+		{Name: "extractIssues", Phase: planPhaseExtract, DomainTypes: []string{plugin.DOMAIN_TYPE_TICKET}, DependsOn: []string{"collectIssues"}},
+		{Name: "convertIssues", Phase: planPhaseConvert, DomainTypes: []string{plugin.DOMAIN_TYPE_TICKET}, DependsOn: []string{"extractIssues"}},
+	}, resp.Subtasks)
+
+	// no entities means every domain type
+	resp, err = makePlanTemplate(subtaskMetas, 1, []string{"10"}, &models.JiraScopeConfig{}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"collectIssues", "extractIssues", "collectDevelopmentPanel", "convertIssues"}, resp.Plan[0][0].Subtasks)
+}
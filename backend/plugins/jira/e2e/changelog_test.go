@@ -18,11 +18,13 @@ limitations under the License.
 package e2e
 
 import (
+	"github.com/apache/incubator-devlake/core/dal"
 	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
 	"github.com/apache/incubator-devlake/helpers/e2ehelper"
 	"github.com/apache/incubator-devlake/plugins/jira/impl"
 	"github.com/apache/incubator-devlake/plugins/jira/models"
 	"github.com/apache/incubator-devlake/plugins/jira/tasks"
+	"github.com/stretchr/testify/assert"
 	"testing"
 )
 
@@ -108,3 +110,45 @@ func TestIssueChangelogDataFlow(t *testing.T) {
 		),
 	)
 }
+
+func TestIssueChangelogTransitionsModeDataFlow(t *testing.T) {
+	var plugin impl.Jira
+	dataflowTester := e2ehelper.NewDataFlowTester(t, "jira", plugin)
+
+	taskData := &tasks.JiraTaskData{
+		Options: &tasks.JiraOptions{
+			ConnectionId: 2,
+			BoardId:      8,
+			ScopeConfig: &models.JiraScopeConfig{
+				ChangelogMode: models.ChangelogModeTransitions,
+			},
+		},
+	}
+	// only the compact status transitions get extracted
+	dataflowTester.ImportCsvIntoRawTable("./raw_tables/_raw_jira_api_issue_changelogs.csv", "_raw_jira_api_issue_changelogs")
+	dataflowTester.ImportCsvIntoTabler("./snapshot_tables/_tool_jira_statuses_for_changelog.csv", &models.JiraStatus{})
+	dataflowTester.ImportCsvIntoTabler("./snapshot_tables/_tool_jira_board_issues_for_changelog.csv", &models.JiraBoardIssue{})
+	dataflowTester.FlushTabler(&models.JiraIssue{})
+	dataflowTester.FlushTabler(&models.JiraIssueChangelogs{})
+	dataflowTester.FlushTabler(&models.JiraIssueChangelogItems{})
+	dataflowTester.FlushTabler(&models.JiraIssueStatusTransition{})
+	dataflowTester.FlushTabler(&models.JiraAccount{})
+	dataflowTester.Subtask(tasks.ExtractIssueChangelogsMeta, taskData)
+	dataflowTester.VerifyTable(
+		models.JiraIssueChangelogItems{},
+		"./snapshot_tables/_tool_jira_issue_changelog_items_transitions_mode.csv",
+		[]string{"connection_id", "changelog_id", "field"},
+	)
+	transitions, err := dataflowTester.Dal.Count(dal.From(&models.JiraIssueStatusTransition{}))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10), transitions)
+
+	// the subtasks built on changelog items leave the domain changelogs of a previous run alone
+	dataflowTester.ImportCsvIntoTabler("./snapshot_tables/issue_changelogs.csv", &ticket.IssueChangelogs{})
+	dataflowTester.Subtask(tasks.ConvertIssueChangelogsMeta, taskData)
+	dataflowTester.VerifyTable(
+		ticket.IssueChangelogs{},
+		"./snapshot_tables/issue_changelogs.csv",
+		[]string{"id", "issue_id", "field_name", "created_date"},
+	)
+}
@@ -52,6 +52,8 @@ func TestEpicDataflow(t *testing.T) {
 	dataflowTester.FlushTabler(&models.JiraWorklog{})
 	dataflowTester.FlushTabler(&models.JiraAccount{})
 	dataflowTester.FlushTabler(&models.JiraIssueType{})
+	dataflowTester.FlushTabler(&models.JiraIssueLinkType{})
+	dataflowTester.FlushTabler(&models.JiraIssueRelationship{})
 
 	ctx := dataflowTester.SubtaskContext(taskData)
 
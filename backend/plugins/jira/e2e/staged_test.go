@@ -0,0 +1,84 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"testing"
+
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/helpers/e2ehelper"
+	"github.com/apache/incubator-devlake/plugins/jira/impl"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/apache/incubator-devlake/plugins/jira/tasks"
+)
+
+// TestStagedDataFlow runs the whole pipeline but the collectors, which need the API, on staged raw data, and makes
+// sure the production tables are left as they were
+func TestStagedDataFlow(t *testing.T) {
+	var plugin impl.Jira
+	dataflowTester := e2ehelper.NewDataFlowTester(t, "jira", plugin)
+
+	taskData := &tasks.JiraTaskData{
+		Options: &tasks.JiraOptions{
+			ConnectionId:   2,
+			BoardId:        8,
+			CollectAndDiff: true,
+			ScopeConfig:    &models.JiraScopeConfig{},
+		},
+	}
+
+	dataflowTester.ImportCsvIntoRawTable("./raw_tables/_raw_jira_api_issues.csv", "_raw_jira_api_issues")
+	dataflowTester.ImportCsvIntoTabler("./snapshot_tables/_tool_jira_issues.csv", &models.JiraIssue{})
+	dataflowTester.ImportCsvIntoTabler("./snapshot_tables/_tool_jira_board_issues.csv", &models.JiraBoardIssue{})
+	dataflowTester.ImportCsvIntoTabler("./snapshot_tables/issues.csv", &ticket.Issue{})
+	dataflowTester.ImportCsvIntoTabler("./snapshot_tables/board_issues.csv", &ticket.BoardIssue{})
+
+	for _, subtaskMeta := range plugin.SubTaskMetas() {
+		if subtaskMeta.RunsWhenStaged {
+			continue
+		}
+		dataflowTester.Subtask(subtaskMeta, taskData)
+	}
+
+	dataflowTester.VerifyTable(
+		models.JiraIssue{},
+		"./snapshot_tables/_tool_jira_issues.csv",
+		[]string{"connection_id", "issue_id", "issue_key", "summary", "std_type", "std_status", "story_point"},
+	)
+	dataflowTester.VerifyTable(
+		ticket.Issue{},
+		"./snapshot_tables/issues.csv",
+		[]string{
+			"id",
+			"issue_key",
+			"title",
+			"type",
+			"status",
+			"story_point",
+			"resolution_date",
+			"lead_time_minutes",
+			"parent_issue_id",
+			"assignee_id",
+		},
+	)
+	dataflowTester.VerifyTable(
+		ticket.BoardIssue{},
+		"./snapshot_tables/board_issues.csv",
+		[]string{"board_id", "issue_id"},
+	)
+}
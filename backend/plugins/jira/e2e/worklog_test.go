@@ -56,6 +56,7 @@ func TestWorklogDataFlow(t *testing.T) {
 			"time_spent_seconds",
 			"updated",
 			"started",
+			"created",
 			"issue_updated",
 		),
 	)
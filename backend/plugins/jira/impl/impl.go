@@ -89,6 +89,35 @@ func (p Jira) GetTablesInfo() []dal.Tabler {
 		&models.JiraWorklog{},
 		&models.JiraIssueComment{},
 		&models.JiraScopeConfig{},
+		&models.JiraBoardQuickFilter{},
+		&models.JiraQuickFilterIssue{},
+		&models.JiraBoardThroughput{},
+		&models.JiraBoardReworkRate{},
+		&models.JiraIssueMention{},
+		&models.JiraIssueWatcher{},
+		&models.JiraIssueParticipant{},
+		&models.JiraVersion{},
+		&models.JiraIssueVersion{},
+		&models.JiraIssueAttribute{},
+		&models.JiraIssueWorklogBreakdown{},
+		&models.JiraIssueKeyChange{},
+		&models.JiraIssueCollectorCursor{},
+		&models.JiraIssueStatusTransition{},
+		&models.JiraIssueEstimateHistory{},
+		&models.JiraProjectStatus{},
+		&models.JiraLabelTeamMapping{},
+		&models.JiraPortfolioItem{},
+		&models.JiraIssueEnvironment{},
+		&models.JiraSavedFilter{},
+		&models.JiraSavedFilterIssue{},
+		&models.JiraAuditChange{},
+		&models.JiraEscapedDefect{},
+		&models.JiraBoardConfiguration{},
+		&models.JiraIssueLinkType{},
+		&models.JiraIssueRelationship{},
+		&models.JiraComponentBoard{},
+		&models.JiraWorklogChange{},
+		&models.JiraBoardFreshnessAlert{},
 	}
 }
 
@@ -111,16 +140,32 @@ func (p Jira) SubTaskMetas() []plugin.SubTaskMeta {
 		tasks.CollectIssueTypesMeta,
 		tasks.ExtractIssueTypesMeta,
 
+		tasks.CollectIssueLinkTypesMeta,
+		tasks.ExtractIssueLinkTypesMeta,
+
+		tasks.CollectBoardConfigurationMeta,
+		tasks.ExtractBoardConfigurationMeta,
+
 		tasks.CollectIssuesMeta,
 		tasks.ExtractIssuesMeta,
+		tasks.ExtractIssueMentionsMeta,
+
+		tasks.CollectProjectStatusesMeta,
+		tasks.ExtractProjectStatusesMeta,
+		tasks.ReportUnmappedStatusesMeta,
 
 		tasks.ConvertIssueLabelsMeta,
+		tasks.ConvertIssueParticipantsMeta,
+		tasks.ConvertIssueVersionsMeta,
+		tasks.ConvertIssueAttributesMeta,
+		tasks.ConvertIssueRelationshipsMeta,
 
 		tasks.CollectIssueCommentsMeta,
 		tasks.ExtractIssueCommentsMeta,
 
 		tasks.CollectIssueChangelogsMeta,
 		tasks.ExtractIssueChangelogsMeta,
+		tasks.ReconcileMovedIssuesMeta,
 
 		tasks.CollectAccountsMeta,
 
@@ -130,30 +175,67 @@ func (p Jira) SubTaskMetas() []plugin.SubTaskMeta {
 		tasks.CollectRemotelinksMeta,
 		tasks.ExtractRemotelinksMeta,
 
+		tasks.CollectIssueWatchersMeta,
+		tasks.ExtractIssueWatchersMeta,
+
 		tasks.CollectSprintsMeta,
 		tasks.ExtractSprintsMeta,
 
 		tasks.ConvertBoardMeta,
+		tasks.ConvertProjectsMeta,
+
+		tasks.CollectQuickFiltersMeta,
+		tasks.ExtractQuickFiltersMeta,
+		tasks.CollectQuickFilterIssuesMeta,
+		tasks.ExtractQuickFilterIssuesMeta,
+		tasks.CollectSavedFiltersMeta,
+		tasks.ExtractSavedFiltersMeta,
+		tasks.CollectSavedFilterIssuesMeta,
+		tasks.ExtractSavedFilterIssuesMeta,
+		tasks.CollectAuditIssuesMeta,
+		tasks.ExtractAuditIssuesMeta,
 
 		tasks.ConvertIssuesMeta,
+		tasks.ConvertLabelTeamsMeta,
+		tasks.ConvertComponentBoardsMeta,
+		tasks.ConvertEpicProgressMeta,
+		tasks.ConvertSubtaskCountsMeta,
+		tasks.ConvertIssueHierarchyMeta,
+		tasks.ConvertWorklogRollupsMeta,
+		tasks.ConvertPortfolioItemsMeta,
+		tasks.ConvertBlockingChainsMeta,
+		tasks.ConvertEscapedDefectsMeta,
+		tasks.ConvertAuditChangesMeta,
 		tasks.ConvertIssueCommentsMeta,
+		tasks.ConvertFirstResponsesMeta,
+		tasks.ConvertFlowEfficiencyMeta,
 		tasks.ConvertWorklogsMeta,
+		tasks.ConvertWorklogBreakdownMeta,
 		tasks.ConvertIssueChangelogsMeta,
+		tasks.ConvertIssueEventsMeta,
+		tasks.ConvertBoardThroughputMeta,
+		tasks.ConvertBoardReworkRatesMeta,
 
 		tasks.ConvertSprintsMeta,
 		tasks.ConvertSprintIssuesMeta,
+		tasks.ConvertSprintReportMeta,
 
 		tasks.CollectDevelopmentPanelMeta,
 		tasks.ExtractDevelopmentPanelMeta,
 
 		tasks.ConvertIssueCommitsMeta,
 		tasks.ConvertIssueRepoCommitsMeta,
+		tasks.ConvertDodComplianceMeta,
 
 		tasks.ExtractAccountsMeta,
 		tasks.ConvertAccountsMeta,
 
 		tasks.CollectEpicsMeta,
 		tasks.ExtractEpicsMeta,
+
+		tasks.ValidateIntegrityMeta,
+		tasks.DiffRawDataMeta,
+		tasks.CheckBoardFreshnessMeta,
 	}
 }
 
@@ -243,9 +325,11 @@ func (p Jira) PrepareTaskData(taskCtx plugin.TaskContext, options map[string]int
 		return nil, errors.HttpStatus(code).Wrap(err, "fail to get Jira server info")
 	}
 	taskData := &tasks.JiraTaskData{
-		Options:        &op,
-		ApiClient:      jiraApiClient,
-		JiraServerInfo: *info,
+		Options:             &op,
+		ApiClient:           jiraApiClient,
+		JiraServerInfo:      *info,
+		FreshnessSlaMinutes: connection.FreshnessSlaMinutes,
+		FreshnessWebhookUrl: connection.FreshnessWebhookUrl,
 	}
 	if op.TimeAfter != "" {
 		var timeAfter time.Time
@@ -256,6 +340,25 @@ func (p Jira) PrepareTaskData(taskCtx plugin.TaskContext, options map[string]int
 		taskData.TimeAfter = &timeAfter
 		logger.Debug("collect data created from %s", timeAfter)
 	}
+	if op.AuditSince != "" {
+		var auditSince time.Time
+		auditSince, err = errors.Convert01(time.Parse(time.RFC3339, op.AuditSince))
+		if err != nil {
+			return nil, errors.BadInput.Wrap(err, "invalid value for `auditSince`")
+		}
+		taskData.AuditSince = &auditSince
+	}
+	if op.AuditUntil != "" {
+		if op.AuditSince == "" {
+			return nil, errors.BadInput.New("`auditUntil` requires `auditSince`")
+		}
+		var auditUntil time.Time
+		auditUntil, err = errors.Convert01(time.Parse(time.RFC3339, op.AuditUntil))
+		if err != nil {
+			return nil, errors.BadInput.Wrap(err, "invalid value for `auditUntil`")
+		}
+		taskData.AuditUntil = &auditUntil
+	}
 	return taskData, nil
 }
 
@@ -320,6 +423,13 @@ func (p Jira) ApiResources() map[string]map[string]plugin.ApiResourceHandler {
 		"connections/:connectionId/dev-panel-commits": {
 			"GET": api.GetCommitsURLs,
 		},
+		"connections/:connectionId/plan-template": {
+			"POST": api.MakePlanTemplate,
+		},
+		"connections/:connectionId/label-team-mappings.csv": {
+			"GET": api.GetLabelTeamMappings,
+			"PUT": api.PutLabelTeamMappings,
+		},
 		"generate-regex": {
 			"POST": api.GenRegex,
 		},
@@ -32,6 +32,8 @@ type JiraAccount struct {
 	Email        string `gorm:"type:varchar(255)"`
 	AvatarUrl    string `gorm:"type:varchar(255)"`
 	Timezone     string `gorm:"type:varchar(255)"`
+	// Active is null when Jira does not tell whether the account is active
+	Active *bool
 }
 
 func (JiraAccount) TableName() string {
@@ -0,0 +1,42 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"time"
+
+	"github.com/apache/incubator-devlake/core/models/common"
+)
+
+// JiraAuditChange is a field of an issue of the board changed by the account under audit within the audit window
+type JiraAuditChange struct {
+	common.NoPKModel
+	ConnectionId uint64 `gorm:"primaryKey"`
+	BoardId      uint64 `gorm:"primaryKey"`
+	ChangelogId  uint64 `gorm:"primaryKey"`
+	Field        string `gorm:"primaryKey;type:varchar(255)"`
+	IssueId      uint64 `gorm:"index"`
+	AccountId    string `gorm:"type:varchar(255)"`
+	Created      time.Time
+	FromString   string
+	ToString     string
+}
+
+func (JiraAuditChange) TableName() string {
+	return "_tool_jira_audit_changes"
+}
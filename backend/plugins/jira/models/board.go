@@ -35,6 +35,11 @@ type JiraBoard struct {
 	Name             string `json:"name" mapstructure:"name" gorm:"type:varchar(255)"`
 	Self             string `json:"self" mapstructure:"self" gorm:"type:varchar(255)"`
 	Type             string `json:"type" mapstructure:"type" gorm:"type:varchar(100)"`
+	// RefreshIntervalMinutes is the cadence the board is collected at, blueprint runs happening sooner after the
+	// latest collection skip it. 0 collects the board on every run
+	RefreshIntervalMinutes int `json:"refreshIntervalMinutes" mapstructure:"refreshIntervalMinutes"`
+	// FreshnessSlaMinutes overrides the freshness SLA of the connection for the board, 0 keeps the connection one
+	FreshnessSlaMinutes int `json:"freshnessSlaMinutes" mapstructure:"freshnessSlaMinutes"`
 }
 
 func (b JiraBoard) ScopeId() string {
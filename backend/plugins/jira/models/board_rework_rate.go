@@ -0,0 +1,44 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"time"
+
+	"github.com/apache/incubator-devlake/core/models/common"
+)
+
+// JiraBoardReworkRate is the rework of the issues of a board within a week or a month, ReworkRate being the share
+// of the issues moving between stages in the period that went back to an earlier one. The counts are only minimums
+// when IsMinimum is set because the history of some issues was incomplete
+type JiraBoardReworkRate struct {
+	common.NoPKModel
+	ConnectionId       uint64    `gorm:"primaryKey"`
+	BoardId            uint64    `gorm:"primaryKey"`
+	PeriodType         string    `gorm:"primaryKey;type:varchar(20)"`
+	PeriodStart        time.Time `gorm:"primaryKey"`
+	ReworkCount        int
+	ReworkedIssueCount int
+	ActiveIssueCount   int
+	ReworkRate         float64
+	IsMinimum          bool
+}
+
+func (JiraBoardReworkRate) TableName() string {
+	return "_tool_jira_board_rework_rates"
+}
@@ -0,0 +1,43 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"time"
+
+	"github.com/apache/incubator-devlake/core/models/common"
+)
+
+const (
+	ThroughputPeriodWeek  = "WEEK"
+	ThroughputPeriodMonth = "MONTH"
+)
+
+// JiraBoardThroughput is the number of issues of a board completed within a week or a month
+type JiraBoardThroughput struct {
+	common.NoPKModel
+	ConnectionId uint64    `gorm:"primaryKey"`
+	BoardId      uint64    `gorm:"primaryKey"`
+	PeriodType   string    `gorm:"primaryKey;type:varchar(20)"`
+	PeriodStart  time.Time `gorm:"primaryKey"`
+	IssueCount   int
+}
+
+func (JiraBoardThroughput) TableName() string {
+	return "_tool_jira_board_throughputs"
+}
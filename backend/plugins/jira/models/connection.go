@@ -42,6 +42,16 @@ type JiraConn struct {
 	helper.MultiAuth      `mapstructure:",squash"`
 	helper.BasicAuth      `mapstructure:",squash"`
 	helper.AccessToken    `mapstructure:",squash"`
+	// RequestBudget caps the number of requests the collections of a board may send in a run, the collection stops
+	// cleanly once it is used up and the next run resumes from there. 0 for no cap
+	RequestBudget int `mapstructure:"requestBudget" json:"requestBudget"`
+	// AdaptiveThrottling slows the collection down as the rate limit budget reported by Jira Cloud drops, the
+	// static rate applies when Jira reports none
+	AdaptiveThrottling bool `mapstructure:"adaptiveThrottling" json:"adaptiveThrottling"`
+	// FreshnessSlaMinutes is how old the data of the boards may get before FreshnessWebhookUrl is alerted, boards
+	// may override it. 0 disables the check
+	FreshnessSlaMinutes int    `mapstructure:"freshnessSlaMinutes" json:"freshnessSlaMinutes"`
+	FreshnessWebhookUrl string `mapstructure:"freshnessWebhookUrl" json:"freshnessWebhookUrl" gorm:"type:varchar(255)"`
 }
 
 // SetupAuthentication implements the `IAuthentication` interface by delegating
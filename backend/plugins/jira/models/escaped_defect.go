@@ -0,0 +1,36 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/apache/incubator-devlake/core/models/common"
+)
+
+// JiraEscapedDefect links a defect of the board escaped to production to an issue causing it, SourceIssueId being 0
+// for the escaped defects traced back to no issue
+type JiraEscapedDefect struct {
+	common.NoPKModel
+	ConnectionId  uint64 `gorm:"primaryKey"`
+	BoardId       uint64 `gorm:"primaryKey"`
+	DefectIssueId uint64 `gorm:"primaryKey;autoIncrement:false"`
+	SourceIssueId uint64 `gorm:"primaryKey;autoIncrement:false"`
+}
+
+func (JiraEscapedDefect) TableName() string {
+	return "_tool_jira_escaped_defects"
+}
@@ -57,6 +57,11 @@ type JiraIssue struct {
 	SprintId                 uint64 // latest sprint, issue might cross multiple sprints, would be addressed by #514
 	SprintName               string `gorm:"type:varchar(255)"`
 	ResolutionDate           *time.Time
+	ResolutionName           string   `gorm:"type:varchar(255)"`
+	Components               []string `gorm:"type:json;serializer:json"`
+	WatchCount               int
+	AcceptanceCriteria       string
+	StartDate                *time.Time
 	Created                  time.Time
 	Updated                  time.Time `gorm:"index"`
 	SpentMinutes             int64
@@ -64,8 +69,25 @@ type JiraIssue struct {
 	LeadTimeMinutes          uint
 	StdType                  string `gorm:"type:varchar(255)"`
 	StdStatus                string `gorm:"type:varchar(255)"`
+	StdResolution            string `gorm:"type:varchar(100)"`
 	AllFields                datatypes.JSONMap
 	ChangelogTotal           int
+	// LastCommentedDate is the date of the latest comment embedded in the issue, which may miss the latest ones
+	// when CommentsTruncated is set
+	LastCommentedDate *time.Time
+	CommentsTruncated bool
+	// HierarchyLevel is the level of the issue type in the Advanced Roadmaps hierarchy, e.g. -1 for subtasks, 0 for
+	// stories and 1 for epics, null when unknown
+	HierarchyLevel *int
+	// SecurityLevel is the name of the issue security level, SecurityExcluded tells the issue is above the maximum
+	// security level of the scope config and is kept out of the domain layer
+	SecurityLevel    string `gorm:"type:varchar(255)"`
+	SecurityExcluded bool
+	// Categories are the values of the label categories of the scope config, by category
+	Categories map[string]string `gorm:"type:json;serializer:json"`
+	// TeamId is read out of the field given by the fieldMappings of the scope config, it takes precedence over the
+	// team of the components
+	TeamId string `gorm:"type:varchar(255)"`
 	common.NoPKModel
 }
 
@@ -34,6 +34,10 @@ type JiraIssueComment struct {
 	Created            time.Time `json:"created"`
 	Updated            time.Time `json:"updated"`
 	IssueUpdated       *time.Time
+	// Edited tells the comment was updated after its creation, Jira doesn't report how many times
+	Edited bool
+	// Restricted tells the comment is internal to Jira Service Management agents or visible to a group or a role only
+	Restricted bool
 }
 
 func (JiraIssueComment) TableName() string {
@@ -0,0 +1,49 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"time"
+
+	"github.com/apache/incubator-devlake/core/models/common"
+)
+
+const (
+	// types of time estimates
+	EstimateTypeRemaining = "remaining"
+	EstimateTypeOriginal  = "original"
+)
+
+// JiraIssueEstimateHistory is a change of the time estimates of an issue, taken from the changelogs. Estimates
+// increasing while the issue is open reveal scope creep
+type JiraIssueEstimateHistory struct {
+	common.NoPKModel
+	ConnectionId    uint64    `gorm:"primaryKey"`
+	ChangelogId     uint64    `gorm:"primaryKey"`
+	EstimateType    string    `gorm:"primaryKey;type:varchar(20)"`
+	IssueId         uint64    `gorm:"index"`
+	Created         time.Time `gorm:"index"`
+	FromSeconds     *int64
+	ToSeconds       *int64
+	Increased       bool
+	AuthorAccountId string `gorm:"type:varchar(255)"`
+}
+
+func (JiraIssueEstimateHistory) TableName() string {
+	return "_tool_jira_issue_estimate_histories"
+}
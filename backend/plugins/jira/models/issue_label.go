@@ -28,9 +28,19 @@ type JiraIssueLabel struct {
 	ConnectionId uint64 `gorm:"primaryKey;autoIncrement:false"`
 	IssueId      uint64 `gorm:"primaryKey;autoIncrement:false"`
 	LabelName    string `gorm:"primaryKey;type:varchar(255)"`
+	// CanonicalName is LabelName as mapped by the label synonyms of the scope config
+	CanonicalName string `gorm:"type:varchar(255)"`
 	common.NoPKModel
 }
 
 func (JiraIssueLabel) TableName() string {
 	return "_tool_jira_issue_labels"
 }
+
+// GetCanonicalName falls back to LabelName for labels extracted before the canonical name was recorded
+func (l JiraIssueLabel) GetCanonicalName() string {
+	if l.CanonicalName == "" {
+		return l.LabelName
+	}
+	return l.CanonicalName
+}
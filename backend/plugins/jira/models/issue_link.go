@@ -0,0 +1,61 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/apache/incubator-devlake/core/models/common"
+)
+
+const (
+	IssueLinkInward  = "INWARD"
+	IssueLinkOutward = "OUTWARD"
+)
+
+// JiraIssueLinkType is an issue link type defined on the instance, Inward and Outward are the (localized) labels
+// of both ends of the relationship
+type JiraIssueLinkType struct {
+	common.NoPKModel
+	ConnectionId uint64 `gorm:"primaryKey;autoIncrement:false"`
+	Id           string `gorm:"primaryKey;type:varchar(255)"`
+	Self         string `gorm:"type:varchar(255)"`
+	Name         string `gorm:"type:varchar(255)"`
+	Inward       string `gorm:"type:varchar(255)"`
+	Outward      string `gorm:"type:varchar(255)"`
+}
+
+func (JiraIssueLinkType) TableName() string {
+	return "_tool_jira_issue_link_types"
+}
+
+// JiraIssueRelationship is an issue link seen from IssueId, Direction tells which end RelatedIssueId is at
+type JiraIssueRelationship struct {
+	common.NoPKModel
+	ConnectionId    uint64 `gorm:"primaryKey"`
+	IssueId         uint64 `gorm:"primaryKey"`
+	LinkId          uint64 `gorm:"primaryKey;autoIncrement:false"`
+	LinkTypeId      string `gorm:"type:varchar(255)"`
+	LinkTypeName    string `gorm:"type:varchar(255)"`
+	Direction       string `gorm:"type:varchar(20)"`
+	Label           string `gorm:"type:varchar(255)"`
+	RelatedIssueId  uint64
+	RelatedIssueKey string `gorm:"type:varchar(255)"`
+}
+
+func (JiraIssueRelationship) TableName() string {
+	return "_tool_jira_issue_relationships"
+}
@@ -0,0 +1,56 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrationscripts
+
+import (
+	"github.com/apache/incubator-devlake/core/context"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/helpers/migrationhelper"
+)
+
+type issue20230721 struct {
+	Components []string `gorm:"type:json;serializer:json"`
+}
+
+func (issue20230721) TableName() string {
+	return "_tool_jira_issues"
+}
+
+type scopeConfig20230721 struct {
+	ComponentTeamMappings map[string]string `gorm:"type:json;serializer:json"`
+	ComponentTieBreak     string            `gorm:"type:varchar(20)"`
+	ComponentPriority     []string          `gorm:"type:json;serializer:json"`
+}
+
+func (scopeConfig20230721) TableName() string {
+	return "_tool_jira_scope_configs"
+}
+
+type addComponentTeamMappings struct{}
+
+func (script *addComponentTeamMappings) Up(basicRes context.BasicRes) errors.Error {
+	return migrationhelper.AutoMigrateTables(basicRes, &issue20230721{}, &scopeConfig20230721{})
+}
+
+func (*addComponentTeamMappings) Version() uint64 {
+	return 20230721100000
+}
+
+func (*addComponentTeamMappings) Name() string {
+	return "add components to _tool_jira_issues and component team mappings to _tool_jira_scope_configs"
+}
@@ -0,0 +1,60 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrationscripts
+
+import (
+	"github.com/apache/incubator-devlake/core/context"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/helpers/migrationhelper"
+	"github.com/apache/incubator-devlake/plugins/jira/models/migrationscripts/archived"
+)
+
+type issue20230723 struct {
+	WatchCount int
+}
+
+func (issue20230723) TableName() string {
+	return "_tool_jira_issues"
+}
+
+type scopeConfig20230723 struct {
+	WatchersMinCount int
+}
+
+func (scopeConfig20230723) TableName() string {
+	return "_tool_jira_scope_configs"
+}
+
+type addIssueWatchers struct{}
+
+func (script *addIssueWatchers) Up(basicRes context.BasicRes) errors.Error {
+	return migrationhelper.AutoMigrateTables(
+		basicRes,
+		&issue20230723{},
+		&scopeConfig20230723{},
+		&archived.JiraIssueWatcher{},
+	)
+}
+
+func (*addIssueWatchers) Version() uint64 {
+	return 20230723100000
+}
+
+func (*addIssueWatchers) Name() string {
+	return "add _tool_jira_issue_watchers and watch_count to _tool_jira_issues"
+}
@@ -0,0 +1,51 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrationscripts
+
+import (
+	"github.com/apache/incubator-devlake/core/context"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/helpers/migrationhelper"
+)
+
+type project20230801 struct {
+	Self           string `gorm:"type:varchar(255)"`
+	Description    string
+	LeadAccountId  string `gorm:"type:varchar(255)"`
+	CategoryName   string `gorm:"type:varchar(255)"`
+	ProjectTypeKey string `gorm:"type:varchar(100)"`
+	Style          string `gorm:"type:varchar(100)"`
+}
+
+func (project20230801) TableName() string {
+	return "_tool_jira_projects"
+}
+
+type addProjectMetadata struct{}
+
+func (script *addProjectMetadata) Up(basicRes context.BasicRes) errors.Error {
+	return migrationhelper.AutoMigrateTables(basicRes, &project20230801{})
+}
+
+func (*addProjectMetadata) Version() uint64 {
+	return 20230801100000
+}
+
+func (*addProjectMetadata) Name() string {
+	return "add lead, category, type and style to _tool_jira_projects"
+}
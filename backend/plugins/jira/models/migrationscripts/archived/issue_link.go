@@ -0,0 +1,53 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archived
+
+import (
+	"github.com/apache/incubator-devlake/core/models/migrationscripts/archived"
+)
+
+type JiraIssueLinkType struct {
+	archived.NoPKModel
+	ConnectionId uint64 `gorm:"primaryKey;autoIncrement:false"`
+	Id           string `gorm:"primaryKey;type:varchar(255)"`
+	Self         string `gorm:"type:varchar(255)"`
+	Name         string `gorm:"type:varchar(255)"`
+	Inward       string `gorm:"type:varchar(255)"`
+	Outward      string `gorm:"type:varchar(255)"`
+}
+
+func (JiraIssueLinkType) TableName() string {
+	return "_tool_jira_issue_link_types"
+}
+
+type JiraIssueRelationship struct {
+	archived.NoPKModel
+	ConnectionId    uint64 `gorm:"primaryKey"`
+	IssueId         uint64 `gorm:"primaryKey"`
+	LinkId          uint64 `gorm:"primaryKey;autoIncrement:false"`
+	LinkTypeId      string `gorm:"type:varchar(255)"`
+	LinkTypeName    string `gorm:"type:varchar(255)"`
+	Direction       string `gorm:"type:varchar(20)"`
+	Label           string `gorm:"type:varchar(255)"`
+	RelatedIssueId  uint64
+	RelatedIssueKey string `gorm:"type:varchar(255)"`
+}
+
+func (JiraIssueRelationship) TableName() string {
+	return "_tool_jira_issue_relationships"
+}
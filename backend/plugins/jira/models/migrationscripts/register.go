@@ -42,5 +42,66 @@ func All() []plugin.MigrationScript {
 		new(addApplicationType),
 		new(clearRepoPattern),
 		new(addRawParamTableForScope),
+		new(addResolutionDateDiscrepancy),
+		new(addBoardQuickFilters),
+		new(addStoryPointFields),
+		new(addBoardThroughputs),
+		new(addComponentTeamMappings),
+		new(addIssueMentions),
+		new(addIssueWatchers),
+		new(addIssueLinkTypes),
+		new(addAcceptanceCriteria),
+		new(addDoneDateStrategy),
+		new(addDenormalizeIssueFacets),
+		new(addIssueWorklogBreakdowns),
+		new(addDedupChangelogItems),
+		new(addProjectMetadata),
+		new(addBlockedTimeField),
+		new(addStartDateField),
+		new(addIssueKeyChanges),
+		new(addCollectStatuses),
+		new(addRequestBudget),
+		new(addIntegrityOrphanTolerance),
+		new(addLabelHierarchySeparator),
+		new(addIssueStatusTransitions),
+		new(addBoardRefreshInterval),
+		new(addCombinedStatusTemplate),
+		new(addWorklogCreated),
+		new(addIssueCommentSummary),
+		new(addProjectTypeMappings),
+		new(addFieldMappings),
+		new(addIssueHierarchyLevel),
+		new(addIssueParticipants),
+		new(addIssueVersions),
+		new(addAdaptiveThrottling),
+		new(addSecurityLevel),
+		new(addComponentBoards),
+		new(addIssueEstimateHistories),
+		new(addAccountActive),
+		new(addMaxHierarchyDepth),
+		new(addProjectStatuses),
+		new(addFreshnessSla),
+		new(addLabelTeamMappings),
+		new(addLabelSynonyms),
+		new(addCollectWorklogsUpdatedSince),
+		new(addDodRules),
+		new(addPortfolioItems),
+		new(addCommentEdited),
+		new(addIssueEnvironments),
+		new(addSavedFilters),
+		new(addBlockingLinkTypes),
+		new(addResolutionMappings),
+		new(addAuditChanges),
+		new(addBoardReworkRates),
+		new(addLabelCategories),
+		new(addRestrictedComments),
+		new(addEscapedDefects),
+		new(addBoardConfigurations),
+		new(addFlowStatuses),
+		new(addIssueAttributes),
+		new(addComponentBoardTable),
+		new(addWorklogChangeTable),
+		new(addBoardFreshnessAlertTable),
+		new(addTeamIdToIssues),
 	}
 }
@@ -0,0 +1,36 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/apache/incubator-devlake/core/models/common"
+
+// JiraPortfolioItem places an issue under the initiative and the epic it rolls up to, initiatives being the issues
+// above the epic level of the hierarchy. Initiatives are items of their own, so that those without children show up
+type JiraPortfolioItem struct {
+	common.NoPKModel
+	ConnectionId uint64 `gorm:"primaryKey"`
+	IssueId      uint64 `gorm:"primaryKey"`
+	InitiativeId uint64 `gorm:"index"`
+	// EpicId is 0 for initiatives and for the issues right under an initiative
+	EpicId         uint64
+	HierarchyLevel int
+}
+
+func (JiraPortfolioItem) TableName() string {
+	return "_tool_jira_portfolio_items"
+}
@@ -25,10 +25,17 @@ type JiraProject struct {
 	common.NoPKModel
 
 	// collected fields
-	ConnectionId uint64 `gorm:"primarykey"`
-	Id           string `gorm:"primaryKey;type:varchar(255)"`
-	ProjectKey   string `gorm:"type:varchar(255)"`
-	Name         string `gorm:"type:varchar(255)"`
+	ConnectionId   uint64 `gorm:"primarykey"`
+	Id             string `gorm:"primaryKey;type:varchar(255)"`
+	ProjectKey     string `gorm:"type:varchar(255)"`
+	Name           string `gorm:"type:varchar(255)"`
+	Self           string `gorm:"type:varchar(255)"`
+	Description    string
+	LeadAccountId  string `gorm:"type:varchar(255)"`
+	CategoryName   string `gorm:"type:varchar(255)"`
+	ProjectTypeKey string `gorm:"type:varchar(100)"`
+	// Style is `classic` for company-managed projects and `next-gen` for team-managed ones, empty on Jira Server
+	Style string `gorm:"type:varchar(100)"`
 }
 
 func (JiraProject) TableName() string {
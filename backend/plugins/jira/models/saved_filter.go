@@ -0,0 +1,52 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/apache/incubator-devlake/core/models/common"
+)
+
+// JiraSavedFilter is a filter saved in Jira, kept so that dashboards can segment issues the same way as Jira does
+type JiraSavedFilter struct {
+	common.NoPKModel
+	ConnectionId   uint64 `gorm:"primaryKey"`
+	BoardId        uint64 `gorm:"primaryKey"`
+	FilterId       uint64 `gorm:"primaryKey"`
+	Name           string `gorm:"type:varchar(255)"`
+	Jql            string
+	Description    string
+	OwnerAccountId string `gorm:"type:varchar(255)"`
+	Url            string `gorm:"type:varchar(255)"`
+}
+
+func (JiraSavedFilter) TableName() string {
+	return "_tool_jira_saved_filters"
+}
+
+// JiraSavedFilterIssue tags an issue of the board as matching the JQL of a saved filter
+type JiraSavedFilterIssue struct {
+	common.NoPKModel
+	ConnectionId uint64 `gorm:"primaryKey"`
+	BoardId      uint64 `gorm:"primaryKey"`
+	FilterId     uint64 `gorm:"primaryKey"`
+	IssueId      uint64 `gorm:"primaryKey"`
+}
+
+func (JiraSavedFilterIssue) TableName() string {
+	return "_tool_jira_saved_filter_issues"
+}
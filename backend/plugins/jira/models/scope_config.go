@@ -18,10 +18,15 @@ limitations under the License.
 package models
 
 import (
+	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/apache/incubator-devlake/core/errors"
 	"github.com/apache/incubator-devlake/core/models/common"
+	helper "github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+
+	"golang.org/x/exp/slices"
 )
 
 type StatusMapping struct {
@@ -35,23 +40,281 @@ type TypeMapping struct {
 	StatusMappings StatusMappings `json:"statusMappings"`
 }
 
+const (
+	DoneDateStrategyResolutionField = "resolution-field"
+	DoneDateStrategyFirstDone       = "first-done"
+	DoneDateStrategyLastDone        = "last-done"
+	// DefaultDoneDateStrategy applies to scope configs setting no DoneDateStrategy
+	DefaultDoneDateStrategy = DoneDateStrategyResolutionField
+)
+
+const (
+	ComponentTieBreakFirst        = "first"
+	ComponentTieBreakAlphabetical = "alphabetical"
+	ComponentTieBreakPriority     = "priority"
+)
+
+const (
+	ChangelogModeFull        = "full"
+	ChangelogModeTransitions = "transitions"
+	ChangelogModeBoth        = "both"
+)
+
 type CommitUrlPattern struct {
 	Pattern string `json:"pattern"`
 	Regex   string `json:"regex"`
 }
 
 type JiraScopeConfig struct {
-	common.ScopeConfig         `mapstructure:",squash" json:",inline" gorm:"embedded"`
-	ConnectionId               uint64                 `mapstructure:"connectionId" json:"connectionId"`
-	Name                       string                 `mapstructure:"name" json:"name" gorm:"type:varchar(255);index:idx_name_jira,unique" validate:"required"`
-	EpicKeyField               string                 `mapstructure:"epicKeyField,omitempty" json:"epicKeyField" gorm:"type:varchar(255)"`
-	StoryPointField            string                 `mapstructure:"storyPointField,omitempty" json:"storyPointField" gorm:"type:varchar(255)"`
+	common.ScopeConfig `mapstructure:",squash" json:",inline" gorm:"embedded"`
+	ConnectionId       uint64 `mapstructure:"connectionId" json:"connectionId"`
+	Name               string `mapstructure:"name" json:"name" gorm:"type:varchar(255);index:idx_name_jira,unique" validate:"required"`
+	EpicKeyField       string `mapstructure:"epicKeyField,omitempty" json:"epicKeyField" gorm:"type:varchar(255)"`
+	StoryPointField    string `mapstructure:"storyPointField,omitempty" json:"storyPointField" gorm:"type:varchar(255)"`
+	// StoryPointFields lists candidate story point fields in priority order, the first populated one is used, the
+	// estimation field of the board is used when neither is set
+	StoryPointFields           []string               `mapstructure:"storyPointFields,omitempty" json:"storyPointFields" gorm:"type:json;serializer:json"`
 	RemotelinkCommitShaPattern string                 `mapstructure:"remotelinkCommitShaPattern,omitempty" json:"remotelinkCommitShaPattern" gorm:"type:varchar(255)"`
 	RemotelinkRepoPattern      []CommitUrlPattern     `mapstructure:"remotelinkRepoPattern,omitempty" json:"remotelinkRepoPattern" gorm:"type:json;serializer:json"`
 	TypeMappings               map[string]TypeMapping `mapstructure:"typeMappings,omitempty" json:"typeMappings" gorm:"type:json;serializer:json"`
 	ApplicationType            string                 `mapstructure:"applicationType,omitempty" json:"applicationType" gorm:"type:varchar(255)"`
+	// ResolutionDateDiscrepancyMinutes flags issues whose resolution date is further than this from their last
+	// done-transition, 0 disables the flag
+	ResolutionDateDiscrepancyMinutes int `mapstructure:"resolutionDateDiscrepancyMinutes,omitempty" json:"resolutionDateDiscrepancyMinutes"`
+	// MaterializeQuickFilters tags issues with the board quick filters they match
+	MaterializeQuickFilters bool `mapstructure:"materializeQuickFilters,omitempty" json:"materializeQuickFilters"`
+	// MaterializeSavedFilters tags the issues of the board with the favourite saved filters they match, filters
+	// Jira can't evaluate are kept without being materialized
+	MaterializeSavedFilters bool `mapstructure:"materializeSavedFilters,omitempty" json:"materializeSavedFilters"`
+	// CancelledResolutions lists the resolutions of issues closed without being delivered, they are left out of
+	// the board throughput
+	CancelledResolutions []string `mapstructure:"cancelledResolutions,omitempty" json:"cancelledResolutions" gorm:"type:json;serializer:json"`
+	// ComponentTeamMappings maps component names to the ids of their owning teams
+	ComponentTeamMappings map[string]string `mapstructure:"componentTeamMappings,omitempty" json:"componentTeamMappings" gorm:"type:json;serializer:json"`
+	// ComponentTieBreak picks the component deciding the team of issues with several mapped components, one of
+	// `first` (default, in Jira order), `alphabetical` or `priority` (following ComponentPriority)
+	ComponentTieBreak string   `mapstructure:"componentTieBreak,omitempty" json:"componentTieBreak" gorm:"type:varchar(20)"`
+	ComponentPriority []string `mapstructure:"componentPriority,omitempty" json:"componentPriority" gorm:"type:json;serializer:json"`
+	// WatchersMinCount collects the watchers of issues watched by at least this many accounts, 0 disables it
+	WatchersMinCount int `mapstructure:"watchersMinCount,omitempty" json:"watchersMinCount"`
+	// AcceptanceCriteriaField is the custom field holding the acceptance criteria, as plain text or ADF
+	AcceptanceCriteriaField string `mapstructure:"acceptanceCriteriaField,omitempty" json:"acceptanceCriteriaField" gorm:"type:varchar(255)"`
+	// DoneDateStrategy picks the timestamp filling the resolution date of done issues, one of `resolution-field`
+	// (default), `first-done` or `last-done`, the latter two being derived from the status changelogs
+	DoneDateStrategy string `mapstructure:"doneDateStrategy,omitempty" json:"doneDateStrategy" gorm:"type:varchar(20)"`
+	// DenormalizeIssueFacets copies labels and components into `issues.facets` for single-table filtering, the
+	// join tables remain the source of truth
+	DenormalizeIssueFacets bool `mapstructure:"denormalizeIssueFacets,omitempty" json:"denormalizeIssueFacets"`
+	// DedupChangelogItems collapses the changelog items repeated by several changelogs within the same second, as
+	// emitted by some automations, the earliest changelog keeps them
+	DedupChangelogItems bool `mapstructure:"dedupChangelogItems,omitempty" json:"dedupChangelogItems"`
+	// BlockedTimeField is the changelog field recording issues being flagged, usually `Flagged`, the time spent
+	// flagged goes into `issues.blocked_minutes`, empty disables it
+	BlockedTimeField string `mapstructure:"blockedTimeField,omitempty" json:"blockedTimeField" gorm:"type:varchar(255)"`
+	// ExcludeBlockedTimeFromLeadTime subtracts the blocked time from the lead time of issues
+	ExcludeBlockedTimeFromLeadTime bool `mapstructure:"excludeBlockedTimeFromLeadTime,omitempty" json:"excludeBlockedTimeFromLeadTime"`
+	// StartDateField is the custom field holding the start date of issues, only the date part is kept
+	StartDateField string `mapstructure:"startDateField,omitempty" json:"startDateField" gorm:"type:varchar(255)"`
+	// CollectStatuses and CollectStatusCategories restrict the collection to issues in any of these statuses or
+	// status categories, both empty collects all issues. Incremental collections also fetch issues whose status
+	// changed since the previous run, so issues leaving the filter get their final status instead of staying open,
+	// while a full collection drops them altogether
+	CollectStatuses         []string `mapstructure:"collectStatuses,omitempty" json:"collectStatuses" gorm:"type:json;serializer:json"`
+	CollectStatusCategories []string `mapstructure:"collectStatusCategories,omitempty" json:"collectStatusCategories" gorm:"type:json;serializer:json"`
+	// IntegrityOrphanTolerance is the number of orphans an integrity check may find before being reported, the
+	// collection fails on it only if FailOnIntegrityOrphans is set
+	IntegrityOrphanTolerance int  `mapstructure:"integrityOrphanTolerance,omitempty" json:"integrityOrphanTolerance"`
+	FailOnIntegrityOrphans   bool `mapstructure:"failOnIntegrityOrphans,omitempty" json:"failOnIntegrityOrphans"`
+	// LabelHierarchySeparator splits labels like `area/backend` into levels, each label is then also emitted as
+	// its ancestors `area` for rolling up, empty disables it
+	LabelHierarchySeparator string `mapstructure:"labelHierarchySeparator,omitempty" json:"labelHierarchySeparator" gorm:"type:varchar(20)"`
+	// ChangelogMode decides whether changelogs are extracted into the full changelog item table, into the compact
+	// `_tool_jira_issue_status_transitions` only, or both, among `full`, `transitions` and `both`, defaults to
+	// `full`. Status based metrics, like triage time or done dates, read the transitions unless the mode is `full`,
+	// everything built on other fields, like domain changelogs, reassignments or blocked time, is skipped with
+	// `transitions`
+	ChangelogMode string `mapstructure:"changelogMode,omitempty" json:"changelogMode" gorm:"type:varchar(20)"`
+	// CombinedStatusTemplate renders `issues.combined_status` out of `{status}`, `{stdStatus}` and `{resolution}`,
+	// e.g. `{status} ({resolution})`, issues without resolution get their status only. Empty disables it
+	CombinedStatusTemplate string `mapstructure:"combinedStatusTemplate,omitempty" json:"combinedStatusTemplate" gorm:"type:varchar(255)"`
+	// ProjectTypeMappings overrides TypeMappings by project key for boards spanning several projects, issue types
+	// a project doesn't map fall back to TypeMappings
+	ProjectTypeMappings map[string]map[string]TypeMapping `mapstructure:"projectTypeMappings,omitempty" json:"projectTypeMappings" gorm:"type:json;serializer:json"`
+	// FieldMappings reads `storyPoint`, `startDate` and `teamId` out of the given issue fields, taking precedence
+	// over StoryPointFields and StartDateField which are read through it, see GetFieldMappings
+	FieldMappings helper.FieldMappings `mapstructure:"fieldMappings,omitempty" json:"fieldMappings" gorm:"type:json;serializer:json"`
+	// RequestParticipantsField is the custom field holding the request participants of service desk issues, they
+	// are extracted as participants of the issues. Empty disables it
+	RequestParticipantsField string `mapstructure:"requestParticipantsField,omitempty" json:"requestParticipantsField" gorm:"type:varchar(255)"`
+	// SecurityLevels orders the issue security levels from the least to the most restricted. Issues above
+	// MaxSecurityLevel, or with a level missing from SecurityLevels, are kept in the tool layer for reconciliation
+	// but never converted, neither are their comments, worklogs and changelogs. Empty MaxSecurityLevel disables it
+	SecurityLevels   []string `mapstructure:"securityLevels,omitempty" json:"securityLevels" gorm:"type:json;serializer:json"`
+	MaxSecurityLevel string   `mapstructure:"maxSecurityLevel,omitempty" json:"maxSecurityLevel" gorm:"type:varchar(255)"`
+	// ComponentBoards promotes the components of the board to domain boards of their own, every issue joining the
+	// board of its primary component as picked by ComponentTieBreak. Issues without component join the board named
+	// DefaultComponentBoard, `No Component` by default
+	ComponentBoards       bool   `mapstructure:"componentBoards,omitempty" json:"componentBoards"`
+	DefaultComponentBoard string `mapstructure:"defaultComponentBoard,omitempty" json:"defaultComponentBoard" gorm:"type:varchar(255)"`
+	// MaxHierarchyDepth caps the walk up the parents of issues computing their depth and root, chains deeper than
+	// this, or looping, are cut and logged. 0 means DefaultMaxHierarchyDepth
+	MaxHierarchyDepth int `mapstructure:"maxHierarchyDepth,omitempty" json:"maxHierarchyDepth"`
+	// LabelSynonyms maps canonical labels to the spellings teams use for them, e.g. `frontend: [front-end, FE]`,
+	// matched case-insensitively. `_tool_jira_issue_labels` keeps the original label next to the canonical one, which
+	// is the one reaching the domain layer, unmapped labels pass through unchanged
+	LabelSynonyms map[string][]string `mapstructure:"labelSynonyms,omitempty" json:"labelSynonyms" gorm:"type:json;serializer:json"`
+	// CollectWorklogsUpdatedSince collects the worklogs of incremental collections through the instance-wide lists of
+	// worklogs updated and deleted since the previous run, rather than issue by issue. The lists are fetched once per
+	// connection and pipeline, each board then requests the worklogs of its own issues
+	CollectWorklogsUpdatedSince bool `mapstructure:"collectWorklogsUpdatedSince,omitempty" json:"collectWorklogsUpdatedSince"`
+	// DodRules is the definition of done, done issues breaking any of the rules are flagged as not compliant
+	DodRules []DodRule `mapstructure:"dodRules,omitempty" json:"dodRules" gorm:"type:json;serializer:json"`
+	// EnvironmentParser extracts structured fields out of the free-text environment of issues into
+	// `_tool_jira_issue_environments`, nil disables it
+	EnvironmentParser *EnvironmentParser `mapstructure:"environmentParser,omitempty" json:"environmentParser" gorm:"type:json;serializer:json"`
+	// BlockingLinkTypes names the issue link types whose outward end blocks the inward one, the blocking chains of
+	// issues following them. Empty means DefaultBlockingLinkTypes
+	BlockingLinkTypes []string `mapstructure:"blockingLinkTypes,omitempty" json:"blockingLinkTypes" gorm:"type:json;serializer:json"`
+	// ResolutionMappings standardizes the resolutions of issues by their standard type, ResolutionMappingAnyType
+	// applying to the types not listed
+	ResolutionMappings map[string]ResolutionMapping `mapstructure:"resolutionMappings,omitempty" json:"resolutionMappings" gorm:"type:json;serializer:json"`
+	// ReworkStages orders the active stages of issues, an issue going back to an earlier stage being reworked.
+	// Empty means DefaultReworkStages
+	ReworkStages []ReworkStage `mapstructure:"reworkStages,omitempty" json:"reworkStages" gorm:"type:json;serializer:json"`
+	// LabelCategories turns the labels starting with a prefix into the single value of a category of issues, such
+	// labels being no longer kept as labels
+	LabelCategories []LabelCategory `mapstructure:"labelCategories,omitempty" json:"labelCategories" gorm:"type:json;serializer:json"`
+	// FirstResponseIgnoresRestrictedComments only counts the comments visible to customers as responses to issues
+	FirstResponseIgnoresRestrictedComments bool `mapstructure:"firstResponseIgnoresRestrictedComments,omitempty" json:"firstResponseIgnoresRestrictedComments"`
+	// EscapedDefectLinkTypes names the issue link types whose outward end causes the inward one, the escaped defects
+	// being traced back to their sources by them. Empty means DefaultEscapedDefectLinkTypes
+	EscapedDefectLinkTypes []string `mapstructure:"escapedDefectLinkTypes,omitempty" json:"escapedDefectLinkTypes" gorm:"type:json;serializer:json"`
+	// EscapedDefectLabels tells the defects escaped to production by their labels, empty counts every bug and
+	// incident as escaped
+	EscapedDefectLabels []string `mapstructure:"escapedDefectLabels,omitempty" json:"escapedDefectLabels" gorm:"type:json;serializer:json"`
+	// FlowActiveStatuses and FlowWaitingStatuses tell the statuses issues are worked on and waiting in for their
+	// flow efficiency, by Jira status name or standard status. Empty means DefaultFlowActiveStatuses and
+	// DefaultFlowWaitingStatuses respectively
+	FlowActiveStatuses  []string `mapstructure:"flowActiveStatuses,omitempty" json:"flowActiveStatuses" gorm:"type:json;serializer:json"`
+	FlowWaitingStatuses []string `mapstructure:"flowWaitingStatuses,omitempty" json:"flowWaitingStatuses" gorm:"type:json;serializer:json"`
+	// MaterializeIssueAttributes also copies labels, components, fix and affected versions along with the values
+	// of IssueAttributeFields into `issue_attributes` for generic faceting, the dedicated tables remain the source
+	// of truth
+	MaterializeIssueAttributes bool `mapstructure:"materializeIssueAttributes,omitempty" json:"materializeIssueAttributes"`
+	// IssueAttributeFields are the custom select fields materialized as issue attributes, typed by their field id
+	IssueAttributeFields []string `mapstructure:"issueAttributeFields,omitempty" json:"issueAttributeFields" gorm:"type:json;serializer:json"`
+}
+
+const (
+	DodConditionStoryPoint         = "storyPoint"
+	DodConditionAcceptanceCriteria = "acceptanceCriteria"
+	DodConditionAssignee           = "assignee"
+	DodConditionLinkedCommit       = "linkedCommit"
+	DodConditionLinkedPullRequest  = "linkedPullRequest"
+)
+
+// DodRule requires done issues to have a story point, acceptance criteria, an assignee, a linked commit or a linked
+// pull request, as picked by Condition
+type DodRule struct {
+	Condition string `json:"condition"`
+	// IssueTypes restricts the rule to issues of these standard types, empty applies it to all of them
+	IssueTypes []string `json:"issueTypes"`
+}
+
+// ReworkStage is a stage of issues, made of statuses named either by their Jira name or by their standard status,
+// the Jira names being matched first
+type ReworkStage struct {
+	Name     string   `json:"name"`
+	Statuses []string `json:"statuses"`
+}
+
+const (
+	LabelCategoryPickFirst = "first"
+	LabelCategoryPickLast  = "last"
+)
+
+// LabelCategory reads the value of Category out of the labels starting with Prefix, e.g. `team:` for `team:core`.
+// Pick decides which of several matching labels wins, LabelCategoryPickFirst by default
+type LabelCategory struct {
+	Prefix   string `json:"prefix"`
+	Category string `json:"category"`
+	Pick     string `json:"pick"`
+}
+
+// EnvironmentParser reads the fields out of the named groups of Pattern, e.g. `OS: (?P<os>\S+)`, or when Pattern is
+// empty out of key-value pairs like `os: linux` separated by PairSeparator, a new line by default, the keys being
+// split from the values by KeyValueSeparator, `:` by default
+type EnvironmentParser struct {
+	Pattern           string `json:"pattern"`
+	PairSeparator     string `json:"pairSeparator"`
+	KeyValueSeparator string `json:"keyValueSeparator"`
+}
+
+// Validate checks that Pattern compiles and names the fields it extracts
+func (p *EnvironmentParser) Validate() errors.Error {
+	if p.Pattern == "" {
+		return nil
+	}
+	pattern, err := regexp.Compile(p.Pattern)
+	if err != nil {
+		return errors.BadInput.Wrap(err, "invalid environmentParser pattern")
+	}
+	for _, name := range pattern.SubexpNames() {
+		if name != "" {
+			return nil
+		}
+	}
+	return errors.BadInput.New("environmentParser pattern has no named group")
+}
+
+// ResolutionMappingAnyType keys the resolution mapping of the standard types without one of their own
+const ResolutionMappingAnyType = "*"
+
+// ResolutionMapping maps resolution names to standard resolutions, done issues without resolution getting Default
+type ResolutionMapping struct {
+	Resolutions map[string]string `json:"resolutions"`
+	Default     string            `json:"default"`
+}
+
+// GetStdResolution returns the standard resolution of an issue of the given standard type, empty when unmapped.
+// The mapping of the type is looked up first, then the one of ResolutionMappingAnyType
+func (r *JiraScopeConfig) GetStdResolution(stdType, resolution string, done bool) string {
+	for _, key := range []string{stdType, ResolutionMappingAnyType} {
+		mapping, ok := r.ResolutionMappings[key]
+		if !ok {
+			continue
+		}
+		if resolution == "" {
+			if done && mapping.Default != "" {
+				return strings.ToUpper(mapping.Default)
+			}
+		} else if stdResolution, ok := mapping.Resolutions[resolution]; ok && stdResolution != "" {
+			return strings.ToUpper(stdResolution)
+		}
+	}
+	return ""
+}
+
+// DefaultMaxHierarchyDepth leaves room for initiatives above epics along with a few custom levels
+const DefaultMaxHierarchyDepth = 10
+
+// DefaultBlockingLinkTypes is the link type Jira comes with for blocking issues
+var DefaultBlockingLinkTypes = []string{"Blocks"}
+
+// DefaultEscapedDefectLinkTypes is the link type Jira comes with for problems causing incidents
+var DefaultEscapedDefectLinkTypes = []string{"Problem/Incident"}
+
+// DefaultReworkStages counts the issues going back from done to in progress as reworked
+var DefaultReworkStages = []ReworkStage{
+	{Name: "IN_PROGRESS", Statuses: []string{"IN_PROGRESS"}},
+	{Name: "DONE", Statuses: []string{"DONE"}},
 }
 
+// DefaultFlowActiveStatuses and DefaultFlowWaitingStatuses count the issues in progress as worked on and the issues
+// to do as waiting
+var DefaultFlowActiveStatuses = []string{"IN_PROGRESS"}
+var DefaultFlowWaitingStatuses = []string{"TODO"}
+
 func (r *JiraScopeConfig) Validate() errors.Error {
 	var err error
 	if r.RemotelinkCommitShaPattern != "" {
@@ -60,6 +323,82 @@ func (r *JiraScopeConfig) Validate() errors.Error {
 			return errors.Convert(err)
 		}
 	}
+	if r.ResolutionDateDiscrepancyMinutes < 0 {
+		return errors.BadInput.New("resolutionDateDiscrepancyMinutes must not be negative")
+	}
+	if r.WatchersMinCount < 0 {
+		return errors.BadInput.New("watchersMinCount must not be negative")
+	}
+	if r.MaxHierarchyDepth < 0 {
+		return errors.BadInput.New("maxHierarchyDepth must not be negative")
+	}
+	switch r.DoneDateStrategy {
+	case "", DoneDateStrategyResolutionField, DoneDateStrategyFirstDone, DoneDateStrategyLastDone:
+	default:
+		return errors.BadInput.New("invalid doneDateStrategy " + r.DoneDateStrategy)
+	}
+	switch r.ComponentTieBreak {
+	case "", ComponentTieBreakFirst, ComponentTieBreakAlphabetical, ComponentTieBreakPriority:
+	default:
+		return errors.BadInput.New("invalid componentTieBreak " + r.ComponentTieBreak)
+	}
+	switch r.ChangelogMode {
+	case "", ChangelogModeFull, ChangelogModeTransitions, ChangelogModeBoth:
+	default:
+		return errors.BadInput.New("invalid changelogMode " + r.ChangelogMode)
+	}
+	if r.MaxSecurityLevel != "" && !slices.Contains(r.SecurityLevels, r.MaxSecurityLevel) {
+		return errors.BadInput.New("maxSecurityLevel " + r.MaxSecurityLevel + " is missing from securityLevels")
+	}
+	if err := r.FieldMappings.Validate(); err != nil {
+		return err
+	}
+	if _, err := r.GetCanonicalLabels(); err != nil {
+		return err
+	}
+	if r.EnvironmentParser != nil {
+		if err := r.EnvironmentParser.Validate(); err != nil {
+			return err
+		}
+	}
+	for stdType, mapping := range r.ResolutionMappings {
+		for resolution, stdResolution := range mapping.Resolutions {
+			if resolution == "" || stdResolution == "" {
+				return errors.BadInput.New("empty resolution in the resolutionMappings of " + stdType)
+			}
+		}
+	}
+	for _, category := range r.LabelCategories {
+		if category.Prefix == "" || category.Category == "" {
+			return errors.BadInput.New("labelCategories require a prefix and a category")
+		}
+		switch category.Pick {
+		case "", LabelCategoryPickFirst, LabelCategoryPickLast:
+		default:
+			return errors.BadInput.New("invalid pick " + category.Pick + " of the label category " + category.Category)
+		}
+	}
+	for _, stage := range r.ReworkStages {
+		if len(stage.Statuses) == 0 {
+			return errors.BadInput.New("no statuses in the rework stage " + stage.Name)
+		}
+	}
+	activeStatuses := make(map[string]bool, len(r.FlowActiveStatuses))
+	for _, status := range r.FlowActiveStatuses {
+		activeStatuses[strings.ToLower(status)] = true
+	}
+	for _, status := range r.FlowWaitingStatuses {
+		if activeStatuses[strings.ToLower(status)] {
+			return errors.BadInput.New("the status " + status + " is both in flowActiveStatuses and flowWaitingStatuses")
+		}
+	}
+	for _, rule := range r.DodRules {
+		switch rule.Condition {
+		case DodConditionStoryPoint, DodConditionAcceptanceCriteria, DodConditionAssignee, DodConditionLinkedCommit, DodConditionLinkedPullRequest:
+		default:
+			return errors.BadInput.New("invalid dodRules condition " + rule.Condition)
+		}
+	}
 	for _, pattern := range r.RemotelinkRepoPattern {
 		if pattern.Regex == "" {
 			return errors.BadInput.New("empty regex in remotelinkRepoPattern")
@@ -72,6 +411,63 @@ func (r *JiraScopeConfig) Validate() errors.Error {
 	return nil
 }
 
+// GetStoryPointFields returns the candidate story point fields in priority order, the `storyPoint` of FieldMappings
+// comes first and `StoryPointField` last unless it was listed explicitly
+func (r *JiraScopeConfig) GetStoryPointFields() []string {
+	fields := make([]string, 0, len(r.StoryPointFields)+2)
+	candidates := append([]string{r.FieldMappings[helper.FieldMappingStoryPoint]}, r.StoryPointFields...)
+	for _, field := range append(candidates, r.StoryPointField) {
+		if field != "" && !slices.Contains(fields, field) {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// GetFieldMappings returns FieldMappings completed with the legacy StartDateField, story points having several
+// candidate fields are left to GetStoryPointFields
+func (r *JiraScopeConfig) GetFieldMappings() helper.FieldMappings {
+	mappings := make(helper.FieldMappings, len(r.FieldMappings)+1)
+	for target, source := range r.FieldMappings {
+		if target != helper.FieldMappingStoryPoint {
+			mappings[target] = source
+		}
+	}
+	if _, ok := mappings[helper.FieldMappingStartDate]; !ok && r.StartDateField != "" {
+		mappings[helper.FieldMappingStartDate] = r.StartDateField
+	}
+	return mappings
+}
+
+// GetCanonicalLabels indexes LabelSynonyms by lowercased spelling, canonical labels included, spellings claimed by
+// several canonical labels are rejected
+func (r *JiraScopeConfig) GetCanonicalLabels() (map[string]string, errors.Error) {
+	canonicalLabels := make(map[string]string)
+	for canonical, synonyms := range r.LabelSynonyms {
+		if canonical == "" {
+			return nil, errors.BadInput.New("empty canonical label in labelSynonyms")
+		}
+		for _, label := range append([]string{canonical}, synonyms...) {
+			key := strings.ToLower(label)
+			if existing, ok := canonicalLabels[key]; ok && existing != canonical {
+				return nil, errors.BadInput.New(fmt.Sprintf("label %s is a synonym of both %s and %s", label, existing, canonical))
+			}
+			canonicalLabels[key] = canonical
+		}
+	}
+	return canonicalLabels, nil
+}
+
+// IsSecurityLevelExcluded tells whether issues of the given security level are above MaxSecurityLevel, issues
+// without security level never are
+func (r *JiraScopeConfig) IsSecurityLevelExcluded(level string) bool {
+	if r.MaxSecurityLevel == "" || level == "" {
+		return false
+	}
+	index := slices.Index(r.SecurityLevels, level)
+	return index < 0 || index > slices.Index(r.SecurityLevels, r.MaxSecurityLevel)
+}
+
 func (r JiraScopeConfig) TableName() string {
 	return "_tool_jira_scope_configs"
 }
@@ -33,7 +33,9 @@ type JiraWorklog struct {
 	TimeSpentSeconds int
 	Updated          time.Time
 	Started          time.Time
-	IssueUpdated     *time.Time
+	// Created is when the worklog got logged, Started when the work happened
+	Created      *time.Time
+	IssueUpdated *time.Time
 }
 
 func (JiraWorklog) TableName() string {
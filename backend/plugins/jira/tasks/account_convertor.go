@@ -77,6 +77,7 @@ func ConvertAccounts(taskCtx plugin.SubTaskContext) errors.Error {
 				UserName:  jiraAccount.Name,
 				Email:     jiraAccount.Email,
 				AvatarUrl: jiraAccount.AvatarUrl,
+				Active:    jiraAccount.Active,
 			}
 			return []interface{}{u}, nil
 		},
@@ -0,0 +1,153 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+var (
+	issueKeyPattern = regexp.MustCompile(`\b[A-Z][A-Z0-9_]+-[1-9][0-9]*\b`)
+	// stops at the delimiters of wiki markup links such as `[title|https://example.com]`
+	urlPattern = regexp.MustCompile(`https?://[^\s|\[\]<>"']+`)
+)
+
+type textReference struct {
+	Kind  string
+	Value string
+}
+
+// adfNode is a node of the Atlassian Document Format tree
+type adfNode struct {
+	Type    string                 `json:"type"`
+	Text    string                 `json:"text"`
+	Attrs   map[string]interface{} `json:"attrs"`
+	Marks   []adfNode              `json:"marks"`
+	Content []adfNode              `json:"content"`
+}
+
+// extractTextReferences returns the issue keys and urls referenced by a description or a comment body, which is
+// either an ADF document or a plain-text/wiki-markup string. Duplicates are dropped and the order of appearance kept.
+func extractTextReferences(body json.RawMessage) []textReference {
+	collector := &referenceCollector{seen: make(map[textReference]bool)}
+	var text string
+	if err := json.Unmarshal(body, &text); err == nil {
+		collector.scanText(text)
+		return collector.refs
+	}
+	var doc adfNode
+	if err := json.Unmarshal(body, &doc); err == nil {
+		collector.walk(&doc)
+	}
+	return collector.refs
+}
+
+type referenceCollector struct {
+	refs []textReference
+	seen map[textReference]bool
+}
+
+func (c *referenceCollector) add(kind, value string) {
+	ref := textReference{Kind: kind, Value: value}
+	if value == "" || c.seen[ref] {
+		return
+	}
+	c.seen[ref] = true
+	c.refs = append(c.refs, ref)
+}
+
+func (c *referenceCollector) scanText(text string) {
+	for _, url := range urlPattern.FindAllString(text, -1) {
+		c.add(models.MentionKindUrl, strings.TrimRight(url, ".,;:!?)"))
+	}
+	// issue keys inside urls are covered by the urls themselves
+	for _, key := range issueKeyPattern.FindAllString(urlPattern.ReplaceAllString(text, " "), -1) {
+		c.add(models.MentionKindIssueKey, key)
+	}
+}
+
+// adfToText flattens a field value into plain text, ADF documents get one line per block while strings are kept as
+// they are
+func adfToText(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	}
+	body, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+	var doc adfNode
+	if err = json.Unmarshal(body, &doc); err != nil {
+		return ""
+	}
+	var sb strings.Builder
+	writeAdfText(&sb, &doc)
+	return strings.TrimSpace(sb.String())
+}
+
+func writeAdfText(sb *strings.Builder, node *adfNode) {
+	switch node.Type {
+	case "text":
+		sb.WriteString(node.Text)
+	case "hardBreak":
+		sb.WriteString("\n")
+	case "mention", "emoji":
+		if text, ok := node.Attrs["text"].(string); ok {
+			sb.WriteString(text)
+		}
+	case "inlineCard":
+		if url, ok := node.Attrs["url"].(string); ok {
+			sb.WriteString(url)
+		}
+	}
+	for i := range node.Content {
+		writeAdfText(sb, &node.Content[i])
+	}
+	switch node.Type {
+	case "paragraph", "heading", "codeBlock", "blockquote", "rule", "tableRow":
+		sb.WriteString("\n")
+	}
+}
+
+func (c *referenceCollector) walk(node *adfNode) {
+	switch node.Type {
+	case "text":
+		for _, mark := range node.Marks {
+			if mark.Type == "link" {
+				if href, ok := mark.Attrs["href"].(string); ok {
+					c.add(models.MentionKindUrl, href)
+				}
+			}
+		}
+		c.scanText(node.Text)
+	case "inlineCard", "blockCard", "embedCard":
+		if url, ok := node.Attrs["url"].(string); ok {
+			c.add(models.MentionKindUrl, url)
+		}
+	}
+	for i := range node.Content {
+		c.walk(&node.Content[i])
+	}
+}
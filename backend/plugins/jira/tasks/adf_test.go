@@ -0,0 +1,67 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractTextReferences(t *testing.T) {
+	wiki, _ := json.Marshal("Blocked by DEV-12, see [the PR|https://github.com/apache/incubator-devlake/pull/1] and https://example.com/DOC-3.")
+	assert.Equal(t, []textReference{
+		{models.MentionKindUrl, "https://github.com/apache/incubator-devlake/pull/1"},
+		{models.MentionKindUrl, "https://example.com/DOC-3"},
+		{models.MentionKindIssueKey, "DEV-12"},
+	}, extractTextReferences(wiki))
+
+	adf := json.RawMessage(`{"type":"doc","version":1,"content":[
+		{"type":"paragraph","content":[
+			{"type":"text","text":"duplicate of DEV-7 and DEV-7"},
+			{"type":"text","text":"design","marks":[{"type":"link","attrs":{"href":"https://docs.example.com/design"}}]},
+			{"type":"mention","attrs":{"id":"5b10ac8d82e05b22cc7d4ef5","text":"@someone"}}
+		]},
+		{"type":"paragraph","content":[{"type":"inlineCard","attrs":{"url":"https://jira.example.com/browse/OPS-1"}}]}
+	]}`)
+	assert.Equal(t, []textReference{
+		{models.MentionKindIssueKey, "DEV-7"},
+		{models.MentionKindUrl, "https://docs.example.com/design"},
+		{models.MentionKindUrl, "https://jira.example.com/browse/OPS-1"},
+	}, extractTextReferences(adf))
+
+	assert.Empty(t, extractTextReferences(json.RawMessage(`null`)))
+}
+
+func TestAdfToText(t *testing.T) {
+	var doc interface{}
+	err := json.Unmarshal([]byte(`{"type":"doc","version":1,"content":[
+		{"type":"heading","attrs":{"level":3},"content":[{"type":"text","text":"Given"}]},
+		{"type":"bulletList","content":[
+			{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"a user"},{"type":"hardBreak"},{"type":"text","text":"logged in"}]}]},
+			{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"mention","attrs":{"id":"1","text":"@admin"}}]}]}
+		]}
+	]}`), &doc)
+	assert.Nil(t, err)
+	assert.Equal(t, "Given\na user\nlogged in\n@admin", adfToText(doc))
+	assert.Equal(t, "h3. Given", adfToText("h3. Given"))
+	assert.Equal(t, "", adfToText(nil))
+	assert.Equal(t, "", adfToText(map[string]interface{}{"type": "doc"}))
+}
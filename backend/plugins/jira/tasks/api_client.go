@@ -46,6 +46,14 @@ func NewJiraApiClient(taskCtx plugin.TaskContext, connection *models.JiraConnect
 	if err != nil {
 		return nil, err
 	}
+	asyncApiClient.SetRequestBudget(api.NewRequestBudget(connection.RequestBudget))
+	if connection.AdaptiveThrottling {
+		asyncApiClient.SetAdaptiveThrottling(api.RateLimitHeaders{
+			Remaining: "X-RateLimit-Remaining",
+			Limit:     "X-RateLimit-Limit",
+			Reset:     "X-RateLimit-Reset",
+		})
+	}
 
 	return asyncApiClient, nil
 }
@@ -38,7 +38,7 @@ func (c Changelog) ToToolLayer(connectionId, issueId uint64, issueUpdated *time.
 		IssueId:           issueId,
 		AuthorAccountId:   c.Author.getAccountId(),
 		AuthorDisplayName: c.Author.DisplayName,
-		AuthorActive:      c.Author.Active,
+		AuthorActive:      c.Author.Active == nil || *c.Author.Active,
 		Created:           c.Created.ToTime(),
 		IssueUpdated:      issueUpdated,
 	}
@@ -32,7 +32,15 @@ type Comment struct {
 	UpdateAuthor *Account           `json:"updateAuthor"`
 	Created      helper.Iso8601Time `json:"created"`
 	Updated      helper.Iso8601Time `json:"updated"`
-	JsdPublic    bool               `json:"jsdPublic"`
+	// JsdPublic is false for the internal comments of Jira Service Management, missing elsewhere
+	JsdPublic  *bool              `json:"jsdPublic"`
+	Visibility *CommentVisibility `json:"visibility"`
+}
+
+// CommentVisibility restricts a comment to a group or a project role
+type CommentVisibility struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
 }
 
 func (c Comment) ToToolLayer(connectionId uint64, issueId uint64, issueUpdated *time.Time) *models.JiraIssueComment {
@@ -42,10 +50,13 @@ func (c Comment) ToToolLayer(connectionId uint64, issueId uint64, issueUpdated *
 		ComentId:     c.Id,
 		Self:         c.Self,
 		Body:         c.Body,
-		Created:      c.Updated.ToTime(),
+		Created:      c.Created.ToTime(),
 		Updated:      c.Updated.ToTime(),
 		IssueUpdated: issueUpdated,
 	}
+	// Jira keeps the updated date of comments never edited equal to their creation date
+	result.Edited = result.Updated.After(result.Created)
+	result.Restricted = c.Visibility != nil || (c.JsdPublic != nil && !*c.JsdPublic)
 	if c.Author != nil {
 		result.CreatorAccountId = c.Author.getAccountId()
 		result.CreatorDisplayName = c.Author.DisplayName
@@ -0,0 +1,66 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiv2models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComment_ToToolLayerEdited(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want bool
+	}{
+		{"never edited", `{"id":"1","created":"2023-08-01T10:00:00.000+0000","updated":"2023-08-01T10:00:00.000+0000"}`, false},
+		{"edited", `{"id":"2","created":"2023-08-01T10:00:00.000+0000","updated":"2023-08-02T09:30:00.000+0000"}`, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var c Comment
+			require.NoError(t, json.Unmarshal([]byte(tt.json), &c))
+			got := c.ToToolLayer(1, 10, nil)
+			assert.Equal(t, tt.want, got.Edited)
+			assert.True(t, got.Created.Equal(c.Created.ToTime()))
+		})
+	}
+}
+
+func TestComment_ToToolLayerRestricted(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want bool
+	}{
+		{"public", `{"id":"1"}`, false},
+		{"public service desk", `{"id":"2","jsdPublic":true}`, false},
+		{"internal service desk", `{"id":"3","jsdPublic":false}`, true},
+		{"role", `{"id":"4","visibility":{"type":"role","value":"Administrators"}}`, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var c Comment
+			require.NoError(t, json.Unmarshal([]byte(tt.json), &c))
+			assert.Equal(t, tt.want, c.ToToolLayer(1, 10, nil).Restricted)
+		})
+	}
+}
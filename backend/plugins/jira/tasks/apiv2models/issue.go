@@ -43,6 +43,8 @@ type Issue struct {
 			Name        string `json:"name"`
 			Subtask     bool   `json:"subtask"`
 			AvatarID    int    `json:"avatarId"`
+			// HierarchyLevel is only returned by Jira Cloud
+			HierarchyLevel *int `json:"hierarchyLevel"`
 		} `json:"issuetype"`
 		Parent *struct {
 			ID  uint64 `json:"id,string"`
@@ -64,13 +66,15 @@ type Issue struct {
 				Three2X32 string `json:"32x32"`
 			} `json:"avatarUrls"`
 		} `json:"project"`
-		FixVersions        []interface{}       `json:"fixVersions"`
-		Aggregatetimespent interface{}         `json:"aggregatetimespent"`
-		Resolution         interface{}         `json:"resolution"`
-		Resolutiondate     *helper.Iso8601Time `json:"resolutiondate"`
-		Workratio          int                 `json:"workratio"`
-		LastViewed         string              `json:"lastViewed"`
-		Watches            struct {
+		FixVersions        []Version   `json:"fixVersions"`
+		Aggregatetimespent interface{} `json:"aggregatetimespent"`
+		Resolution         *struct {
+			Name string `json:"name"`
+		} `json:"resolution"`
+		Resolutiondate *helper.Iso8601Time `json:"resolutiondate"`
+		Workratio      int                 `json:"workratio"`
+		LastViewed     string              `json:"lastViewed"`
+		Watches        struct {
 			Self       string `json:"self"`
 			WatchCount int    `json:"watchCount"`
 			IsWatching bool   `json:"isWatching"`
@@ -87,17 +91,26 @@ type Issue struct {
 			} `json:"color"`
 			Done bool `json:"done"`
 		} `json:"epic"`
+		Security *struct {
+			Self string `json:"self"`
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"security"`
 		Priority *struct {
 			Self    string `json:"self"`
 			IconURL string `json:"iconUrl"`
 			Name    string `json:"name"`
 			ID      uint64 `json:"id,string"`
 		} `json:"priority"`
+		Components []struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"components"`
 		Labels                        []string           `json:"labels"`
 		Timeestimate                  interface{}        `json:"timeestimate"`
 		Aggregatetimeoriginalestimate interface{}        `json:"aggregatetimeoriginalestimate"`
-		Versions                      []interface{}      `json:"versions"`
-		Issuelinks                    []interface{}      `json:"issuelinks"`
+		Versions                      []Version          `json:"versions"`
+		Issuelinks                    []IssueLink        `json:"issuelinks"`
 		Assignee                      *Account           `json:"assignee"`
 		Updated                       helper.Iso8601Time `json:"updated"`
 		Status                        struct {
@@ -174,6 +187,7 @@ func (i Issue) toToolLayer(connectionId uint64) *models.JiraIssue {
 		Summary:            i.Fields.Summary,
 		Description:        i.Fields.Description,
 		Type:               i.Fields.Issuetype.ID,
+		HierarchyLevel:     i.Fields.Issuetype.HierarchyLevel,
 		StatusName:         i.Fields.Status.Name,
 		StatusKey:          i.Fields.Status.StatusCategory.Key,
 		ResolutionDate:     i.Fields.Resolutiondate.ToNullableTime(),
@@ -181,6 +195,13 @@ func (i Issue) toToolLayer(connectionId uint64) *models.JiraIssue {
 		CreatorDisplayName: i.Fields.Creator.DisplayName,
 		Created:            i.Fields.Created.ToTime(),
 		Updated:            i.Fields.Updated.ToTime(),
+		WatchCount:         i.Fields.Watches.WatchCount,
+	}
+	for _, component := range i.Fields.Components {
+		result.Components = append(result.Components, component.Name)
+	}
+	if i.Fields.Resolution != nil {
+		result.ResolutionName = i.Fields.Resolution.Name
 	}
 	if i.Changelog != nil {
 		result.ChangelogTotal = i.Changelog.Total
@@ -192,6 +213,9 @@ func (i Issue) toToolLayer(connectionId uint64) *models.JiraIssue {
 		result.AssigneeAccountId = i.Fields.Assignee.getAccountId()
 		result.AssigneeDisplayName = i.Fields.Assignee.DisplayName
 	}
+	if i.Fields.Security != nil {
+		result.SecurityLevel = i.Fields.Security.Name
+	}
 	if i.Fields.Priority != nil {
 		result.PriorityId = i.Fields.Priority.ID
 		result.PriorityName = i.Fields.Priority.Name
@@ -253,8 +277,12 @@ func (i Issue) ExtractEntities(connectionId uint64) ([]uint64, *models.JiraIssue
 		if len(i.Fields.Comment.Comments) <= i.Fields.Comment.Total {
 			issueUpdated = i.Fields.Updated.ToNullableTime()
 		}
+		issue.CommentsTruncated = len(i.Fields.Comment.Comments) < i.Fields.Comment.Total
 		for _, c := range i.Fields.Comment.Comments {
 			comments = append(comments, c.ToToolLayer(connectionId, i.ID, issueUpdated))
+			if created := c.Created.ToTime(); issue.LastCommentedDate == nil || created.After(*issue.LastCommentedDate) {
+				issue.LastCommentedDate = &created
+			}
 		}
 	}
 	if i.Fields.Worklog != nil {
@@ -0,0 +1,90 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiv2models
+
+import (
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+type IssueLinkType struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Inward  string `json:"inward"`
+	Outward string `json:"outward"`
+	Self    string `json:"self"`
+}
+
+func (t IssueLinkType) ToToolLayer(connectionId uint64) *models.JiraIssueLinkType {
+	return &models.JiraIssueLinkType{
+		ConnectionId: connectionId,
+		Id:           t.ID,
+		Self:         t.Self,
+		Name:         t.Name,
+		Inward:       t.Inward,
+		Outward:      t.Outward,
+	}
+}
+
+type LinkedIssue struct {
+	ID  uint64 `json:"id,string"`
+	Key string `json:"key"`
+}
+
+// IssueLink is an entry of `fields.issuelinks`, only one of InwardIssue and OutwardIssue is set, it is the other
+// end of the link
+type IssueLink struct {
+	ID           uint64        `json:"id,string"`
+	Type         IssueLinkType `json:"type"`
+	InwardIssue  *LinkedIssue  `json:"inwardIssue"`
+	OutwardIssue *LinkedIssue  `json:"outwardIssue"`
+}
+
+// ToToolLayer labels the link with the instance's link type definition, falling back to the type name in the
+// payload when the definition was not collected
+func (l IssueLink) ToToolLayer(connectionId, issueId uint64, linkType *models.JiraIssueLinkType) *models.JiraIssueRelationship {
+	relationship := &models.JiraIssueRelationship{
+		ConnectionId: connectionId,
+		IssueId:      issueId,
+		LinkId:       l.ID,
+		LinkTypeId:   l.Type.ID,
+		LinkTypeName: l.Type.Name,
+		Label:        l.Type.Name,
+	}
+	related := l.OutwardIssue
+	relationship.Direction = models.IssueLinkOutward
+	if related == nil {
+		related = l.InwardIssue
+		relationship.Direction = models.IssueLinkInward
+	}
+	if related == nil {
+		return nil
+	}
+	relationship.RelatedIssueId = related.ID
+	relationship.RelatedIssueKey = related.Key
+	if linkType != nil {
+		relationship.LinkTypeName = linkType.Name
+		label := linkType.Outward
+		if relationship.Direction == models.IssueLinkInward {
+			label = linkType.Inward
+		}
+		if label != "" {
+			relationship.Label = label
+		}
+	}
+	return relationship
+}
@@ -22,17 +22,33 @@ import (
 )
 
 type Project struct {
-	Self string `json:"self"`
-	ID   string `json:"id"`
-	Key  string `json:"key"`
-	Name string `json:"name"`
+	Self            string   `json:"self"`
+	ID              string   `json:"id"`
+	Key             string   `json:"key"`
+	Name            string   `json:"name"`
+	Description     string   `json:"description"`
+	Lead            *Account `json:"lead"`
+	ProjectTypeKey  string   `json:"projectTypeKey"`
+	Style           string   `json:"style"`
+	ProjectCategory *struct {
+		Name string `json:"name"`
+	} `json:"projectCategory"`
 }
 
 func (p Project) ToToolLayer(connectionId uint64) *models.JiraProject {
-	return &models.JiraProject{
-		ConnectionId: connectionId,
-		Id:           p.ID,
-		ProjectKey:   p.Key,
-		Name:         p.Name,
+	project := &models.JiraProject{
+		ConnectionId:   connectionId,
+		Id:             p.ID,
+		ProjectKey:     p.Key,
+		Name:           p.Name,
+		Self:           p.Self,
+		Description:    p.Description,
+		LeadAccountId:  p.Lead.getAccountId(),
+		ProjectTypeKey: p.ProjectTypeKey,
+		Style:          p.Style,
 	}
+	if p.ProjectCategory != nil {
+		project.CategoryName = p.ProjectCategory.Name
+	}
+	return project
 }
@@ -0,0 +1,43 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiv2models
+
+import (
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+type QuickFilter struct {
+	ID          uint64 `json:"id"`
+	BoardID     uint64 `json:"boardId"`
+	Name        string `json:"name"`
+	Jql         string `json:"jql"`
+	Description string `json:"description"`
+	Position    int    `json:"position"`
+}
+
+func (q QuickFilter) ToToolLayer(connectionId, boardId uint64) *models.JiraBoardQuickFilter {
+	return &models.JiraBoardQuickFilter{
+		ConnectionId:  connectionId,
+		BoardId:       boardId,
+		QuickFilterId: q.ID,
+		Name:          q.Name,
+		Jql:           q.Jql,
+		Description:   q.Description,
+		Position:      q.Position,
+	}
+}
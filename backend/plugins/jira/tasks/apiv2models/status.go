@@ -38,3 +38,11 @@ type Status struct {
 	} `json:"statusCategory"`
 	UntranslatedName string `json:"untranslatedName"`
 }
+
+// IssueTypeStatuses are the statuses the workflow of a project allows for one of its issue types
+type IssueTypeStatuses struct {
+	ID       string   `json:"id"`
+	Name     string   `json:"name"`
+	Subtask  bool     `json:"subtask"`
+	Statuses []Status `json:"statuses"`
+}
@@ -35,10 +35,11 @@ type Account struct {
 		Three2X32 string `json:"32x32"`
 	} `json:"avatarUrls"`
 	DisplayName string `json:"displayName"`
-	Active      bool   `json:"active"`
-	Deleted     bool   `json:"deleted"`
-	TimeZone    string `json:"timeZone"`
-	Locale      string `json:"locale"`
+	// Active is missing from the users embedded by some Jira Server versions
+	Active   *bool  `json:"active"`
+	Deleted  bool   `json:"deleted"`
+	TimeZone string `json:"timeZone"`
+	Locale   string `json:"locale"`
 }
 
 func (u *Account) getAccountId() string {
@@ -67,6 +68,7 @@ func (u *Account) ToToolLayer(connectionId uint64) *models.JiraAccount {
 			Email:        u.EmailAddress,
 			Timezone:     u.TimeZone,
 			AvatarUrl:    u.AvatarUrls.Four8X48,
+			Active:       u.Active,
 		}
 	}
 }
@@ -34,7 +34,7 @@ func TestUser_getAccountId(t *testing.T) {
 			Three2X32 string `json:"32x32"`
 		}
 		DisplayName string
-		Active      bool
+		Active      *bool
 		Deleted     bool
 		TimeZone    string
 		Locale      string
@@ -83,3 +83,13 @@ func TestUser_getAccountId(t *testing.T) {
 		})
 	}
 }
+
+func TestUser_ToToolLayerActive(t *testing.T) {
+	active, inactive := true, false
+	for _, want := range []*bool{&active, &inactive, nil} {
+		u := &Account{AccountId: "abcd", Active: want}
+		if got := u.ToToolLayer(1).Active; got != want {
+			t.Errorf("ToToolLayer().Active = %v, want %v", got, want)
+		}
+	}
+}
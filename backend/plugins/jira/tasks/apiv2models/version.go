@@ -0,0 +1,45 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiv2models
+
+import (
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+// Version is an entry of `fields.fixVersions` or `fields.versions`
+type Version struct {
+	Self        string `json:"self"`
+	ID          uint64 `json:"id,string"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Archived    bool   `json:"archived"`
+	Released    bool   `json:"released"`
+	ReleaseDate string `json:"releaseDate"`
+}
+
+func (v Version) ToToolLayer(connectionId, projectId uint64) *models.JiraVersion {
+	return &models.JiraVersion{
+		ConnectionId: connectionId,
+		VersionId:    v.ID,
+		ProjectId:    projectId,
+		Name:         v.Name,
+		Description:  v.Description,
+		Archived:     v.Archived,
+		Released:     v.Released,
+	}
+}
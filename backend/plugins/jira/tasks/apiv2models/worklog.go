@@ -18,23 +18,24 @@ limitations under the License.
 package apiv2models
 
 import (
+	"encoding/json"
 	helper "github.com/apache/incubator-devlake/helpers/pluginhelper/api"
 	"github.com/apache/incubator-devlake/plugins/jira/models"
 	"time"
 )
 
 type Worklog struct {
-	Self             string             `json:"self"`
-	Author           *Account           `json:"author"`
-	UpdateAuthor     *Account           `json:"updateAuthor"`
-	Comment          string             `json:"comment"`
-	Created          string             `json:"created"`
-	Updated          helper.Iso8601Time `json:"updated"`
-	Started          helper.Iso8601Time `json:"started"`
-	TimeSpent        string             `json:"timeSpent"`
-	TimeSpentSeconds int                `json:"timeSpentSeconds"`
-	ID               string             `json:"id"`
-	IssueID          uint64             `json:"issueId,string"`
+	Self             string              `json:"self"`
+	Author           *Account            `json:"author"`
+	UpdateAuthor     *Account            `json:"updateAuthor"`
+	Comment          string              `json:"comment"`
+	Created          *helper.Iso8601Time `json:"created"`
+	Updated          helper.Iso8601Time  `json:"updated"`
+	Started          helper.Iso8601Time  `json:"started"`
+	TimeSpent        string              `json:"timeSpent"`
+	TimeSpentSeconds int                 `json:"timeSpentSeconds"`
+	ID               string              `json:"id"`
+	IssueID          uint64              `json:"issueId,string"`
 }
 
 func (w Worklog) ToToolLayer(connectionId uint64, issueUpdated *time.Time) *models.JiraWorklog {
@@ -46,8 +47,13 @@ func (w Worklog) ToToolLayer(connectionId uint64, issueUpdated *time.Time) *mode
 		TimeSpentSeconds: w.TimeSpentSeconds,
 		Updated:          w.Updated.ToTime(),
 		Started:          w.Started.ToTime(),
+		Created:          w.Created.ToNullableTime(),
 		IssueUpdated:     issueUpdated,
 	}
+	// worklogs without a start were done when they got logged
+	if result.Started.IsZero() && result.Created != nil {
+		result.Started = *result.Created
+	}
 	if w.Author != nil {
 		result.AuthorId = w.Author.getAccountId()
 	}
@@ -56,3 +62,16 @@ func (w Worklog) ToToolLayer(connectionId uint64, issueUpdated *time.Time) *mode
 	}
 	return result
 }
+
+// WorklogChange is an entry of the worklogs updated or deleted since a given time
+type WorklogChange struct {
+	WorklogId   uint64 `json:"worklogId"`
+	UpdatedTime int64  `json:"updatedTime"`
+}
+
+// WorklogChanges is a page of worklog changes, the next page starts at Until
+type WorklogChanges struct {
+	Values   []json.RawMessage `json:"values"`
+	Until    int64             `json:"until"`
+	LastPage bool              `json:"lastPage"`
+}
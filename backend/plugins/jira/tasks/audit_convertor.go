@@ -0,0 +1,158 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"reflect"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ConvertAuditChanges
+
+var ConvertAuditChangesMeta = plugin.SubTaskMeta{
+	Name:             "convertAuditChanges",
+	EntryPoint:       ConvertAuditChanges,
+	EnabledByDefault: true,
+	Description:      "flag the Jira issues changed by the account under audit and convert the changes into issue changelogs",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+type auditChangeCount struct {
+	IssueId uint64
+	Count   int
+}
+
+// ConvertAuditChanges flags the issues of the board with the account under audit and the number of fields it
+// changed, clearing the flags left by a previous audit on the issues it didn't change, then writes the changes to
+// the domain issue_changelogs. Issues excluded by their security level are left out
+func ConvertAuditChanges(taskCtx plugin.SubTaskContext) errors.Error {
+	db := taskCtx.GetDal()
+	data := taskCtx.GetData().(*JiraTaskData)
+	if data.Options.AuditAccountId == "" {
+		return nil
+	}
+	connectionId := data.Options.ConnectionId
+
+	var changeCounts []*auditChangeCount
+	err := db.All(&changeCounts,
+		dal.Select("issue_id, COUNT(*) AS count"),
+		dal.From(&models.JiraAuditChange{}),
+		dal.Where("connection_id = ? AND board_id = ? AND account_id = ?", connectionId, data.Options.BoardId, data.Options.AuditAccountId),
+		dal.Groupby("issue_id"),
+	)
+	if err != nil {
+		return err
+	}
+	counts := make(map[uint64]int, len(changeCounts))
+	for _, changeCount := range changeCounts {
+		counts[changeCount.IssueId] = changeCount.Count
+	}
+
+	var boardIssueIds []uint64
+	err = db.Pluck("ji.issue_id", &boardIssueIds,
+		dal.From("_tool_jira_issues ji"),
+		dal.Join(`JOIN _tool_jira_board_issues bi ON (bi.connection_id = ji.connection_id AND bi.issue_id = ji.issue_id)`),
+		dal.Where("ji.connection_id = ? AND bi.board_id = ? AND ji.security_excluded = ?", connectionId, data.Options.BoardId, false),
+	)
+	if err != nil {
+		return err
+	}
+	issueIdGen := didgen.NewDomainIdGenerator(&models.JiraIssue{})
+	updater := api.NewBatchUpdater(db, &ticket.Issue{}, "id")
+	for _, issueId := range boardIssueIds {
+		var accountId *string
+		var changeCount *int
+		if count, ok := counts[issueId]; ok {
+			accountId = &data.Options.AuditAccountId
+			changeCount = &count
+		}
+		err = updater.Add(issueIdGen.Generate(connectionId, issueId),
+			dal.DalSet{ColumnName: "audit_account_id", Value: accountId},
+			dal.DalSet{ColumnName: "audit_change_count", Value: changeCount},
+		)
+		if err != nil {
+			return err
+		}
+	}
+	err = updater.Flush()
+	if err != nil {
+		return err
+	}
+	return convertAuditChangelogs(taskCtx)
+}
+
+// convertAuditChangelogs writes the changes made by the account under audit to the domain issue_changelogs, with
+// the ids ConvertIssueChangelogs gives the same changelog items
+func convertAuditChangelogs(taskCtx plugin.SubTaskContext) errors.Error {
+	db := taskCtx.GetDal()
+	data := taskCtx.GetData().(*JiraTaskData)
+	connectionId := data.Options.ConnectionId
+	cursor, err := db.Cursor(
+		dal.From(&models.JiraAuditChange{}),
+		dal.Where("connection_id = ? AND board_id = ? AND account_id = ?", connectionId, data.Options.BoardId, data.Options.AuditAccountId),
+		securityLevelFilter("connection_id", "issue_id"),
+	)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+	issueIdGen := didgen.NewDomainIdGenerator(&models.JiraIssue{})
+	changelogIdGen := didgen.NewDomainIdGenerator(&models.JiraIssueChangelogItems{})
+	accountIdGen := didgen.NewDomainIdGenerator(&models.JiraAccount{})
+	converter, err := api.NewDataConverter(api.DataConverterArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: JiraApiParams{
+				ConnectionId: connectionId,
+				BoardId:      data.Options.BoardId,
+			},
+			Table: RAW_AUDIT_ISSUE_TABLE,
+		},
+		InputRowType: reflect.TypeOf(models.JiraAuditChange{}),
+		Input:        cursor,
+		Convert: func(inputRow interface{}) ([]interface{}, errors.Error) {
+			change := inputRow.(*models.JiraAuditChange)
+			return []interface{}{toAuditChangelog(change, issueIdGen, changelogIdGen, accountIdGen)}, nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return converter.Execute()
+}
+
+func toAuditChangelog(change *models.JiraAuditChange, issueIdGen, changelogIdGen, accountIdGen *didgen.DomainIdGenerator) *ticket.IssueChangelogs {
+	return &ticket.IssueChangelogs{
+		DomainEntity:      domainlayer.DomainEntity{Id: changelogIdGen.Generate(change.ConnectionId, change.ChangelogId, change.Field)},
+		IssueId:           issueIdGen.Generate(change.ConnectionId, change.IssueId),
+		AuthorId:          accountIdGen.Generate(change.ConnectionId, change.AccountId),
+		FieldId:           change.Field,
+		FieldName:         change.Field,
+		OriginalFromValue: change.FromString,
+		OriginalToValue:   change.ToString,
+		CreatedDate:       change.Created,
+	}
+}
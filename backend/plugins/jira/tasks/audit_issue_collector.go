@@ -0,0 +1,211 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/apache/incubator-devlake/plugins/jira/tasks/apiv2models"
+)
+
+const (
+	RAW_AUDIT_ISSUE_TABLE     = "jira_api_audit_issues"
+	RAW_AUDIT_CHANGELOG_TABLE = "jira_api_audit_changelogs"
+)
+
+var _ plugin.SubTaskEntryPoint = CollectAuditIssues
+
+var CollectAuditIssuesMeta = plugin.SubTaskMeta{
+	Name:             "collectAuditIssues",
+	EntryPoint:       CollectAuditIssues,
+	EnabledByDefault: true,
+	RunsWhenStaged:   true,
+	Description:      "collect the issues of the board changed by an account for audit, only runs when auditAccountId is set",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+// CollectAuditIssues collects the issues of the board updated by the account under audit along with their
+// changelogs, then pages through the changelogs of the issues having more than the ones embedded. The whole window
+// is collected on every run, the jql being widened to whole minutes, or to whole days when the timezone of the user
+// is unknown, ExtractAuditIssues keeping the changes within the exact window
+func CollectAuditIssues(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*JiraTaskData)
+	if data.Options.AuditAccountId == "" {
+		return nil
+	}
+	logger := taskCtx.GetLogger()
+	logger.Info("collect the issues changed by %s", data.Options.AuditAccountId)
+	loc, err := getTimeZone(taskCtx)
+	if err != nil {
+		logger.Info("failed to get timezone, err: %v", err)
+	}
+	jql := buildAuditJQL(data.Options.AuditAccountId, data.AuditSince, data.AuditUntil, loc)
+	pagesChangelogs := pagesAuditChangelogs(data)
+	var truncatedMutex sync.Mutex
+	var truncatedIssueIds []uint64
+
+	collector, err := api.NewApiCollector(api.ApiCollectorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: JiraApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				BoardId:      data.Options.BoardId,
+			},
+			Table: RAW_AUDIT_ISSUE_TABLE,
+		},
+		ApiClient:   data.ApiClient,
+		PageSize:    data.Options.PageSize,
+		UrlTemplate: "agile/1.0/board/{{ .Params.BoardId }}/issue",
+		Query: func(reqData *api.RequestData) (url.Values, errors.Error) {
+			query := url.Values{}
+			query.Set("jql", jql)
+			query.Set("fields", "created,updated")
+			query.Set("startAt", fmt.Sprintf("%v", reqData.Pager.Skip))
+			query.Set("maxResults", fmt.Sprintf("%v", reqData.Pager.Size))
+			query.Set("expand", "changelog")
+			return query, nil
+		},
+		GetTotalPages: GetTotalPagesFromResponse,
+		Concurrency:   data.ApiClient.GetNumOfWorkers(),
+		ResponseParser: func(res *http.Response) ([]json.RawMessage, errors.Error) {
+			var data struct {
+				Issues []json.RawMessage `json:"issues"`
+			}
+			err := api.UnmarshalResponse(res, &data)
+			if err != nil {
+				return nil, err
+			}
+			if !pagesChangelogs {
+				return data.Issues, nil
+			}
+			for _, raw := range data.Issues {
+				var apiIssue apiv2models.Issue
+				if err := errors.Convert(json.Unmarshal(raw, &apiIssue)); err != nil {
+					return nil, err
+				}
+				if isAuditChangelogTruncated(&apiIssue) {
+					truncatedMutex.Lock()
+					truncatedIssueIds = append(truncatedIssueIds, apiIssue.ID)
+					truncatedMutex.Unlock()
+				}
+			}
+			return data.Issues, nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+	err = collector.Execute()
+	if err != nil {
+		return err
+	}
+	if !pagesChangelogs {
+		logger.Warn(nil, "Jira Server doesn't page changelogs, the changes beyond the ones embedded in the issues are missed")
+		return nil
+	}
+	return collectAuditChangelogs(taskCtx, truncatedIssueIds)
+}
+
+// collectAuditChangelogs pages through the changelogs of the issues, collecting none still clears the ones
+// collected by the previous run
+func collectAuditChangelogs(taskCtx plugin.SubTaskContext, issueIds []uint64) errors.Error {
+	data := taskCtx.GetData().(*JiraTaskData)
+	iterator := api.NewQueueIterator()
+	for _, issueId := range issueIds {
+		iterator.Push(&apiv2models.Input{IssueId: issueId})
+	}
+	collector, err := api.NewApiCollector(api.ApiCollectorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: JiraApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				BoardId:      data.Options.BoardId,
+			},
+			Table: RAW_AUDIT_CHANGELOG_TABLE,
+		},
+		ApiClient:     data.ApiClient,
+		PageSize:      100,
+		Input:         iterator,
+		UrlTemplate:   "api/3/issue/{{ .Input.IssueId }}/changelog",
+		GetTotalPages: GetTotalPagesFromResponse,
+		Concurrency:   data.ApiClient.GetNumOfWorkers(),
+		Query: func(reqData *api.RequestData) (url.Values, errors.Error) {
+			query := url.Values{}
+			query.Set("startAt", fmt.Sprintf("%v", reqData.Pager.Skip))
+			query.Set("maxResults", fmt.Sprintf("%v", reqData.Pager.Size))
+			return query, nil
+		},
+		ResponseParser: func(res *http.Response) ([]json.RawMessage, errors.Error) {
+			var data struct {
+				Values []json.RawMessage
+			}
+			err := api.UnmarshalResponse(res, &data)
+			if err != nil {
+				return nil, err
+			}
+			return data.Values, nil
+		},
+		AfterResponse: ignoreHTTPStatus404,
+	})
+	if err != nil {
+		return err
+	}
+	return collector.Execute()
+}
+
+// pagesAuditChangelogs tells whether the changelogs of the issues can be paged through, like
+// CollectIssueChangelogs Jira Server is left out
+func pagesAuditChangelogs(data *JiraTaskData) bool {
+	return data.JiraServerInfo.DeploymentType != models.DeploymentServer
+}
+
+// isAuditChangelogTruncated tells whether the issue has more changelogs than the ones embedded
+func isAuditChangelogTruncated(apiIssue *apiv2models.Issue) bool {
+	return apiIssue.Changelog != nil && apiIssue.Changelog.Total > len(apiIssue.Changelog.Histories)
+}
+
+// buildAuditJQL returns the jql matching the issues updated by the account within the window, sorted by creation
+func buildAuditJQL(accountId string, since, until *time.Time, location *time.Location) string {
+	args := []string{strconv.Quote(accountId)}
+	if since != nil {
+		moment := since.In(time.UTC).Add(-24 * time.Hour)
+		if location != nil {
+			moment = since.In(location)
+		}
+		args = append(args, strconv.Quote(moment.Format("2006/01/02 15:04")))
+	}
+	if since != nil && until != nil {
+		moment := until.In(time.UTC).Add(24 * time.Hour)
+		if location != nil {
+			moment = until.In(location).Add(time.Minute)
+		}
+		args = append(args, strconv.Quote(moment.Format("2006/01/02 15:04")))
+	}
+	return fmt.Sprintf("issue in updatedBy(%s) ORDER BY created ASC", strings.Join(args, ", "))
+}
@@ -0,0 +1,142 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/apache/incubator-devlake/plugins/jira/tasks/apiv2models"
+)
+
+var _ plugin.SubTaskEntryPoint = ExtractAuditIssues
+
+var ExtractAuditIssuesMeta = plugin.SubTaskMeta{
+	Name:             "extractAuditIssues",
+	EntryPoint:       ExtractAuditIssues,
+	EnabledByDefault: true,
+	Description:      "extract the changes made to Jira issues by the account under audit",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+// ExtractAuditIssues keeps the changelog items authored by the account under audit within the audit window, out
+// of the changelogs embedded in the issues or, for the issues having more, of the changelogs paged through
+func ExtractAuditIssues(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*JiraTaskData)
+	if data.Options.AuditAccountId == "" {
+		return nil
+	}
+	pagesChangelogs := pagesAuditChangelogs(data)
+	extractor, err := api.NewApiExtractor(api.ApiExtractorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: JiraApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				BoardId:      data.Options.BoardId,
+			},
+			Table: RAW_AUDIT_ISSUE_TABLE,
+		},
+		Extract: func(row *api.RawData) ([]interface{}, errors.Error) {
+			var apiIssue apiv2models.Issue
+			err := errors.Convert(json.Unmarshal(row.Data, &apiIssue))
+			if err != nil {
+				return nil, err
+			}
+			if apiIssue.Changelog == nil || (pagesChangelogs && isAuditChangelogTruncated(&apiIssue)) {
+				return nil, nil
+			}
+			var results []interface{}
+			for _, change := range getAuditChanges(data, apiIssue.ID, apiIssue.Changelog.Histories) {
+				results = append(results, change)
+			}
+			return results, nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+	err = extractor.Execute()
+	if err != nil {
+		return err
+	}
+
+	changelogExtractor, err := api.NewApiExtractor(api.ApiExtractorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: JiraApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				BoardId:      data.Options.BoardId,
+			},
+			Table: RAW_AUDIT_CHANGELOG_TABLE,
+		},
+		Extract: func(row *api.RawData) ([]interface{}, errors.Error) {
+			var input apiv2models.Input
+			err := errors.Convert(json.Unmarshal(row.Input, &input))
+			if err != nil {
+				return nil, err
+			}
+			var changelog apiv2models.Changelog
+			err = errors.Convert(json.Unmarshal(row.Data, &changelog))
+			if err != nil {
+				return nil, err
+			}
+			var results []interface{}
+			for _, change := range getAuditChanges(data, input.IssueId, []apiv2models.Changelog{changelog}) {
+				results = append(results, change)
+			}
+			return results, nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return changelogExtractor.Execute()
+}
+
+// getAuditChanges returns the changelog items of the issue authored by the account under audit within the window
+func getAuditChanges(data *JiraTaskData, issueId uint64, histories []apiv2models.Changelog) []*models.JiraAuditChange {
+	var changes []*models.JiraAuditChange
+	for _, apiChangelog := range histories {
+		changelog, _ := apiChangelog.ToToolLayer(data.Options.ConnectionId, issueId, nil)
+		if changelog.AuthorAccountId != data.Options.AuditAccountId || !isInAuditWindow(changelog.Created, data.AuditSince, data.AuditUntil) {
+			continue
+		}
+		for _, item := range apiChangelog.Items {
+			changes = append(changes, &models.JiraAuditChange{
+				ConnectionId: data.Options.ConnectionId,
+				BoardId:      data.Options.BoardId,
+				ChangelogId:  changelog.ChangelogId,
+				Field:        item.Field,
+				IssueId:      issueId,
+				AccountId:    changelog.AuthorAccountId,
+				Created:      changelog.Created,
+				FromString:   item.FromString,
+				ToString:     item.ToString,
+			})
+		}
+	}
+	return changes
+}
+
+func isInAuditWindow(t time.Time, since, until *time.Time) bool {
+	return (since == nil || !t.Before(*since)) && (until == nil || !t.After(*until))
+}
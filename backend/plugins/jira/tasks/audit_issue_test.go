@@ -0,0 +1,108 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/apache/incubator-devlake/plugins/jira/tasks/apiv2models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildAuditJQL(t *testing.T) {
+	since := time.Date(2023, 8, 1, 10, 30, 45, 0, time.UTC)
+	until := time.Date(2023, 8, 31, 18, 0, 0, 0, time.UTC)
+	shanghai := time.FixedZone("Asia/Shanghai", 8*3600)
+	assert.Equal(t, `issue in updatedBy("5b10a2844c20165700ede21g") ORDER BY created ASC`,
+		buildAuditJQL("5b10a2844c20165700ede21g", nil, nil, shanghai))
+	assert.Equal(t, `issue in updatedBy("bot", "2023/08/01 18:30") ORDER BY created ASC`,
+		buildAuditJQL("bot", &since, nil, shanghai))
+	assert.Equal(t, `issue in updatedBy("bot", "2023/08/01 18:30", "2023/09/01 02:01") ORDER BY created ASC`,
+		buildAuditJQL("bot", &since, &until, shanghai))
+	assert.Equal(t, `issue in updatedBy("bot", "2023/07/31 10:30", "2023/09/01 18:00") ORDER BY created ASC`,
+		buildAuditJQL("bot", &since, &until, nil))
+}
+
+func TestGetAuditChanges(t *testing.T) {
+	var apiIssue apiv2models.Issue
+	err := json.Unmarshal([]byte(`{"id": "10001", "changelog": {"histories": [
+		{"id": "1", "author": {"accountId": "bot"}, "created": "2023-08-01T09:00:00.000+0000", "items": [{"field": "status", "fromString": "To Do", "toString": "Done"}]},
+		{"id": "2", "author": {"accountId": "bot"}, "created": "2023-08-02T09:00:00.000+0000", "items": [{"field": "status", "fromString": "Done", "toString": "To Do"}, {"field": "labels", "toString": "audited"}]},
+		{"id": "3", "author": {"accountId": "alice"}, "created": "2023-08-02T10:00:00.000+0000", "items": [{"field": "summary"}]}
+	]}}`), &apiIssue)
+	assert.Nil(t, err)
+	since := time.Date(2023, 8, 2, 0, 0, 0, 0, time.UTC)
+	data := &JiraTaskData{Options: &JiraOptions{ConnectionId: 1, BoardId: 2, AuditAccountId: "bot"}, AuditSince: &since}
+	changes := getAuditChanges(data, apiIssue.ID, apiIssue.Changelog.Histories)
+	assert.Len(t, changes, 2)
+	assert.Equal(t, uint64(2), changes[0].ChangelogId)
+	assert.Equal(t, uint64(10001), changes[0].IssueId)
+	assert.Equal(t, "status", changes[0].Field)
+	assert.Equal(t, "To Do", changes[0].ToString)
+	assert.Equal(t, "labels", changes[1].Field)
+
+	data.AuditSince = nil
+	assert.Len(t, getAuditChanges(data, apiIssue.ID, apiIssue.Changelog.Histories), 3)
+	assert.Len(t, getAuditChanges(data, apiIssue.ID, nil), 0)
+}
+
+func TestIsAuditChangelogTruncated(t *testing.T) {
+	var apiIssue apiv2models.Issue
+	assert.False(t, isAuditChangelogTruncated(&apiIssue))
+	assert.Nil(t, json.Unmarshal([]byte(`{"id": "10001", "changelog": {"total": 2, "histories": [{"id": "1"}, {"id": "2"}]}}`), &apiIssue))
+	assert.False(t, isAuditChangelogTruncated(&apiIssue))
+	assert.Nil(t, json.Unmarshal([]byte(`{"id": "10001", "changelog": {"total": 101, "histories": [{"id": "1"}]}}`), &apiIssue))
+	assert.True(t, isAuditChangelogTruncated(&apiIssue))
+
+	// Jira Server doesn't page changelogs
+	data := &JiraTaskData{}
+	assert.True(t, pagesAuditChangelogs(data))
+	data.JiraServerInfo.DeploymentType = models.DeploymentServer
+	assert.False(t, pagesAuditChangelogs(data))
+}
+
+func TestToAuditChangelog(t *testing.T) {
+	registerJiraForTest(t)
+	created := time.Date(2023, 8, 2, 9, 0, 0, 0, time.UTC)
+	changelog := toAuditChangelog(&models.JiraAuditChange{
+		ConnectionId: 1,
+		BoardId:      2,
+		ChangelogId:  30,
+		Field:        "status",
+		IssueId:      10001,
+		AccountId:    "bot",
+		Created:      created,
+		FromString:   "Done",
+		ToString:     "To Do",
+	},
+		didgen.NewDomainIdGenerator(&models.JiraIssue{}),
+		didgen.NewDomainIdGenerator(&models.JiraIssueChangelogItems{}),
+		didgen.NewDomainIdGenerator(&models.JiraAccount{}),
+	)
+	assert.Equal(t, "jira:JiraIssueChangelogItems:1:30:status", changelog.Id)
+	assert.Equal(t, "jira:JiraIssue:1:10001", changelog.IssueId)
+	assert.Equal(t, "jira:JiraAccount:1:bot", changelog.AuthorId)
+	assert.Equal(t, "status", changelog.FieldName)
+	assert.Equal(t, "Done", changelog.OriginalFromValue)
+	assert.Equal(t, "To Do", changelog.OriginalToValue)
+	assert.Equal(t, created, changelog.CreatedDate)
+}
@@ -0,0 +1,261 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ConvertBlockingChains
+
+var ConvertBlockingChainsMeta = plugin.SubTaskMeta{
+	Name:             "convertBlockingChains",
+	EntryPoint:       ConvertBlockingChains,
+	EnabledByDefault: true,
+	Description:      "compute the chains of open issues blocking Jira issues out of their links",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+type blockingLink struct {
+	IssueId        uint64
+	Direction      string
+	RelatedIssueId uint64
+}
+
+// blockingGraph maps the issues to their open blockers. Issues blocking each other, directly or through others,
+// form a loop which counts as a single step of the chains going through it
+type blockingGraph struct {
+	blockers map[uint64][]uint64
+	statuses map[uint64]string
+	// components maps the issues to the strongly connected component they belong to, loops being the components
+	// of more than one issue or of an issue blocking itself, which computeDepths tells apart
+	components map[uint64]int
+	members    [][]uint64
+	isLoop     []bool
+	depths     []int
+}
+
+// ConvertBlockingChains writes the depth of the longest chain of open blockers onto the issues of the board. Links
+// and statuses are loaded across the whole connection, like ConvertIssueHierarchy, and blockers that weren't
+// collected count as open. Issues excluded by their security level are left out. Loops are logged
+func ConvertBlockingChains(taskCtx plugin.SubTaskContext) errors.Error {
+	db := taskCtx.GetDal()
+	logger := taskCtx.GetLogger()
+	data := taskCtx.GetData().(*JiraTaskData)
+	connectionId := data.Options.ConnectionId
+	linkTypes := models.DefaultBlockingLinkTypes
+	if data.Options.ScopeConfig != nil && len(data.Options.ScopeConfig.BlockingLinkTypes) > 0 {
+		linkTypes = data.Options.ScopeConfig.BlockingLinkTypes
+	}
+
+	var links []*blockingLink
+	err := db.All(&links,
+		dal.Select("issue_id, direction, related_issue_id"),
+		dal.From(&models.JiraIssueRelationship{}),
+		dal.Where("connection_id = ? AND link_type_name IN ?", connectionId, linkTypes),
+		securityLevelFilter("connection_id", "issue_id"),
+		securityLevelFilter("connection_id", "related_issue_id"),
+	)
+	if err != nil {
+		return err
+	}
+	var issues []*models.JiraIssue
+	err = db.All(&issues,
+		dal.Select("issue_id, std_status"),
+		dal.From(&models.JiraIssue{}),
+		dal.Where("connection_id = ? AND security_excluded = ?", connectionId, false),
+	)
+	if err != nil {
+		return err
+	}
+	graph := newBlockingGraph(links, issues)
+
+	var boardIssueIds []uint64
+	err = db.Pluck("ji.issue_id", &boardIssueIds,
+		dal.From("_tool_jira_issues ji"),
+		dal.Join(`JOIN _tool_jira_board_issues bi ON (bi.connection_id = ji.connection_id AND bi.issue_id = ji.issue_id)`),
+		dal.Where("ji.connection_id = ? AND bi.board_id = ? AND ji.security_excluded = ?", connectionId, data.Options.BoardId, false),
+	)
+	if err != nil {
+		return err
+	}
+	issueIdGen := didgen.NewDomainIdGenerator(&models.JiraIssue{})
+	updater := api.NewBatchUpdater(db, &ticket.Issue{}, "id")
+	for _, issueId := range boardIssueIds {
+		depth := graph.depth(issueId)
+		err = updater.Add(issueIdGen.Generate(connectionId, issueId),
+			dal.DalSet{ColumnName: "blocking_chain_depth", Value: depth},
+			dal.DalSet{ColumnName: "blocked_by_open_issue", Value: depth > 0},
+		)
+		if err != nil {
+			return err
+		}
+	}
+	err = updater.Flush()
+	if err != nil {
+		return err
+	}
+	for _, loop := range graph.getLoops() {
+		logger.Warn(nil, fmt.Sprintf("blocking links loop through issues %v, the loop counts as a single step", loop))
+	}
+	return nil
+}
+
+// newBlockingGraph maps issues to the open issues blocking them, a link being listed on both of its ends, then
+// groups the loops and computes the depths
+func newBlockingGraph(links []*blockingLink, issues []*models.JiraIssue) *blockingGraph {
+	graph := &blockingGraph{
+		blockers:   make(map[uint64][]uint64),
+		statuses:   make(map[uint64]string, len(issues)),
+		components: make(map[uint64]int),
+	}
+	for _, issue := range issues {
+		graph.statuses[issue.IssueId] = issue.StdStatus
+	}
+	seen := make(map[[2]uint64]bool)
+	for _, link := range links {
+		blocker, blocked := link.IssueId, link.RelatedIssueId
+		if link.Direction == models.IssueLinkInward {
+			blocker, blocked = blocked, blocker
+		}
+		if seen[[2]uint64{blocker, blocked}] {
+			continue
+		}
+		seen[[2]uint64{blocker, blocked}] = true
+		if status, ok := graph.statuses[blocker]; ok && status == ticket.DONE {
+			continue
+		}
+		graph.blockers[blocked] = append(graph.blockers[blocked], blocker)
+	}
+	graph.groupLoops()
+	graph.computeDepths()
+	return graph
+}
+
+// groupLoops finds the strongly connected components with Tarjan's algorithm, which lists each component after
+// all the components it reaches, that is after its blockers
+func (g *blockingGraph) groupLoops() {
+	issueIds := make([]uint64, 0, len(g.blockers))
+	for issueId := range g.blockers {
+		issueIds = append(issueIds, issueId)
+	}
+	sort.Slice(issueIds, func(i, j int) bool { return issueIds[i] < issueIds[j] })
+
+	index := 0
+	indexes := make(map[uint64]int)
+	lowLinks := make(map[uint64]int)
+	onStack := make(map[uint64]bool)
+	var stack []uint64
+	var connect func(issueId uint64)
+	connect = func(issueId uint64) {
+		indexes[issueId] = index
+		lowLinks[issueId] = index
+		index++
+		stack = append(stack, issueId)
+		onStack[issueId] = true
+		for _, blocker := range g.blockers[issueId] {
+			if _, visited := indexes[blocker]; !visited {
+				connect(blocker)
+				if lowLinks[blocker] < lowLinks[issueId] {
+					lowLinks[issueId] = lowLinks[blocker]
+				}
+			} else if onStack[blocker] && indexes[blocker] < lowLinks[issueId] {
+				lowLinks[issueId] = indexes[blocker]
+			}
+		}
+		if lowLinks[issueId] != indexes[issueId] {
+			return
+		}
+		component := len(g.members)
+		var members []uint64
+		for {
+			member := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[member] = false
+			g.components[member] = component
+			members = append(members, member)
+			if member == issueId {
+				break
+			}
+		}
+		sort.Slice(members, func(i, j int) bool { return members[i] < members[j] })
+		g.members = append(g.members, members)
+		g.isLoop = append(g.isLoop, false)
+	}
+	for _, issueId := range issueIds {
+		if _, visited := indexes[issueId]; !visited {
+			connect(issueId)
+		}
+	}
+}
+
+// computeDepths takes the longest path over the components, the blockers of a component being listed before it
+func (g *blockingGraph) computeDepths() {
+	g.depths = make([]int, len(g.members))
+	for component, members := range g.members {
+		depth := 0
+		for _, member := range members {
+			for _, blocker := range g.blockers[member] {
+				// every blocker was given a component while walking its blocked issue
+				blockerComponent := g.components[blocker]
+				if blockerComponent == component {
+					// the loop itself, or an issue blocking itself
+					g.isLoop[component] = true
+					if depth < 1 {
+						depth = 1
+					}
+					continue
+				}
+				if g.depths[blockerComponent]+1 > depth {
+					depth = g.depths[blockerComponent] + 1
+				}
+			}
+		}
+		g.depths[component] = depth
+	}
+}
+
+// depth returns the length of the longest chain of open issues blocking the issue
+func (g *blockingGraph) depth(issueId uint64) int {
+	component, ok := g.components[issueId]
+	if !ok {
+		return 0
+	}
+	return g.depths[component]
+}
+
+// getLoops returns the issues of each loop
+func (g *blockingGraph) getLoops() [][]uint64 {
+	var loops [][]uint64
+	for component, members := range g.members {
+		if g.isLoop[component] {
+			loops = append(loops, members)
+		}
+	}
+	sort.Slice(loops, func(i, j int) bool { return loops[i][0] < loops[j][0] })
+	return loops
+}
@@ -0,0 +1,109 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockingGraph(t *testing.T) {
+	issues := []*models.JiraIssue{
+		{IssueId: 1, StdStatus: ticket.TODO},
+		{IssueId: 2, StdStatus: ticket.IN_PROGRESS},
+		{IssueId: 3, StdStatus: ticket.TODO},
+		{IssueId: 4, StdStatus: ticket.DONE},
+		{IssueId: 5, StdStatus: ticket.TODO},
+		{IssueId: 6, StdStatus: ticket.TODO},
+		{IssueId: 7, StdStatus: ticket.TODO},
+	}
+	links := []*blockingLink{
+		// 1 blocks 2 which blocks 3, listed on both ends
+		{IssueId: 1, Direction: models.IssueLinkOutward, RelatedIssueId: 2},
+		{IssueId: 2, Direction: models.IssueLinkInward, RelatedIssueId: 1},
+		{IssueId: 3, Direction: models.IssueLinkInward, RelatedIssueId: 2},
+		// 4 is done, it no longer blocks 5
+		{IssueId: 4, Direction: models.IssueLinkOutward, RelatedIssueId: 5},
+		// 6 and 7 block each other
+		{IssueId: 6, Direction: models.IssueLinkOutward, RelatedIssueId: 7},
+		{IssueId: 7, Direction: models.IssueLinkOutward, RelatedIssueId: 6},
+		// 99 wasn't collected, it counts as open
+		{IssueId: 5, Direction: models.IssueLinkInward, RelatedIssueId: 99},
+	}
+	graph := newBlockingGraph(links, issues)
+	assert.Equal(t, 0, graph.depth(1))
+	assert.Equal(t, 1, graph.depth(2))
+	assert.Equal(t, 2, graph.depth(3))
+	assert.Equal(t, 1, graph.depth(5))
+	// a loop counts as a single step, whichever issue of it is asked about
+	assert.Equal(t, 1, graph.depth(6))
+	assert.Equal(t, 1, graph.depth(7))
+	assert.Equal(t, [][]uint64{{6, 7}}, graph.getLoops())
+}
+
+func TestBlockingGraphChainsThroughLoops(t *testing.T) {
+	links := []*blockingLink{
+		// 1 blocks the loop of 2, 3 and 4, which blocks 5
+		{IssueId: 1, Direction: models.IssueLinkOutward, RelatedIssueId: 2},
+		{IssueId: 2, Direction: models.IssueLinkOutward, RelatedIssueId: 3},
+		{IssueId: 3, Direction: models.IssueLinkOutward, RelatedIssueId: 4},
+		{IssueId: 4, Direction: models.IssueLinkOutward, RelatedIssueId: 2},
+		{IssueId: 4, Direction: models.IssueLinkOutward, RelatedIssueId: 5},
+		// 6 blocks itself
+		{IssueId: 6, Direction: models.IssueLinkOutward, RelatedIssueId: 6},
+	}
+	graph := newBlockingGraph(links, nil)
+	assert.Equal(t, 0, graph.depth(1))
+	assert.Equal(t, 1, graph.depth(2))
+	assert.Equal(t, 1, graph.depth(3))
+	assert.Equal(t, 1, graph.depth(4))
+	assert.Equal(t, 2, graph.depth(5))
+	assert.Equal(t, 1, graph.depth(6))
+	assert.Equal(t, 0, graph.depth(100))
+	assert.Equal(t, [][]uint64{{2, 3, 4}, {6}}, graph.getLoops())
+}
+
+func TestBlockingGraphDenseLoop(t *testing.T) {
+	// every issue blocks every other one, walking each chain would never end
+	const size = 200
+	var links []*blockingLink
+	for i := uint64(1); i <= size; i++ {
+		for j := uint64(1); j <= size; j++ {
+			if i != j {
+				links = append(links, &blockingLink{IssueId: i, Direction: models.IssueLinkOutward, RelatedIssueId: j})
+			}
+		}
+	}
+	// the loop blocks a chain of two issues
+	links = append(links,
+		&blockingLink{IssueId: size, Direction: models.IssueLinkOutward, RelatedIssueId: size + 1},
+		&blockingLink{IssueId: size + 1, Direction: models.IssueLinkOutward, RelatedIssueId: size + 2},
+	)
+	graph := newBlockingGraph(links, nil)
+	for i := uint64(1); i <= size; i++ {
+		assert.Equal(t, 1, graph.depth(i))
+	}
+	assert.Equal(t, 2, graph.depth(size+1))
+	assert.Equal(t, 3, graph.depth(size+2))
+	loops := graph.getLoops()
+	assert.Len(t, loops, 1)
+	assert.Len(t, loops[0], size)
+}
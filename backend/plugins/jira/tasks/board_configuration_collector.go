@@ -0,0 +1,73 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+)
+
+const RAW_BOARD_CONFIGURATION_TABLE = "jira_api_board_configurations"
+
+var _ plugin.SubTaskEntryPoint = CollectBoardConfiguration
+
+var CollectBoardConfigurationMeta = plugin.SubTaskMeta{
+	Name:             "collectBoardConfiguration",
+	EntryPoint:       CollectBoardConfiguration,
+	EnabledByDefault: true,
+	RunsWhenStaged:   true,
+	Description:      "collect Jira board configuration, does not support either timeFilter or diffSync.",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+func CollectBoardConfiguration(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*JiraTaskData)
+	logger := taskCtx.GetLogger()
+	logger.Info("collect board configuration")
+	collector, err := api.NewApiCollector(api.ApiCollectorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: JiraApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				BoardId:      data.Options.BoardId,
+			},
+			Table: RAW_BOARD_CONFIGURATION_TABLE,
+		},
+		ApiClient:   data.ApiClient,
+		UrlTemplate: "agile/1.0/board/{{ .Params.BoardId }}/configuration",
+		ResponseParser: func(res *http.Response) ([]json.RawMessage, errors.Error) {
+			var result json.RawMessage
+			err := api.UnmarshalResponse(res, &result)
+			if err != nil {
+				return nil, err
+			}
+			return []json.RawMessage{result}, nil
+		},
+		AfterResponse: ignoreHTTPStatus404,
+	})
+	if err != nil {
+		logger.Error(err, "collect board configuration error")
+		return err
+	}
+
+	return collector.Execute()
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
 	"github.com/apache/incubator-devlake/plugins/jira/models"
 	"reflect"
+	"strconv"
 )
 
 const RAW_BOARD_TABLE = "jira_api_boards"
@@ -45,6 +46,7 @@ func ConvertBoard(taskCtx plugin.SubTaskContext) errors.Error {
 	db := taskCtx.GetDal()
 	logger.Info("collect board:%d", data.Options.BoardId)
 	idGen := didgen.NewDomainIdGenerator(&models.JiraBoard{})
+	projectIdGen := didgen.NewDomainIdGenerator(&models.JiraProject{})
 	clauses := []dal.Clause{
 		dal.Select("*"),
 		dal.From(&models.JiraBoard{}),
@@ -74,6 +76,9 @@ func ConvertBoard(taskCtx plugin.SubTaskContext) errors.Error {
 				Url:          board.Self,
 				Type:         board.Type,
 			}
+			if board.ProjectId != 0 {
+				domainBoard.ProjectId = projectIdGen.Generate(data.Options.ConnectionId, strconv.FormatUint(uint64(board.ProjectId), 10))
+			}
 			return []interface{}{
 				domainBoard,
 			}, nil
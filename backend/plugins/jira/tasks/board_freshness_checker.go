@@ -0,0 +1,131 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+var _ plugin.SubTaskEntryPoint = CheckBoardFreshness
+
+var CheckBoardFreshnessMeta = plugin.SubTaskMeta{
+	Name:             "checkBoardFreshness",
+	EntryPoint:       CheckBoardFreshness,
+	EnabledByDefault: true,
+	Description:      "alert the freshness webhook of the connection about the boards whose data is older than their SLA",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+// CheckBoardFreshness checks every board of the connection rather than only the current one, so that the boards
+// whose collection keeps failing or being skipped get reported by the runs of the others. The first run finding a
+// breach claims it, so each breach is alerted once. A failing alert is logged rather than failing the collection,
+// and released so that the next run tries again
+func CheckBoardFreshness(taskCtx plugin.SubTaskContext) errors.Error {
+	db := taskCtx.GetDal()
+	logger := taskCtx.GetLogger()
+	data := taskCtx.GetData().(*JiraTaskData)
+	connectionId := data.Options.ConnectionId
+
+	var boards []*models.JiraBoard
+	err := db.All(&boards, dal.Where("connection_id = ?", connectionId))
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	var breaches []*api.ScopeFreshnessBreach
+	var claims []*models.JiraBoardFreshnessAlert
+	for _, board := range boards {
+		slaMinutes := data.FreshnessSlaMinutes
+		if board.FreshnessSlaMinutes > 0 {
+			slaMinutes = board.FreshnessSlaMinutes
+		}
+		if slaMinutes <= 0 {
+			continue
+		}
+		lastRun, err := api.GetScopeLastRun(db, RAW_ISSUE_TABLE, board.ScopeParams())
+		if err != nil {
+			return err
+		}
+		lagMinutes, breached := api.CheckScopeFreshness(now, lastRun, time.Duration(slaMinutes)*time.Minute)
+		if !breached {
+			continue
+		}
+		claim := toBoardFreshnessAlert(board, lastRun)
+		err = db.Create(claim)
+		if db.IsDuplicationError(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		// the earlier breaches of the board ended with a later collection
+		err = db.Delete(&models.JiraBoardFreshnessAlert{},
+			dal.Where("connection_id = ? AND board_id = ? AND last_run <> ?", connectionId, board.BoardId, claim.LastRun))
+		if err != nil {
+			return err
+		}
+		logger.Warn(nil, fmt.Sprintf("the data of board %d (%s) breaches its freshness SLA of %d minutes", board.BoardId, board.Name, slaMinutes))
+		claims = append(claims, claim)
+		breaches = append(breaches, &api.ScopeFreshnessBreach{
+			ScopeId:    board.ScopeId(),
+			ScopeName:  board.ScopeName(),
+			LastRun:    lastRun,
+			LagMinutes: lagMinutes,
+			SlaMinutes: slaMinutes,
+		})
+	}
+	if len(breaches) == 0 || data.FreshnessWebhookUrl == "" {
+		return nil
+	}
+	err = api.PostFreshnessAlert(taskCtx.GetContext(), data.FreshnessWebhookUrl, &api.FreshnessAlert{
+		Plugin:       "jira",
+		ConnectionId: connectionId,
+		CheckedAt:    now,
+		Breaches:     breaches,
+	})
+	if err == nil {
+		return nil
+	}
+	logger.Error(err, "failed to post the freshness alert of connection %d", connectionId)
+	for _, claim := range claims {
+		err = db.Delete(claim)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// toBoardFreshnessAlert identifies the breach of a board by the collection it is stale since
+func toBoardFreshnessAlert(board *models.JiraBoard, lastRun *time.Time) *models.JiraBoardFreshnessAlert {
+	alert := &models.JiraBoardFreshnessAlert{
+		ConnectionId: board.ConnectionId,
+		BoardId:      board.BoardId,
+	}
+	if lastRun != nil {
+		alert.LastRun = lastRun.UTC().Format(time.RFC3339)
+	}
+	return alert
+}
@@ -0,0 +1,41 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToBoardFreshnessAlert(t *testing.T) {
+	board := &models.JiraBoard{ConnectionId: 1, BoardId: 8}
+	lastRun := time.Date(2023, 9, 1, 12, 0, 0, 0, time.FixedZone("CST", 8*3600))
+
+	alert := toBoardFreshnessAlert(board, &lastRun)
+	assert.Equal(t, &models.JiraBoardFreshnessAlert{ConnectionId: 1, BoardId: 8, LastRun: "2023-09-01T04:00:00Z"}, alert)
+
+	// the same breach found by another run claims the same alert
+	sameRun := lastRun.UTC()
+	assert.Equal(t, alert, toBoardFreshnessAlert(board, &sameRun))
+
+	// a board never collected
+	assert.Equal(t, &models.JiraBoardFreshnessAlert{ConnectionId: 1, BoardId: 8}, toBoardFreshnessAlert(board, nil))
+}
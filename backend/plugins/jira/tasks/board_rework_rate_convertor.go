@@ -0,0 +1,77 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+var ConvertBoardReworkRatesMeta = plugin.SubTaskMeta{
+	Name:             "convertBoardReworkRates",
+	EntryPoint:       ConvertBoardReworkRates,
+	EnabledByDefault: true,
+	Description:      "materialize the rework of issues per week and per month into _tool_jira_board_rework_rates",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+// ConvertBoardReworkRates sums up the transitions of the issues of the board between the configured stages by the
+// week and the month they happened in, like ConvertBoardThroughput. Periods left empty are removed.
+func ConvertBoardReworkRates(taskCtx plugin.SubTaskContext) errors.Error {
+	db := taskCtx.GetDal()
+	logger := taskCtx.GetLogger()
+	data := taskCtx.GetData().(*JiraTaskData)
+	connectionId := data.Options.ConnectionId
+	boardId := data.Options.BoardId
+
+	staged, truncatedIssues, err := loadStagedTransitions(db, data)
+	if err != nil {
+		return err
+	}
+	rates := getReworkRates(staged, truncatedIssues)
+
+	var existing []*models.JiraBoardReworkRate
+	err = db.All(&existing, dal.Where("connection_id = ? AND board_id = ?", connectionId, boardId))
+	if err != nil {
+		return err
+	}
+	for _, row := range existing {
+		if _, ok := rates[throughputKey{row.PeriodType, row.PeriodStart.UTC()}]; ok {
+			continue
+		}
+		err = db.Delete(&models.JiraBoardReworkRate{}, dal.Where(
+			"connection_id = ? AND board_id = ? AND period_type = ? AND period_start = ?",
+			connectionId, boardId, row.PeriodType, row.PeriodStart,
+		))
+		if err != nil {
+			return err
+		}
+	}
+	for _, rate := range rates {
+		rate.ConnectionId = connectionId
+		rate.BoardId = boardId
+		err = db.CreateOrUpdate(rate)
+		if err != nil {
+			return err
+		}
+	}
+	logger.Info("board %d rework rates updated for %d periods", boardId, len(rates))
+	return nil
+}
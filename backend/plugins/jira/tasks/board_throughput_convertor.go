@@ -0,0 +1,163 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"sort"
+	"time"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+
+	"golang.org/x/exp/slices"
+)
+
+var ConvertBoardThroughputMeta = plugin.SubTaskMeta{
+	Name:             "convertBoardThroughput",
+	EntryPoint:       ConvertBoardThroughput,
+	EnabledByDefault: true,
+	Description:      "materialize the number of issues completed per week and per month into _tool_jira_board_throughputs, only the changed periods are written",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+type throughputKey struct {
+	PeriodType  string
+	PeriodStart time.Time
+}
+
+// ConvertBoardThroughput counts the done issues of the board by the week and the month they were completed in,
+// the completion date following the done date strategy, the resolution date by default.
+// The counts are recomputed out of all the done issues of the board on every run, since issues may leave the done
+// status or be completed again at another date, while the table is updated incrementally: only the periods whose
+// count changed are written, and periods left empty are removed.
+func ConvertBoardThroughput(taskCtx plugin.SubTaskContext) errors.Error {
+	db := taskCtx.GetDal()
+	logger := taskCtx.GetLogger()
+	data := taskCtx.GetData().(*JiraTaskData)
+	connectionId := data.Options.ConnectionId
+	boardId := data.Options.BoardId
+	var cancelledResolutions []string
+	if data.Options.ScopeConfig != nil {
+		cancelledResolutions = data.Options.ScopeConfig.CancelledResolutions
+	}
+	// throughput dates issues as ConvertIssues does
+	doneDateStrategy := getDoneDateStrategy(data.Options.ScopeConfig)
+	var transitions map[uint64]*doneTransition
+	var err errors.Error
+	if doneDateStrategy == models.DoneDateStrategyFirstDone || doneDateStrategy == models.DoneDateStrategyLastDone {
+		transitions, err = loadDoneTransitions(db, data)
+		if err != nil {
+			return err
+		}
+	}
+	var issues []*models.JiraIssue
+	err = db.All(&issues,
+		dal.Select("i.issue_id, i.resolution_date, i.resolution_name"),
+		dal.From("_tool_jira_issues i"),
+		dal.Join(`JOIN _tool_jira_board_issues bi ON (bi.connection_id = i.connection_id AND bi.issue_id = i.issue_id)`),
+		dal.Where("i.connection_id = ? AND bi.board_id = ? AND i.std_status = ? AND i.security_excluded = ?", connectionId, boardId, ticket.DONE, false),
+	)
+	if err != nil {
+		return err
+	}
+
+	counts := make(map[throughputKey]int)
+	for _, issue := range issues {
+		if slices.Contains(cancelledResolutions, issue.ResolutionName) {
+			continue
+		}
+		completed := getDoneDate(doneDateStrategy, issue.ResolutionDate, transitions[issue.IssueId])
+		if completed == nil {
+			continue
+		}
+		for _, periodType := range []string{models.ThroughputPeriodWeek, models.ThroughputPeriodMonth} {
+			counts[throughputKey{periodType, getThroughputPeriodStart(*completed, periodType)}]++
+		}
+	}
+
+	var existing []*models.JiraBoardThroughput
+	err = db.All(&existing, dal.Where("connection_id = ? AND board_id = ?", connectionId, boardId))
+	if err != nil {
+		return err
+	}
+	changed, emptied := diffThroughput(existing, counts)
+	for _, row := range emptied {
+		err = db.Delete(&models.JiraBoardThroughput{}, dal.Where(
+			"connection_id = ? AND board_id = ? AND period_type = ? AND period_start = ?",
+			connectionId, boardId, row.PeriodType, row.PeriodStart,
+		))
+		if err != nil {
+			return err
+		}
+	}
+	for _, key := range changed {
+		err = db.CreateOrUpdate(&models.JiraBoardThroughput{
+			ConnectionId: connectionId,
+			BoardId:      boardId,
+			PeriodType:   key.PeriodType,
+			PeriodStart:  key.PeriodStart,
+			IssueCount:   counts[key],
+		})
+		if err != nil {
+			return err
+		}
+	}
+	logger.Info("board %d throughput updated for %d of %d periods, %d emptied", boardId, len(changed), len(counts), len(emptied))
+	return nil
+}
+
+// getThroughputPeriodStart returns the UTC start of the week (Monday) or the month containing t
+func getThroughputPeriodStart(t time.Time, periodType string) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	if periodType == models.ThroughputPeriodMonth {
+		return day.AddDate(0, 0, 1-day.Day())
+	}
+	return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+}
+
+// diffThroughput compares the stored periods of the board with the fresh counts, returning the periods whose count
+// is new or changed, in order, and the stored rows of the periods without any completed issue left
+func diffThroughput(existing []*models.JiraBoardThroughput, counts map[throughputKey]int) ([]throughputKey, []*models.JiraBoardThroughput) {
+	stored := make(map[throughputKey]int, len(existing))
+	var emptied []*models.JiraBoardThroughput
+	for _, row := range existing {
+		key := throughputKey{row.PeriodType, row.PeriodStart.UTC()}
+		if _, ok := counts[key]; !ok {
+			emptied = append(emptied, row)
+			continue
+		}
+		stored[key] = row.IssueCount
+	}
+	var changed []throughputKey
+	for key, count := range counts {
+		if storedCount, ok := stored[key]; !ok || storedCount != count {
+			changed = append(changed, key)
+		}
+	}
+	sort.Slice(changed, func(i, j int) bool {
+		if changed[i].PeriodType != changed[j].PeriodType {
+			return changed[i].PeriodType < changed[j].PeriodType
+		}
+		return changed[i].PeriodStart.Before(changed[j].PeriodStart)
+	})
+	return changed, emptied
+}
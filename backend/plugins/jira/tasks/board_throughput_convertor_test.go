@@ -0,0 +1,68 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetThroughputPeriodStart(t *testing.T) {
+	// Sunday evening in UTC-5 is already Monday in UTC
+	completed := time.Date(2023, 7, 16, 22, 30, 0, 0, time.FixedZone("EST", -5*3600))
+	assert.Equal(t, time.Date(2023, 7, 17, 0, 0, 0, 0, time.UTC), getThroughputPeriodStart(completed, models.ThroughputPeriodWeek))
+	assert.Equal(t, time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC), getThroughputPeriodStart(completed, models.ThroughputPeriodMonth))
+
+	sunday := time.Date(2023, 7, 16, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2023, 7, 10, 0, 0, 0, 0, time.UTC), getThroughputPeriodStart(sunday, models.ThroughputPeriodWeek))
+	monday := time.Date(2023, 7, 10, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, monday, getThroughputPeriodStart(monday, models.ThroughputPeriodWeek))
+}
+
+func TestDiffThroughput(t *testing.T) {
+	july := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+	august := time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)
+	week := time.Date(2023, 7, 10, 0, 0, 0, 0, time.UTC)
+	existing := []*models.JiraBoardThroughput{
+		{PeriodType: models.ThroughputPeriodMonth, PeriodStart: july, IssueCount: 3},
+		{PeriodType: models.ThroughputPeriodMonth, PeriodStart: august, IssueCount: 1},
+		{PeriodType: models.ThroughputPeriodWeek, PeriodStart: week, IssueCount: 2},
+	}
+	counts := map[throughputKey]int{
+		// unchanged
+		{models.ThroughputPeriodMonth, july}: 3,
+		// changed
+		{models.ThroughputPeriodWeek, week}: 1,
+		// new
+		{models.ThroughputPeriodWeek, week.AddDate(0, 0, 7)}: 2,
+	}
+	changed, emptied := diffThroughput(existing, counts)
+	assert.Equal(t, []throughputKey{
+		{models.ThroughputPeriodWeek, week},
+		{models.ThroughputPeriodWeek, week.AddDate(0, 0, 7)},
+	}, changed)
+	assert.Equal(t, []*models.JiraBoardThroughput{existing[1]}, emptied)
+
+	// nothing to write once up to date
+	changed, emptied = diffThroughput([]*models.JiraBoardThroughput{existing[0]}, map[throughputKey]int{{models.ThroughputPeriodMonth, july}: 3})
+	assert.Empty(t, changed)
+	assert.Empty(t, emptied)
+}
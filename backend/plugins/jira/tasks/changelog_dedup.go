@@ -0,0 +1,82 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+type changelogItemKey struct {
+	IssueId    uint64
+	Created    time.Time
+	Field      string
+	FromValue  string
+	FromString string
+	ToValue    string
+	ToString   string
+}
+
+// changelogDeduplicator collapses the changelog items repeated by different changelogs of an issue within the same
+// second, automations are known to record a single transition twice. Every item is observed first, then the
+// earliest changelog, by id, keeps it whatever order the changelogs are extracted in.
+type changelogDeduplicator struct {
+	seen      map[changelogItemKey]uint64
+	collapsed int64
+}
+
+func newChangelogDeduplicator() *changelogDeduplicator {
+	return &changelogDeduplicator{seen: make(map[changelogItemKey]uint64)}
+}
+
+func toChangelogItemKey(issueId uint64, created time.Time, item *models.JiraIssueChangelogItems) changelogItemKey {
+	return changelogItemKey{
+		IssueId:    issueId,
+		Created:    created.UTC().Truncate(time.Second),
+		Field:      item.Field,
+		FromValue:  item.FromValue,
+		FromString: item.FromString,
+		ToValue:    item.ToValue,
+		ToString:   item.ToString,
+	}
+}
+
+// observe records the item, it must not be called concurrently
+func (d *changelogDeduplicator) observe(issueId uint64, created time.Time, item *models.JiraIssueChangelogItems) {
+	key := toChangelogItemKey(issueId, created, item)
+	if changelogId, ok := d.seen[key]; !ok || item.ChangelogId < changelogId {
+		d.seen[key] = item.ChangelogId
+	}
+}
+
+// keep reports whether the item belongs to the earliest changelog recording it, it is safe to call concurrently
+// once every item got observed
+func (d *changelogDeduplicator) keep(issueId uint64, created time.Time, item *models.JiraIssueChangelogItems) bool {
+	if changelogId, ok := d.seen[toChangelogItemKey(issueId, created, item)]; ok && changelogId != item.ChangelogId {
+		atomic.AddInt64(&d.collapsed, 1)
+		return false
+	}
+	return true
+}
+
+// collapsedCount returns the number of items collapsed so far
+func (d *changelogDeduplicator) collapsedCount() int64 {
+	return atomic.LoadInt64(&d.collapsed)
+}
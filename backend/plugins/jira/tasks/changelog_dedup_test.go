@@ -0,0 +1,62 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangelogDeduplicator(t *testing.T) {
+	created := time.Date(2023, 7, 31, 10, 0, 0, 100_000_000, time.UTC)
+	status := func(changelogId uint64, from, to string) *models.JiraIssueChangelogItems {
+		return &models.JiraIssueChangelogItems{ChangelogId: changelogId, Field: "status", FromValue: from, ToValue: to}
+	}
+	d := newChangelogDeduplicator()
+	items := []struct {
+		issueId uint64
+		created time.Time
+		item    *models.JiraIssueChangelogItems
+	}{
+		// repeated by an earlier changelog within the same second, observed later
+		{1, created.Add(500 * time.Millisecond), status(11, "1", "3")},
+		{1, created, status(10, "1", "3")},
+		// the same changelog may be extracted twice, e.g. from the issue and from the changelog api
+		{1, created, status(10, "1", "3")},
+		// a different transition, a different issue or a later second
+		{1, created, status(11, "3", "5")},
+		{2, created, status(12, "1", "3")},
+		{1, created.Add(time.Second), status(13, "1", "3")},
+	}
+	for _, i := range items {
+		d.observe(i.issueId, i.created, i.item)
+	}
+	var kept []uint64
+	for _, i := range items {
+		if d.keep(i.issueId, i.created, i.item) {
+			kept = append(kept, i.item.ChangelogId)
+		}
+	}
+	assert.Equal(t, []uint64{10, 10, 11, 12, 13}, kept)
+	assert.Equal(t, int64(1), d.collapsedCount())
+	// items left unobserved are kept
+	assert.True(t, d.keep(3, created, status(14, "1", "3")))
+}
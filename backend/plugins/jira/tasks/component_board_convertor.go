@@ -0,0 +1,206 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"reflect"
+	"strconv"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/common"
+	"github.com/apache/incubator-devlake/core/models/domainlayer"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/crossdomain"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+// COMPONENT_BOARD_TABLE tags the domain rows of component boards, they are derived from the issues
+const COMPONENT_BOARD_TABLE = "jira_component_boards"
+
+const defaultComponentBoard = "No Component"
+
+var _ plugin.SubTaskEntryPoint = ConvertComponentBoards
+
+var ConvertComponentBoardsMeta = plugin.SubTaskMeta{
+	Name:             "convertComponentBoards",
+	EntryPoint:       ConvertComponentBoards,
+	EnabledByDefault: true,
+	Description:      "promote the components of Jira issues to domain boards when enabled by the scope config",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+func ConvertComponentBoards(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*JiraTaskData)
+	scopeConfig := data.Options.ScopeConfig
+	if scopeConfig == nil || !scopeConfig.ComponentBoards {
+		return nil
+	}
+	db := taskCtx.GetDal()
+	connectionId := data.Options.ConnectionId
+	boardId := data.Options.BoardId
+
+	var projectId string
+	board := &models.JiraBoard{}
+	err := db.First(board, dal.Where("connection_id = ? AND board_id = ?", connectionId, boardId))
+	if err != nil && !db.IsErrorNotFound(err) {
+		return err
+	}
+	board.BoardId = boardId
+	if board.ProjectId != 0 {
+		projectId = didgen.NewDomainIdGenerator(&models.JiraProject{}).Generate(connectionId, strconv.FormatUint(uint64(board.ProjectId), 10))
+	}
+
+	cursor, err := db.Cursor(
+		dal.Select("_tool_jira_issues.issue_id, _tool_jira_issues.components"),
+		dal.From(&models.JiraIssue{}),
+		dal.Join(`left join _tool_jira_board_issues
+			on _tool_jira_board_issues.issue_id = _tool_jira_issues.issue_id
+			and _tool_jira_board_issues.connection_id = _tool_jira_issues.connection_id`),
+		dal.Where(
+			"_tool_jira_board_issues.connection_id = ? AND _tool_jira_board_issues.board_id = ? AND _tool_jira_issues.security_excluded = ?",
+			connectionId, boardId, false,
+		),
+	)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+
+	converter := newComponentBoardConverter(connectionId, board, projectId, scopeConfig)
+	dataConverter, err := api.NewDataConverter(api.DataConverterArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: JiraApiParams{
+				ConnectionId: connectionId,
+				BoardId:      boardId,
+			},
+			Table: COMPONENT_BOARD_TABLE,
+		},
+		InputRowType: reflect.TypeOf(models.JiraIssue{}),
+		Input:        cursor,
+		Convert: func(inputRow interface{}) ([]interface{}, errors.Error) {
+			return converter.convert(inputRow.(*models.JiraIssue)), nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+	err = dataConverter.Execute()
+	if err != nil {
+		return err
+	}
+
+	// component boards are no blueprint scopes, they belong to the projects of their board
+	var parents []*crossdomain.ProjectMapping
+	err = db.All(&parents, dal.Where("`table` = ? AND row_id = ?", "boards",
+		didgen.NewDomainIdGenerator(&models.JiraBoard{}).Generate(connectionId, boardId)))
+	if err != nil {
+		return err
+	}
+	mappings := toComponentProjectMappings(parents, converter.boardIds)
+	if len(mappings) == 0 {
+		return nil
+	}
+	return db.CreateOrUpdate(mappings)
+}
+
+// componentBoardConverter puts every issue of a board on the board of its primary component
+type componentBoardConverter struct {
+	connectionId        uint64
+	board               *models.JiraBoard
+	projectId           string
+	scopeConfig         *models.JiraScopeConfig
+	defaultBoard        string
+	issueIdGen          *didgen.DomainIdGenerator
+	componentBoardIdGen *didgen.DomainIdGenerator
+	// boardIds lists the ids of the component boards in the order they were met
+	boardIds []string
+	seen     map[string]bool
+}
+
+func newComponentBoardConverter(connectionId uint64, board *models.JiraBoard, projectId string, scopeConfig *models.JiraScopeConfig) *componentBoardConverter {
+	defaultBoard := scopeConfig.DefaultComponentBoard
+	if defaultBoard == "" {
+		defaultBoard = defaultComponentBoard
+	}
+	return &componentBoardConverter{
+		connectionId:        connectionId,
+		board:               board,
+		projectId:           projectId,
+		scopeConfig:         scopeConfig,
+		defaultBoard:        defaultBoard,
+		issueIdGen:          didgen.NewDomainIdGenerator(&models.JiraIssue{}),
+		componentBoardIdGen: didgen.NewDomainIdGenerator(&models.JiraComponentBoard{}),
+		seen:                make(map[string]bool),
+	}
+}
+
+func (c *componentBoardConverter) convert(issue *models.JiraIssue) []interface{} {
+	component := getPrimaryComponent(issue.Components, c.scopeConfig)
+	if component == "" {
+		component = c.defaultBoard
+	}
+	componentBoardId := c.componentBoardIdGen.Generate(c.connectionId, c.board.BoardId, component)
+	var results []interface{}
+	if !c.seen[component] {
+		c.seen[component] = true
+		c.boardIds = append(c.boardIds, componentBoardId)
+		results = append(results,
+			&models.JiraComponentBoard{
+				ConnectionId: c.connectionId,
+				BoardId:      c.board.BoardId,
+				Component:    component,
+			},
+			&ticket.Board{
+				DomainEntity: domainlayer.DomainEntity{Id: componentBoardId},
+				Name:         component,
+				Description:  "component of " + c.board.Name,
+				Type:         "component",
+				ProjectId:    c.projectId,
+			},
+		)
+	}
+	results = append(results, &ticket.BoardIssue{
+		BoardId: componentBoardId,
+		IssueId: c.issueIdGen.Generate(c.connectionId, issue.IssueId),
+	})
+	return results
+}
+
+// toComponentProjectMappings maps the component boards to every project their board is mapped to
+func toComponentProjectMappings(parents []*crossdomain.ProjectMapping, boardIds []string) []*crossdomain.ProjectMapping {
+	var mappings []*crossdomain.ProjectMapping
+	for _, parent := range parents {
+		for _, boardId := range boardIds {
+			mappings = append(mappings, &crossdomain.ProjectMapping{
+				ProjectName: parent.ProjectName,
+				Table:       parent.Table,
+				RowId:       boardId,
+				NoPKModel: common.NoPKModel{
+					// deleted along with the other mappings of the project
+					RawDataOrigin: common.RawDataOrigin{RawDataParams: parent.ProjectName},
+				},
+			})
+		}
+	}
+	return mappings
+}
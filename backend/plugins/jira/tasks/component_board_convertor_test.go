@@ -0,0 +1,88 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+
+	"github.com/apache/incubator-devlake/core/models/domainlayer/crossdomain"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/stretchr/testify/assert"
+)
+
+type jiraTestPlugin struct {
+	plugin.PluginMeta
+}
+
+func (jiraTestPlugin) Name() string        { return "jira" }
+func (jiraTestPlugin) Description() string { return "jira" }
+func (jiraTestPlugin) RootPkgPath() string {
+	return "github.com/apache/incubator-devlake/plugins/jira"
+}
+
+// registerJiraForTest lets the domain id generators find the plugin holding the tool entities
+func registerJiraForTest(t *testing.T) {
+	assert.Nil(t, plugin.RegisterPlugin("jira", jiraTestPlugin{}))
+}
+
+func TestComponentBoardConverter(t *testing.T) {
+	registerJiraForTest(t)
+	converter := newComponentBoardConverter(1, &models.JiraBoard{BoardId: 8, Name: "Team board"}, "jira:JiraProject:1:10",
+		&models.JiraScopeConfig{ComponentTieBreak: models.ComponentTieBreakAlphabetical})
+
+	results := converter.convert(&models.JiraIssue{IssueId: 100, Components: []string{"Frontend", "Backend"}})
+	if assert.Len(t, results, 3) {
+		assert.Equal(t, &models.JiraComponentBoard{ConnectionId: 1, BoardId: 8, Component: "Backend"}, results[0])
+		board := results[1].(*ticket.Board)
+		assert.Equal(t, "jira:JiraComponentBoard:1:8:Backend", board.Id)
+		assert.Equal(t, "Backend", board.Name)
+		assert.Equal(t, "component of Team board", board.Description)
+		assert.Equal(t, "jira:JiraProject:1:10", board.ProjectId)
+		assert.Equal(t, &ticket.BoardIssue{BoardId: "jira:JiraComponentBoard:1:8:Backend", IssueId: "jira:JiraIssue:1:100"}, results[2])
+	}
+
+	// the board is emitted once
+	results = converter.convert(&models.JiraIssue{IssueId: 101, Components: []string{"Backend"}})
+	assert.Equal(t, []interface{}{&ticket.BoardIssue{BoardId: "jira:JiraComponentBoard:1:8:Backend", IssueId: "jira:JiraIssue:1:101"}}, results)
+
+	// issues without component go to the default board
+	results = converter.convert(&models.JiraIssue{IssueId: 102})
+	if assert.Len(t, results, 3) {
+		assert.Equal(t, "jira:JiraComponentBoard:1:8:No Component", results[1].(*ticket.Board).Id)
+	}
+	assert.Equal(t, []string{"jira:JiraComponentBoard:1:8:Backend", "jira:JiraComponentBoard:1:8:No Component"}, converter.boardIds)
+}
+
+func TestToComponentProjectMappings(t *testing.T) {
+	assert.Empty(t, toComponentProjectMappings(nil, []string{"jira:JiraComponentBoard:1:8:Backend"}))
+
+	mappings := toComponentProjectMappings([]*crossdomain.ProjectMapping{
+		{ProjectName: "alpha", Table: "boards", RowId: "jira:JiraBoard:1:8"},
+		{ProjectName: "beta", Table: "boards", RowId: "jira:JiraBoard:1:8"},
+	}, []string{"jira:JiraComponentBoard:1:8:Backend", "jira:JiraComponentBoard:1:8:Frontend"})
+	if assert.Len(t, mappings, 4) {
+		assert.Equal(t, "alpha", mappings[0].ProjectName)
+		assert.Equal(t, "boards", mappings[0].Table)
+		assert.Equal(t, "jira:JiraComponentBoard:1:8:Backend", mappings[0].RowId)
+		assert.Equal(t, "alpha", mappings[0].RawDataParams)
+		assert.Equal(t, "beta", mappings[3].ProjectName)
+		assert.Equal(t, "jira:JiraComponentBoard:1:8:Frontend", mappings[3].RowId)
+	}
+}
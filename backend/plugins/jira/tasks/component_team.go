@@ -0,0 +1,82 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"sort"
+
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+
+	"golang.org/x/exp/slices"
+)
+
+// getComponentTeam returns the team owning the issue according to its components. Among several components, the
+// ones without a team are ignored and the tie break picks one of the others, an empty string is returned when none
+// of them is mapped
+func getComponentTeam(components []string, scopeConfig *models.JiraScopeConfig) string {
+	if scopeConfig == nil || len(scopeConfig.ComponentTeamMappings) == 0 {
+		return ""
+	}
+	var mapped []string
+	for _, component := range components {
+		if scopeConfig.ComponentTeamMappings[component] != "" && !slices.Contains(mapped, component) {
+			mapped = append(mapped, component)
+		}
+	}
+	if len(mapped) == 0 {
+		return ""
+	}
+	return scopeConfig.ComponentTeamMappings[sortComponents(mapped, scopeConfig)[0]]
+}
+
+// getPrimaryComponent returns the component of the issue winning the tie break, an empty string for issues without
+// components
+func getPrimaryComponent(components []string, scopeConfig *models.JiraScopeConfig) string {
+	if len(components) == 0 {
+		return ""
+	}
+	return sortComponents(components, scopeConfig)[0]
+}
+
+// sortComponents returns a copy of the components ordered by the tie break of the scope config
+func sortComponents(components []string, scopeConfig *models.JiraScopeConfig) []string {
+	sorted := slices.Clone(components)
+	if scopeConfig == nil {
+		return sorted
+	}
+	switch scopeConfig.ComponentTieBreak {
+	case models.ComponentTieBreakAlphabetical:
+		sort.Strings(sorted)
+	case models.ComponentTieBreakPriority:
+		// components missing from the priority list come last, alphabetically
+		rank := func(component string) int {
+			if i := slices.Index(scopeConfig.ComponentPriority, component); i >= 0 {
+				return i
+			}
+			return len(scopeConfig.ComponentPriority)
+		}
+		sort.SliceStable(sorted, func(i, j int) bool {
+			ri, rj := rank(sorted[i]), rank(sorted[j])
+			if ri != rj {
+				return ri < rj
+			}
+			return sorted[i] < sorted[j]
+		})
+	}
+	return sorted
+}
@@ -0,0 +1,70 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetComponentTeam(t *testing.T) {
+	scopeConfig := &models.JiraScopeConfig{
+		ComponentTeamMappings: map[string]string{
+			"backend":  "team:1",
+			"frontend": "team:2",
+			"api":      "team:3",
+		},
+	}
+	components := []string{"docs", "frontend", "backend", "api"}
+
+	assert.Equal(t, "", getComponentTeam(components, nil))
+	assert.Equal(t, "", getComponentTeam([]string{"docs"}, scopeConfig))
+	assert.Equal(t, "team:2", getComponentTeam(components, scopeConfig))
+
+	scopeConfig.ComponentTieBreak = models.ComponentTieBreakAlphabetical
+	assert.Equal(t, "team:3", getComponentTeam(components, scopeConfig))
+
+	scopeConfig.ComponentTieBreak = models.ComponentTieBreakPriority
+	scopeConfig.ComponentPriority = []string{"backend"}
+	assert.Equal(t, "team:1", getComponentTeam(components, scopeConfig))
+	scopeConfig.ComponentPriority = []string{"docs"}
+	assert.Equal(t, "team:3", getComponentTeam(components, scopeConfig))
+
+	// components mapped to no team don't win the tie break over the mapped ones
+	scopeConfig.ComponentTeamMappings["docs"] = ""
+	assert.Equal(t, "team:3", getComponentTeam(components, scopeConfig))
+	assert.Equal(t, "", getComponentTeam([]string{"docs"}, scopeConfig))
+	// several components of the same team
+	scopeConfig.ComponentTeamMappings["web"] = "team:2"
+	assert.Equal(t, "team:2", getComponentTeam([]string{"web", "frontend", "web"}, scopeConfig))
+}
+
+func TestGetPrimaryComponent(t *testing.T) {
+	components := []string{"frontend", "backend", "api"}
+	assert.Equal(t, "", getPrimaryComponent(nil, nil))
+	assert.Equal(t, "frontend", getPrimaryComponent(components, nil))
+	assert.Equal(t, "api", getPrimaryComponent(components, &models.JiraScopeConfig{ComponentTieBreak: models.ComponentTieBreakAlphabetical}))
+	assert.Equal(t, "backend", getPrimaryComponent(components, &models.JiraScopeConfig{
+		ComponentTieBreak: models.ComponentTieBreakPriority,
+		ComponentPriority: []string{"docs", "backend"},
+	}))
+	// the issue keeps its own order
+	assert.Equal(t, []string{"frontend", "backend", "api"}, components)
+}
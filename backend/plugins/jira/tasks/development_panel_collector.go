@@ -40,6 +40,7 @@ var CollectDevelopmentPanelMeta = plugin.SubTaskMeta{
 	Name:             "collectDevelopmentPanel",
 	EntryPoint:       CollectDevelopmentPanel,
 	EnabledByDefault: true,
+	RunsWhenStaged:   true,
 	Description:      "collect Jira development panel",
 	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET, plugin.DOMAIN_TYPE_CROSS},
 }
@@ -0,0 +1,173 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/crossdomain"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ConvertDodCompliance
+
+var ConvertDodComplianceMeta = plugin.SubTaskMeta{
+	Name:             "convertDodCompliance",
+	EntryPoint:       ConvertDodCompliance,
+	EnabledByDefault: true,
+	Description:      "flag the done Jira issues breaking the definition of done of the scope config",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+var dodViolations = map[string]string{
+	models.DodConditionStoryPoint:         "no story point",
+	models.DodConditionAcceptanceCriteria: "no acceptance criteria",
+	models.DodConditionAssignee:           "no assignee",
+	models.DodConditionLinkedCommit:       "no linked commit",
+	models.DodConditionLinkedPullRequest:  "no linked pull request",
+}
+
+// ConvertDodCompliance checks the done issues of the board against the rules of the definition of done. Linked
+// pull requests come from the code plugins, they only count once those ran
+func ConvertDodCompliance(taskCtx plugin.SubTaskContext) errors.Error {
+	db := taskCtx.GetDal()
+	data := taskCtx.GetData().(*JiraTaskData)
+	if data.Options.ScopeConfig == nil || len(data.Options.ScopeConfig.DodRules) == 0 {
+		return nil
+	}
+	connectionId := data.Options.ConnectionId
+	rules := data.Options.ScopeConfig.DodRules
+
+	var issues []*models.JiraIssue
+	err := db.All(&issues,
+		dal.Select("ji.issue_id, ji.story_point, ji.assignee_account_id, ji.acceptance_criteria, ji.std_type, ji.std_status"),
+		dal.From("_tool_jira_issues ji"),
+		dal.Join(`JOIN _tool_jira_board_issues bi ON (bi.connection_id = ji.connection_id AND bi.issue_id = ji.issue_id)`),
+		dal.Where("ji.connection_id = ? AND bi.board_id = ?", connectionId, data.Options.BoardId),
+		securityLevelFilter("ji.connection_id", "ji.issue_id"),
+	)
+	if err != nil {
+		return err
+	}
+	issueIdGen := didgen.NewDomainIdGenerator(&models.JiraIssue{})
+	issueIds := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		issueIds = append(issueIds, issueIdGen.Generate(connectionId, issue.IssueId))
+	}
+	linkedCommits, err := loadLinkedIssueIds(db, &crossdomain.IssueCommit{}, issueIds)
+	if err != nil {
+		return err
+	}
+	linkedPullRequests, err := loadLinkedIssueIds(db, &crossdomain.PullRequestIssue{}, issueIds)
+	if err != nil {
+		return err
+	}
+
+	updater := api.NewBatchUpdater(db, &ticket.Issue{}, "id")
+	for i, issue := range issues {
+		var compliant *bool
+		var violations interface{}
+		if issue.StdStatus == ticket.DONE {
+			reasons := getDodViolations(issue, issueIds[i], rules, linkedCommits, linkedPullRequests)
+			isCompliant := len(reasons) == 0
+			compliant = &isCompliant
+			// columns are updated as is, bypassing the json serializer of the model
+			encoded, err := errors.Convert01(json.Marshal(reasons))
+			if err != nil {
+				return err
+			}
+			violations = string(encoded)
+		}
+		err = updater.Add(issueIds[i],
+			dal.DalSet{ColumnName: "dod_compliant", Value: compliant},
+			dal.DalSet{ColumnName: "dod_violations", Value: violations},
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return updater.Flush()
+}
+
+// loadLinkedIssueIds returns which of the issues appear in the given relationship table, looking them up
+// api.BatchUpdateSize at a time to keep the statements bounded on large boards
+func loadLinkedIssueIds(db dal.Dal, table dal.Tabler, issueIds []string) (map[string]bool, errors.Error) {
+	result := make(map[string]bool)
+	for start := 0; start < len(issueIds); start += api.BatchUpdateSize {
+		end := start + api.BatchUpdateSize
+		if end > len(issueIds) {
+			end = len(issueIds)
+		}
+		var linkedIds []string
+		err := db.Pluck("DISTINCT issue_id", &linkedIds,
+			dal.From(table),
+			dal.Where("issue_id IN ?", issueIds[start:end]),
+		)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range linkedIds {
+			result[id] = true
+		}
+	}
+	return result, nil
+}
+
+// getDodViolations returns the reasons why an issue breaks the rules applying to its type, all rules have to be met
+func getDodViolations(issue *models.JiraIssue, issueId string, rules []models.DodRule, linkedCommits, linkedPullRequests map[string]bool) []string {
+	violations := make([]string, 0)
+	for _, rule := range rules {
+		if len(rule.IssueTypes) > 0 && !containsFold(rule.IssueTypes, issue.StdType) {
+			continue
+		}
+		var met bool
+		switch rule.Condition {
+		case models.DodConditionStoryPoint:
+			met = issue.StoryPoint > 0
+		case models.DodConditionAcceptanceCriteria:
+			met = issue.AcceptanceCriteria != ""
+		case models.DodConditionAssignee:
+			met = issue.AssigneeAccountId != ""
+		case models.DodConditionLinkedCommit:
+			met = linkedCommits[issueId]
+		case models.DodConditionLinkedPullRequest:
+			met = linkedPullRequests[issueId]
+		}
+		violation := dodViolations[rule.Condition]
+		if !met && !containsFold(violations, violation) {
+			violations = append(violations, violation)
+		}
+	}
+	return violations
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
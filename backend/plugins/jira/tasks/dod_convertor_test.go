@@ -0,0 +1,91 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/crossdomain"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDodViolations(t *testing.T) {
+	rules := []models.DodRule{
+		{Condition: models.DodConditionStoryPoint, IssueTypes: []string{"requirement"}},
+		{Condition: models.DodConditionAcceptanceCriteria},
+		{Condition: models.DodConditionLinkedPullRequest},
+	}
+	linkedPullRequests := map[string]bool{"jira:JiraIssue:1:2": true}
+
+	story := &models.JiraIssue{StdType: "REQUIREMENT", StoryPoint: 3, AcceptanceCriteria: "works"}
+	assert.Empty(t, getDodViolations(story, "jira:JiraIssue:1:2", rules, nil, linkedPullRequests))
+	assert.Equal(t, []string{"no linked pull request"}, getDodViolations(story, "jira:JiraIssue:1:3", rules, nil, linkedPullRequests))
+
+	story.StoryPoint = 0
+	story.AcceptanceCriteria = ""
+	assert.Equal(t, []string{"no story point", "no acceptance criteria"},
+		getDodViolations(story, "jira:JiraIssue:1:2", rules, nil, linkedPullRequests))
+
+	// the story point rule only applies to requirements
+	bug := &models.JiraIssue{StdType: "BUG", AcceptanceCriteria: "fixed"}
+	assert.Empty(t, getDodViolations(bug, "jira:JiraIssue:1:2", rules, nil, linkedPullRequests))
+}
+
+type linkedIssueTestDal struct {
+	dal.Dal
+	linked  map[string]bool
+	batches []int
+}
+
+func (d *linkedIssueTestDal) Pluck(_ string, dst interface{}, clauses ...dal.Clause) errors.Error {
+	issueIds := clauses[1].Data.(dal.DalClause).Params[0].([]string)
+	d.batches = append(d.batches, len(issueIds))
+	linkedIds := dst.(*[]string)
+	for _, id := range issueIds {
+		if d.linked[id] {
+			*linkedIds = append(*linkedIds, id)
+		}
+	}
+	return nil
+}
+
+func TestLoadLinkedIssueIds(t *testing.T) {
+	issueIds := make([]string, api.BatchUpdateSize+1)
+	for i := range issueIds {
+		issueIds[i] = fmt.Sprintf("jira:JiraIssue:1:%d", i)
+	}
+	last := issueIds[api.BatchUpdateSize]
+	db := &linkedIssueTestDal{linked: map[string]bool{issueIds[0]: true, last: true}}
+	linked, err := loadLinkedIssueIds(db, &crossdomain.IssueCommit{}, issueIds)
+	require.Nil(t, err)
+	assert.Equal(t, map[string]bool{issueIds[0]: true, last: true}, linked)
+	// the issues are looked up in bounded batches
+	assert.Equal(t, []int{api.BatchUpdateSize, 1}, db.batches)
+
+	db = &linkedIssueTestDal{}
+	linked, err = loadLinkedIssueIds(db, &crossdomain.IssueCommit{}, nil)
+	require.Nil(t, err)
+	assert.Empty(t, linked)
+	assert.Empty(t, db.batches)
+}
@@ -42,6 +42,7 @@ var CollectEpicsMeta = plugin.SubTaskMeta{
 	Name:             "collectEpics",
 	EntryPoint:       CollectEpics,
 	EnabledByDefault: true,
+	RunsWhenStaged:   true,
 	Description:      "collect Jira epics from all boards, does not support either timeFilter or diffSync.",
 	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET, plugin.DOMAIN_TYPE_CROSS},
 }
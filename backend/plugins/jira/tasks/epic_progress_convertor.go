@@ -0,0 +1,142 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ConvertEpicProgress
+
+var ConvertEpicProgressMeta = plugin.SubTaskMeta{
+	Name:             "convertEpicProgress",
+	EntryPoint:       ConvertEpicProgress,
+	EnabledByDefault: true,
+	Description:      "roll up the progress of Jira epics from the status of their children",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+// epicChild is an issue belonging to an epic, either through the epic link or its parent
+type epicChild struct {
+	EpicKey       string
+	ParentKey     string
+	ParentStdType string
+	StdStatus     string
+}
+
+type epicProgress struct {
+	Total int
+	Done  int
+}
+
+// ConvertEpicProgress writes the percentage of done children onto the epics of the board. Children are counted
+// across the whole connection since they are often spread over several boards, and the progress is recomputed on
+// every run so it follows the children being added, removed or transitioned
+func ConvertEpicProgress(taskCtx plugin.SubTaskContext) errors.Error {
+	db := taskCtx.GetDal()
+	data := taskCtx.GetData().(*JiraTaskData)
+	connectionId := data.Options.ConnectionId
+
+	var children []*epicChild
+	err := db.All(&children,
+		dal.Select("c.epic_key, c.parent_key, p.std_type AS parent_std_type, c.std_status"),
+		dal.From("_tool_jira_issues c"),
+		dal.Join(`LEFT JOIN _tool_jira_issues p ON (p.connection_id = c.connection_id AND p.issue_id = c.parent_id)`),
+		dal.Where("c.connection_id = ? AND (c.epic_key != '' OR c.parent_id != 0) AND c.security_excluded = ?", connectionId, false),
+	)
+	if err != nil {
+		return err
+	}
+	progresses := rollupEpicProgress(children)
+
+	var boardIssues []*models.JiraIssue
+	err = db.All(&boardIssues,
+		dal.Select("ji.issue_id, ji.issue_key, ji.std_type"),
+		dal.From("_tool_jira_issues ji"),
+		dal.Join(`JOIN _tool_jira_board_issues bi ON (bi.connection_id = ji.connection_id AND bi.issue_id = ji.issue_id)`),
+		dal.Where("ji.connection_id = ? AND bi.board_id = ?", connectionId, data.Options.BoardId),
+	)
+	if err != nil {
+		return err
+	}
+	issueIdGen := didgen.NewDomainIdGenerator(&models.JiraIssue{})
+	updater := api.NewBatchUpdater(db, &ticket.Issue{}, "id")
+	for _, issue := range boardIssues {
+		progress, isEpic := getEpicProgress(issue, progresses)
+		if !isEpic {
+			continue
+		}
+		err = updater.Add(issueIdGen.Generate(connectionId, issue.IssueId), dal.DalSet{ColumnName: "progress", Value: progress})
+		if err != nil {
+			return err
+		}
+	}
+	return updater.Flush()
+}
+
+// getEpicProgress returns the percentage of done children of the issue and whether it is an epic at all, either by
+// its type or by having children counted towards it. The progress of the other issues is left as is, epics without
+// children, including the ones whose children are all gone, have no progress at all
+func getEpicProgress(issue *models.JiraIssue, progresses map[string]*epicProgress) (*float64, bool) {
+	p, ok := progresses[issue.IssueKey]
+	if !ok {
+		return nil, issue.StdType == "EPIC"
+	}
+	if p.Total == 0 {
+		return nil, true
+	}
+	percentage := float64(p.Done) * 100 / float64(p.Total)
+	return &percentage, true
+}
+
+// rollupEpicProgress counts the children and the done children of each epic by its key. Issues linked to an epic
+// count towards it, otherwise issues count towards their parent if it is an epic, leaving subtasks out
+func rollupEpicProgress(children []*epicChild) map[string]*epicProgress {
+	epicKeys := make(map[string]bool)
+	for _, child := range children {
+		if child.EpicKey != "" {
+			epicKeys[child.EpicKey] = true
+		}
+	}
+	result := make(map[string]*epicProgress)
+	for _, child := range children {
+		epicKey := child.EpicKey
+		if epicKey == "" && (child.ParentStdType == "EPIC" || epicKeys[child.ParentKey]) {
+			epicKey = child.ParentKey
+		}
+		if epicKey == "" {
+			continue
+		}
+		p, ok := result[epicKey]
+		if !ok {
+			p = &epicProgress{}
+			result[epicKey] = p
+		}
+		p.Total++
+		if child.StdStatus == ticket.DONE {
+			p.Done++
+		}
+	}
+	return result
+}
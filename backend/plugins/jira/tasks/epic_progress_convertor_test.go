@@ -0,0 +1,68 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRollupEpicProgress(t *testing.T) {
+	children := []*epicChild{
+		{EpicKey: "E-1", StdStatus: ticket.DONE},
+		{EpicKey: "E-1", StdStatus: ticket.IN_PROGRESS},
+		{EpicKey: "E-1", ParentKey: "S-1", StdStatus: ticket.DONE},
+		// team-managed projects only set the parent
+		{ParentKey: "E-2", ParentStdType: "EPIC", StdStatus: ticket.TODO},
+		// the parent is known to be an epic as other issues are linked to it
+		{ParentKey: "E-1", ParentStdType: "REQUIREMENT", StdStatus: ticket.TODO},
+		// subtasks of stories are left out
+		{ParentKey: "S-1", ParentStdType: "REQUIREMENT", StdStatus: ticket.DONE},
+	}
+	assert.Equal(t, map[string]*epicProgress{
+		"E-1": {Total: 4, Done: 2},
+		"E-2": {Total: 1, Done: 0},
+	}, rollupEpicProgress(children))
+}
+
+func TestGetEpicProgress(t *testing.T) {
+	progresses := map[string]*epicProgress{
+		"E-1": {Total: 4, Done: 1},
+		// the parent is an epic as issues are linked to it, whatever its type
+		"S-2": {Total: 2, Done: 2},
+	}
+	progress, isEpic := getEpicProgress(&models.JiraIssue{IssueKey: "E-1", StdType: "EPIC"}, progresses)
+	assert.True(t, isEpic)
+	assert.Equal(t, 25.0, *progress)
+
+	progress, isEpic = getEpicProgress(&models.JiraIssue{IssueKey: "S-2", StdType: "REQUIREMENT"}, progresses)
+	assert.True(t, isEpic)
+	assert.Equal(t, 100.0, *progress)
+
+	// epics without children are cleared
+	progress, isEpic = getEpicProgress(&models.JiraIssue{IssueKey: "E-3", StdType: "EPIC"}, progresses)
+	assert.True(t, isEpic)
+	assert.Nil(t, progress)
+
+	// the other issues are left alone
+	_, isEpic = getEpicProgress(&models.JiraIssue{IssueKey: "S-4", StdType: "REQUIREMENT"}, progresses)
+	assert.False(t, isEpic)
+}
@@ -0,0 +1,182 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"sort"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ConvertEscapedDefects
+
+var ConvertEscapedDefectsMeta = plugin.SubTaskMeta{
+	Name:             "convertEscapedDefects",
+	EntryPoint:       ConvertEscapedDefects,
+	EnabledByDefault: true,
+	Description:      "trace the Jira defects escaped to production back to the issues causing them",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+// ConvertEscapedDefects links the escaped defects of the board to their sources in `_tool_jira_escaped_defects`
+// and counts the escaped defects caused by the issues of the board. Links, issues and labels are loaded across the
+// whole connection, like ConvertBlockingChains, so that sources get counted whatever board their defects are on
+func ConvertEscapedDefects(taskCtx plugin.SubTaskContext) errors.Error {
+	db := taskCtx.GetDal()
+	logger := taskCtx.GetLogger()
+	data := taskCtx.GetData().(*JiraTaskData)
+	connectionId := data.Options.ConnectionId
+	boardId := data.Options.BoardId
+	linkTypes := models.DefaultEscapedDefectLinkTypes
+	var labels []string
+	if data.Options.ScopeConfig != nil {
+		if len(data.Options.ScopeConfig.EscapedDefectLinkTypes) > 0 {
+			linkTypes = data.Options.ScopeConfig.EscapedDefectLinkTypes
+		}
+		labels = data.Options.ScopeConfig.EscapedDefectLabels
+	}
+
+	var defectIds []uint64
+	err := db.Pluck("issue_id", &defectIds,
+		dal.From(&models.JiraIssue{}),
+		dal.Where("connection_id = ? AND std_type IN ? AND security_excluded = ?", connectionId, []string{ticket.BUG, ticket.INCIDENT}, false),
+	)
+	if err != nil {
+		return err
+	}
+	if len(labels) > 0 {
+		var labelledIds []uint64
+		err = db.Pluck("DISTINCT issue_id", &labelledIds,
+			dal.From(&models.JiraIssueLabel{}),
+			dal.Where("connection_id = ? AND (label_name IN ? OR canonical_name IN ?)", connectionId, labels, labels),
+		)
+		if err != nil {
+			return err
+		}
+		defectIds = intersectIssueIds(defectIds, labelledIds)
+	}
+	escapedDefects := make(map[uint64]bool, len(defectIds))
+	for _, defectId := range defectIds {
+		escapedDefects[defectId] = true
+	}
+	var links []*blockingLink
+	err = db.All(&links,
+		dal.Select("issue_id, direction, related_issue_id"),
+		dal.From(&models.JiraIssueRelationship{}),
+		dal.Where("connection_id = ? AND link_type_name IN ?", connectionId, linkTypes),
+	)
+	if err != nil {
+		return err
+	}
+	sources := getEscapedDefectSources(links, escapedDefects)
+
+	var boardIssueIds []uint64
+	err = db.Pluck("issue_id", &boardIssueIds,
+		dal.From(&models.JiraBoardIssue{}),
+		dal.Where("connection_id = ? AND board_id = ?", connectionId, boardId),
+	)
+	if err != nil {
+		return err
+	}
+	err = db.Delete(&models.JiraEscapedDefect{}, dal.Where("connection_id = ? AND board_id = ?", connectionId, boardId))
+	if err != nil {
+		return err
+	}
+	counts := make(map[uint64]int)
+	for _, defectSources := range sources {
+		for _, sourceId := range defectSources {
+			counts[sourceId]++
+		}
+	}
+	issueIdGen := didgen.NewDomainIdGenerator(&models.JiraIssue{})
+	unattributed := 0
+	for _, issueId := range boardIssueIds {
+		count := counts[issueId]
+		sets := []dal.DalSet{{ColumnName: "escaped_defect_count", Value: count}}
+		if escapedDefects[issueId] {
+			defectSources := sources[issueId]
+			isUnattributed := len(defectSources) == 0
+			sets = append(sets, dal.DalSet{ColumnName: "escaped_defect_unattributed", Value: isUnattributed})
+			if isUnattributed {
+				unattributed++
+				defectSources = []uint64{0}
+			}
+			for _, sourceId := range defectSources {
+				err = db.CreateOrUpdate(&models.JiraEscapedDefect{
+					ConnectionId:  connectionId,
+					BoardId:       boardId,
+					DefectIssueId: issueId,
+					SourceIssueId: sourceId,
+				})
+				if err != nil {
+					return err
+				}
+			}
+		} else {
+			sets = append(sets, dal.DalSet{ColumnName: "escaped_defect_unattributed", Value: nil})
+		}
+		err = db.UpdateColumns(&ticket.Issue{}, sets, dal.Where("id = ?", issueIdGen.Generate(connectionId, issueId)))
+		if err != nil {
+			return err
+		}
+	}
+	logger.Info("board %d has %d escaped defects not traced back to any issue", boardId, unattributed)
+	return nil
+}
+
+// getEscapedDefectSources returns the issues causing each escaped defect, sorted, the defects without any being
+// absent from the result. A link is listed on both of its ends
+func getEscapedDefectSources(links []*blockingLink, escapedDefects map[uint64]bool) map[uint64][]uint64 {
+	result := make(map[uint64][]uint64)
+	seen := make(map[[2]uint64]bool)
+	for _, link := range links {
+		source, defect := link.IssueId, link.RelatedIssueId
+		if link.Direction == models.IssueLinkInward {
+			source, defect = defect, source
+		}
+		if !escapedDefects[defect] || seen[[2]uint64{source, defect}] {
+			continue
+		}
+		seen[[2]uint64{source, defect}] = true
+		result[defect] = append(result[defect], source)
+	}
+	for _, defectSources := range result {
+		sort.Slice(defectSources, func(i, j int) bool { return defectSources[i] < defectSources[j] })
+	}
+	return result
+}
+
+// intersectIssueIds returns the issues of a also in b
+func intersectIssueIds(a, b []uint64) []uint64 {
+	inB := make(map[uint64]bool, len(b))
+	for _, id := range b {
+		inB[id] = true
+	}
+	var result []uint64
+	for _, id := range a {
+		if inB[id] {
+			result = append(result, id)
+		}
+	}
+	return result
+}
@@ -0,0 +1,42 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEscapedDefectSources(t *testing.T) {
+	links := []*blockingLink{
+		// story 1 causes bug 10, listed on both ends
+		{IssueId: 1, Direction: models.IssueLinkOutward, RelatedIssueId: 10},
+		{IssueId: 10, Direction: models.IssueLinkInward, RelatedIssueId: 1},
+		// bug 10 is also caused by story 2
+		{IssueId: 10, Direction: models.IssueLinkInward, RelatedIssueId: 2},
+		// bug 11 isn't escaped
+		{IssueId: 3, Direction: models.IssueLinkOutward, RelatedIssueId: 11},
+		// bug 12 causes story 4
+		{IssueId: 12, Direction: models.IssueLinkOutward, RelatedIssueId: 4},
+	}
+	sources := getEscapedDefectSources(links, map[uint64]bool{10: true, 12: true})
+	assert.Equal(t, map[uint64][]uint64{10: {1, 2}}, sources)
+	assert.Equal(t, []uint64{10, 12}, intersectIssueIds([]uint64{10, 11, 12}, []uint64{12, 10, 13}))
+}
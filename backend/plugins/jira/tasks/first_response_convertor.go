@@ -0,0 +1,110 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"time"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ConvertFirstResponses
+
+var ConvertFirstResponsesMeta = plugin.SubTaskMeta{
+	Name:             "convertFirstResponses",
+	EntryPoint:       ConvertFirstResponses,
+	EnabledByDefault: true,
+	Description:      "compute when Jira issues were first responded to out of their comments",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+// ConvertFirstResponses writes the first comment of someone other than the creator onto the issues of the board,
+// restricted comments being left out when firstResponseIgnoresRestrictedComments is set
+func ConvertFirstResponses(taskCtx plugin.SubTaskContext) errors.Error {
+	db := taskCtx.GetDal()
+	data := taskCtx.GetData().(*JiraTaskData)
+	connectionId := data.Options.ConnectionId
+	ignoreRestricted := data.Options.ScopeConfig != nil && data.Options.ScopeConfig.FirstResponseIgnoresRestrictedComments
+
+	var issues []*models.JiraIssue
+	err := db.All(&issues,
+		dal.Select("ji.issue_id, ji.creator_account_id, ji.created"),
+		dal.From("_tool_jira_issues ji"),
+		dal.Join(`JOIN _tool_jira_board_issues bi ON (bi.connection_id = ji.connection_id AND bi.issue_id = ji.issue_id)`),
+		dal.Where("ji.connection_id = ? AND bi.board_id = ?", connectionId, data.Options.BoardId),
+		securityLevelFilter("ji.connection_id", "ji.issue_id"),
+	)
+	if err != nil {
+		return err
+	}
+	var comments []*models.JiraIssueComment
+	err = db.All(&comments,
+		dal.Select("c.issue_id, c.creator_account_id, c.created, c.restricted"),
+		dal.From("_tool_jira_issue_comments c"),
+		dal.Join(`JOIN _tool_jira_board_issues bi ON (bi.connection_id = c.connection_id AND bi.issue_id = c.issue_id)`),
+		dal.Where("c.connection_id = ? AND bi.board_id = ?", connectionId, data.Options.BoardId),
+	)
+	if err != nil {
+		return err
+	}
+	firstResponses := getFirstResponses(issues, comments, ignoreRestricted)
+
+	issueIdGen := didgen.NewDomainIdGenerator(&models.JiraIssue{})
+	for _, issue := range issues {
+		var firstResponseDate *time.Time
+		var firstResponseMinutes *int64
+		if responded, ok := firstResponses[issue.IssueId]; ok {
+			minutes := int64(responded.Sub(issue.Created).Minutes())
+			firstResponseDate = &responded
+			firstResponseMinutes = &minutes
+		}
+		err = db.UpdateColumns(&ticket.Issue{}, []dal.DalSet{
+			{ColumnName: "first_response_date", Value: firstResponseDate},
+			{ColumnName: "first_response_minutes", Value: firstResponseMinutes},
+		}, dal.Where("id = ?", issueIdGen.Generate(connectionId, issue.IssueId)))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getFirstResponses returns when the issues were first commented on by someone other than their creator, issues
+// without such comment are absent from the result
+func getFirstResponses(issues []*models.JiraIssue, comments []*models.JiraIssueComment, ignoreRestricted bool) map[uint64]time.Time {
+	creators := make(map[uint64]string, len(issues))
+	for _, issue := range issues {
+		creators[issue.IssueId] = issue.CreatorAccountId
+	}
+	result := make(map[uint64]time.Time)
+	for _, comment := range comments {
+		creator, ok := creators[comment.IssueId]
+		if !ok || comment.CreatorAccountId == creator || (ignoreRestricted && comment.Restricted) {
+			continue
+		}
+		if first, ok := result[comment.IssueId]; !ok || comment.Created.Before(first) {
+			result[comment.IssueId] = comment.Created
+		}
+	}
+	return result
+}
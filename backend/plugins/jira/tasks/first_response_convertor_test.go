@@ -0,0 +1,46 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetFirstResponses(t *testing.T) {
+	hour := func(h int) time.Time {
+		return time.Date(2023, 8, 1, h, 0, 0, 0, time.UTC)
+	}
+	issues := []*models.JiraIssue{
+		{IssueId: 1, CreatorAccountId: "customer", Created: hour(8)},
+		{IssueId: 2, CreatorAccountId: "customer", Created: hour(8)},
+		{IssueId: 3, CreatorAccountId: "customer", Created: hour(8)},
+	}
+	comments := []*models.JiraIssueComment{
+		{IssueId: 1, CreatorAccountId: "customer", Created: hour(9)},
+		{IssueId: 1, CreatorAccountId: "agent", Created: hour(12)},
+		{IssueId: 1, CreatorAccountId: "agent", Created: hour(10), Restricted: true},
+		{IssueId: 2, CreatorAccountId: "agent", Created: hour(11), Restricted: true},
+		{IssueId: 4, CreatorAccountId: "agent", Created: hour(11)},
+	}
+	assert.Equal(t, map[uint64]time.Time{1: hour(10), 2: hour(11)}, getFirstResponses(issues, comments, false))
+	assert.Equal(t, map[uint64]time.Time{1: hour(12)}, getFirstResponses(issues, comments, true))
+}
@@ -0,0 +1,198 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"strings"
+	"time"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ConvertFlowEfficiency
+
+var ConvertFlowEfficiencyMeta = plugin.SubTaskMeta{
+	Name:             "convertFlowEfficiency",
+	EntryPoint:       ConvertFlowEfficiency,
+	EnabledByDefault: true,
+	Description:      "compute the flow efficiency of Jira issues out of their status transitions",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+const (
+	flowClassActive  = "active"
+	flowClassWaiting = "waiting"
+)
+
+// flowClasses looks up whether statuses are active or waiting by their Jira name first, then by their standard
+// status, like reworkStages
+type flowClasses struct {
+	names       map[string]string
+	stdStatuses map[string]string
+}
+
+func newFlowClasses(activeStatuses, waitingStatuses []string) *flowClasses {
+	if len(activeStatuses) == 0 {
+		activeStatuses = models.DefaultFlowActiveStatuses
+	}
+	if len(waitingStatuses) == 0 {
+		waitingStatuses = models.DefaultFlowWaitingStatuses
+	}
+	result := &flowClasses{
+		names:       make(map[string]string),
+		stdStatuses: make(map[string]string),
+	}
+	for class, statuses := range map[string][]string{flowClassActive: activeStatuses, flowClassWaiting: waitingStatuses} {
+		for _, status := range statuses {
+			result.names[strings.ToLower(status)] = class
+			result.stdStatuses[strings.ToUpper(status)] = class
+		}
+	}
+	return result
+}
+
+// class returns whether a status is active or waiting, empty when it is neither
+func (c *flowClasses) class(status, stdStatus string) string {
+	if class, ok := c.names[strings.ToLower(status)]; ok {
+		return class
+	}
+	return c.stdStatuses[stdStatus]
+}
+
+// flowTime holds the minutes an issue spent in active and in waiting statuses out of its lead time
+type flowTime struct {
+	ActiveMinutes  int64
+	WaitingMinutes int64
+	LeadMinutes    int64
+}
+
+// ConvertFlowEfficiency writes the time the issues of the board spent in active and in waiting statuses along with
+// their flow efficiency. Unresolved issues are measured up to now so the efficiency is recomputed on every run, and
+// the issues whose changelog was truncated are flagged as provisional
+func ConvertFlowEfficiency(taskCtx plugin.SubTaskContext) errors.Error {
+	db := taskCtx.GetDal()
+	data := taskCtx.GetData().(*JiraTaskData)
+	connectionId := data.Options.ConnectionId
+	var activeStatuses, waitingStatuses []string
+	if data.Options.ScopeConfig != nil {
+		activeStatuses = data.Options.ScopeConfig.FlowActiveStatuses
+		waitingStatuses = data.Options.ScopeConfig.FlowWaitingStatuses
+	}
+	classes := newFlowClasses(activeStatuses, waitingStatuses)
+
+	var issues []*models.JiraIssue
+	err := db.All(&issues,
+		dal.Select("ji.issue_id, ji.status_name, ji.std_status, ji.created, ji.resolution_date"),
+		dal.From("_tool_jira_issues ji"),
+		dal.Join(`JOIN _tool_jira_board_issues bi ON (bi.connection_id = ji.connection_id AND bi.issue_id = ji.issue_id)`),
+		dal.Where("ji.connection_id = ? AND bi.board_id = ?", connectionId, data.Options.BoardId),
+		securityLevelFilter("ji.connection_id", "ji.issue_id"),
+	)
+	if err != nil {
+		return err
+	}
+	transitions, err := loadReworkTransitions(db, data)
+	if err != nil {
+		return err
+	}
+	issueTransitions := make(map[uint64][]*reworkTransition)
+	for _, transition := range transitions {
+		issueTransitions[transition.IssueId] = append(issueTransitions[transition.IssueId], transition)
+	}
+	truncatedIssues, err := loadTruncatedChangelogIssues(db, connectionId, data.Options.BoardId)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	issueIdGen := didgen.NewDomainIdGenerator(&models.JiraIssue{})
+	for _, issue := range issues {
+		flow := getFlowTime(issue, issueTransitions[issue.IssueId], classes, now)
+		// issues resolved at their creation have no lead time to be efficient in
+		var efficiency *float64
+		if flow.LeadMinutes > 0 {
+			ratio := float64(flow.ActiveMinutes) / float64(flow.LeadMinutes)
+			efficiency = &ratio
+		}
+		provisional := truncatedIssues[issue.IssueId]
+		err = db.UpdateColumns(&ticket.Issue{}, []dal.DalSet{
+			{ColumnName: "flow_active_minutes", Value: flow.ActiveMinutes},
+			{ColumnName: "flow_waiting_minutes", Value: flow.WaitingMinutes},
+			{ColumnName: "flow_efficiency", Value: efficiency},
+			{ColumnName: "flow_efficiency_is_provisional", Value: &provisional},
+		}, dal.Where("id = ?", issueIdGen.Generate(connectionId, issue.IssueId)))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getFlowTime sums up the time an issue spent in each class of statuses from its creation to the end of its lead
+// time, given its status transitions sorted by time. The lead time ends with the resolution, or with the latest
+// transition of issues done without resolution, and with now for the others. Issues without transitions spent all
+// of it in their current status
+func getFlowTime(issue *models.JiraIssue, transitions []*reworkTransition, classes *flowClasses, now time.Time) *flowTime {
+	end := now
+	if issue.ResolutionDate != nil {
+		end = *issue.ResolutionDate
+	} else if issue.StdStatus == ticket.DONE && len(transitions) > 0 {
+		end = transitions[len(transitions)-1].Created
+	}
+	status, stdStatus := issue.StatusName, issue.StdStatus
+	if len(transitions) > 0 {
+		status, stdStatus = transitions[0].FromStatus, transitions[0].FromStdStatus
+	}
+	var active, waiting time.Duration
+	addSegment := func(from, to time.Time) {
+		if to.After(end) {
+			to = end
+		}
+		if !to.After(from) {
+			return
+		}
+		switch classes.class(status, stdStatus) {
+		case flowClassActive:
+			active += to.Sub(from)
+		case flowClassWaiting:
+			waiting += to.Sub(from)
+		}
+	}
+	start := issue.Created
+	for _, transition := range transitions {
+		addSegment(start, transition.Created)
+		if transition.Created.After(start) {
+			start = transition.Created
+		}
+		status, stdStatus = transition.ToStatus, transition.ToStdStatus
+	}
+	addSegment(start, end)
+	result := &flowTime{
+		ActiveMinutes:  int64(active.Minutes()),
+		WaitingMinutes: int64(waiting.Minutes()),
+	}
+	if end.After(issue.Created) {
+		result.LeadMinutes = int64(end.Sub(issue.Created).Minutes())
+	}
+	return result
+}
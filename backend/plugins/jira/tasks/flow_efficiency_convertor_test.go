@@ -0,0 +1,56 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetFlowTime(t *testing.T) {
+	created := time.Date(2023, 9, 1, 9, 0, 0, 0, time.UTC)
+	at := func(hours int) time.Time {
+		return created.Add(time.Duration(hours) * time.Hour)
+	}
+	classes := newFlowClasses(nil, []string{"Code Review", "TODO"})
+	transitions := []*reworkTransition{
+		{FromStatus: "Open", FromStdStatus: ticket.TODO, ToStatus: "In Progress", ToStdStatus: ticket.IN_PROGRESS, Created: at(2)},
+		{FromStatus: "In Progress", FromStdStatus: ticket.IN_PROGRESS, ToStatus: "Code Review", ToStdStatus: ticket.IN_PROGRESS, Created: at(5)},
+		{FromStatus: "Code Review", FromStdStatus: ticket.IN_PROGRESS, ToStatus: "Done", ToStdStatus: ticket.DONE, Created: at(9)},
+	}
+	resolved := at(9)
+	issue := &models.JiraIssue{StatusName: "Done", StdStatus: ticket.DONE, Created: created, ResolutionDate: &resolved}
+	assert.Equal(t, &flowTime{ActiveMinutes: 180, WaitingMinutes: 360, LeadMinutes: 540}, getFlowTime(issue, transitions, classes, at(100)))
+
+	// done without resolution ends with the latest transition
+	issue = &models.JiraIssue{StatusName: "Done", StdStatus: ticket.DONE, Created: created}
+	assert.Equal(t, &flowTime{ActiveMinutes: 180, WaitingMinutes: 360, LeadMinutes: 540}, getFlowTime(issue, transitions, classes, at(100)))
+
+	// unresolved issues are measured up to now
+	issue = &models.JiraIssue{StatusName: "Code Review", StdStatus: ticket.IN_PROGRESS, Created: created}
+	assert.Equal(t, &flowTime{ActiveMinutes: 180, WaitingMinutes: 540, LeadMinutes: 720}, getFlowTime(issue, transitions[:2], classes, at(12)))
+
+	issue = &models.JiraIssue{StatusName: "In Progress", StdStatus: ticket.IN_PROGRESS, Created: created}
+	assert.Equal(t, &flowTime{ActiveMinutes: 60, LeadMinutes: 60}, getFlowTime(issue, nil, classes, at(1)))
+
+	assert.NotNil(t, (&models.JiraScopeConfig{FlowActiveStatuses: []string{"Review"}, FlowWaitingStatuses: []string{"review"}}).Validate())
+}
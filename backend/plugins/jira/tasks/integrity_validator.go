@@ -0,0 +1,154 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ValidateIntegrity
+
+var ValidateIntegrityMeta = plugin.SubTaskMeta{
+	Name:             "validateIntegrity",
+	EntryPoint:       ValidateIntegrity,
+	EnabledByDefault: true,
+	Description:      "report orphaned and dangling references among the Jira tool and domain data of the board",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+// integrityCheck counts the rows of a table referencing a row missing from another one
+type integrityCheck struct {
+	Name    string
+	Clauses []dal.Clause
+}
+
+type integrityResult struct {
+	Name    string
+	Orphans int64
+}
+
+// ValidateIntegrity runs read-only integrity checks over the data of the board and reports the number of orphans
+// found by each. It fails only when FailOnIntegrityOrphans is set and a check exceeds IntegrityOrphanTolerance
+func ValidateIntegrity(taskCtx plugin.SubTaskContext) errors.Error {
+	db := taskCtx.GetDal()
+	data := taskCtx.GetData().(*JiraTaskData)
+	logger := taskCtx.GetLogger()
+
+	var results []*integrityResult
+	for _, check := range getIntegrityChecks(data.Options.ConnectionId, data.Options.BoardId) {
+		orphans, err := db.Count(check.Clauses...)
+		if err != nil {
+			return errors.Default.Wrap(err, fmt.Sprintf("failed to run integrity check %s", check.Name))
+		}
+		logger.Info("integrity check %s: %d orphans", check.Name, orphans)
+		results = append(results, &integrityResult{Name: check.Name, Orphans: orphans})
+	}
+
+	var tolerance int
+	var failOnOrphans bool
+	if data.Options.ScopeConfig != nil {
+		tolerance = data.Options.ScopeConfig.IntegrityOrphanTolerance
+		failOnOrphans = data.Options.ScopeConfig.FailOnIntegrityOrphans
+	}
+	violations := getIntegrityViolations(results, tolerance)
+	if len(violations) == 0 {
+		return nil
+	}
+	message := fmt.Sprintf("integrity checks exceeded the tolerance of %d orphans: %s", tolerance, strings.Join(violations, ", "))
+	if failOnOrphans {
+		return errors.Default.New(message)
+	}
+	logger.Warn(nil, message)
+	return nil
+}
+
+func getIntegrityChecks(connectionId, boardId uint64) []*integrityCheck {
+	domainBoardId := didgen.NewDomainIdGenerator(&models.JiraBoard{}).Generate(connectionId, boardId)
+	return []*integrityCheck{
+		{
+			Name: "_tool_jira_board_issues.issue_id",
+			Clauses: []dal.Clause{
+				dal.From("_tool_jira_board_issues bi"),
+				dal.Join(`LEFT JOIN _tool_jira_issues i ON (i.connection_id = bi.connection_id AND i.issue_id = bi.issue_id)`),
+				dal.Where("bi.connection_id = ? AND bi.board_id = ? AND i.issue_id IS NULL", connectionId, boardId),
+			},
+		},
+		{
+			Name: "_tool_jira_sprint_issues.issue_id",
+			Clauses: []dal.Clause{
+				dal.From("_tool_jira_sprint_issues si"),
+				dal.Join(`JOIN _tool_jira_board_sprints bs ON (bs.connection_id = si.connection_id AND bs.sprint_id = si.sprint_id)`),
+				dal.Join(`LEFT JOIN _tool_jira_issues i ON (i.connection_id = si.connection_id AND i.issue_id = si.issue_id)`),
+				dal.Where("si.connection_id = ? AND bs.board_id = ? AND i.issue_id IS NULL", connectionId, boardId),
+			},
+		},
+		{
+			Name: "_tool_jira_issue_relationships.related_issue_id",
+			Clauses: []dal.Clause{
+				dal.From("_tool_jira_issue_relationships r"),
+				dal.Join(`JOIN _tool_jira_board_issues bi ON (bi.connection_id = r.connection_id AND bi.issue_id = r.issue_id)`),
+				dal.Join(`LEFT JOIN _tool_jira_issues i ON (i.connection_id = r.connection_id AND i.issue_id = r.related_issue_id)`),
+				dal.Where("r.connection_id = ? AND bi.board_id = ? AND i.issue_id IS NULL", connectionId, boardId),
+			},
+		},
+		{
+			Name: "board_issues.issue_id",
+			Clauses: []dal.Clause{
+				dal.From("board_issues bi"),
+				dal.Join(`LEFT JOIN issues i ON (i.id = bi.issue_id)`),
+				dal.Where("bi.board_id = ? AND i.id IS NULL", domainBoardId),
+			},
+		},
+		{
+			Name: "sprint_issues.issue_id",
+			Clauses: []dal.Clause{
+				dal.From("sprint_issues si"),
+				dal.Join(`JOIN board_sprints bs ON (bs.sprint_id = si.sprint_id)`),
+				dal.Join(`LEFT JOIN issues i ON (i.id = si.issue_id)`),
+				dal.Where("bs.board_id = ? AND i.id IS NULL", domainBoardId),
+			},
+		},
+		{
+			Name: "issues.parent_issue_id",
+			Clauses: []dal.Clause{
+				dal.From("issues c"),
+				dal.Join(`JOIN board_issues bi ON (bi.issue_id = c.id)`),
+				dal.Join(`LEFT JOIN issues p ON (p.id = c.parent_issue_id)`),
+				dal.Where("bi.board_id = ? AND c.parent_issue_id != '' AND p.id IS NULL", domainBoardId),
+			},
+		},
+	}
+}
+
+// getIntegrityViolations lists the checks having found more orphans than tolerated
+func getIntegrityViolations(results []*integrityResult, tolerance int) []string {
+	var violations []string
+	for _, result := range results {
+		if result.Orphans > int64(tolerance) {
+			violations = append(violations, fmt.Sprintf("%s (%d)", result.Name, result.Orphans))
+		}
+	}
+	return violations
+}
@@ -0,0 +1,35 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetIntegrityViolations(t *testing.T) {
+	results := []*integrityResult{
+		{Name: "board_issues.issue_id", Orphans: 0},
+		{Name: "sprint_issues.issue_id", Orphans: 3},
+		{Name: "issues.parent_issue_id", Orphans: 5},
+	}
+	assert.Equal(t, []string{"sprint_issues.issue_id (3)", "issues.parent_issue_id (5)"}, getIntegrityViolations(results, 0))
+	assert.Equal(t, []string{"issues.parent_issue_id (5)"}, getIntegrityViolations(results, 3))
+	assert.Empty(t, getIntegrityViolations(results, 5))
+}
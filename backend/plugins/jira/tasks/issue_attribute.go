@@ -0,0 +1,112 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/apache/incubator-devlake/plugins/jira/tasks/apiv2models"
+)
+
+// attributeValueLength is the size of the value column, which is part of the primary key of the attributes
+const attributeValueLength = 255
+
+// extractIssueAttributes returns the labels, components, versions and the values of the custom select fields of an
+// issue as attributes, typed by field id for the latter, an attribute listed twice is emitted once. Values longer
+// than the value column are truncated to it, so two of them sharing its first characters are emitted once as well
+func extractIssueAttributes(
+	connectionId, issueId uint64,
+	labels, components []string,
+	fixVersions, affectedVersions []apiv2models.Version,
+	allFields map[string]interface{},
+	attributeFields []string,
+) []interface{} {
+	var results []interface{}
+	seen := make(map[[2]string]bool)
+	add := func(attributeType, value string) {
+		value = truncateAttributeValue(value)
+		key := [2]string{attributeType, value}
+		if value == "" || seen[key] {
+			return
+		}
+		seen[key] = true
+		results = append(results, &models.JiraIssueAttribute{
+			ConnectionId:  connectionId,
+			IssueId:       issueId,
+			AttributeType: attributeType,
+			Value:         value,
+		})
+	}
+	for _, label := range labels {
+		add(ticket.ISSUE_ATTRIBUTE_LABEL, label)
+	}
+	for _, component := range components {
+		add(ticket.ISSUE_ATTRIBUTE_COMPONENT, component)
+	}
+	for _, version := range fixVersions {
+		add(ticket.ISSUE_ATTRIBUTE_FIX_VERSION, version.Name)
+	}
+	for _, version := range affectedVersions {
+		add(ticket.ISSUE_ATTRIBUTE_AFFECTED_VERSION, version.Name)
+	}
+	for _, field := range attributeFields {
+		for _, value := range parseSelectValues(allFields[field]) {
+			add(field, value)
+		}
+	}
+	return results
+}
+
+// truncateAttributeValue cuts the value to the characters the value column holds, on a rune boundary as both
+// MySQL and PostgreSQL size varchar columns in characters
+func truncateAttributeValue(value string) string {
+	if utf8.RuneCountInString(value) <= attributeValueLength {
+		return value
+	}
+	return string([]rune(value)[:attributeValueLength])
+}
+
+// parseSelectValues returns the selected options of a select field, the value of single selects being an option
+// and the one of multi selects a list of them. Options are objects holding their `value`, or `name` for some
+// system-like fields, plain values are taken as they are
+func parseSelectValues(value interface{}) []string {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		var result []string
+		for _, item := range v {
+			result = append(result, parseSelectValues(item)...)
+		}
+		return result
+	case map[string]interface{}:
+		for _, key := range []string{"value", "name"} {
+			if s, ok := v[key].(string); ok {
+				return []string{s}
+			}
+		}
+		return nil
+	case string:
+		return []string{v}
+	default:
+		return []string{fmt.Sprint(v)}
+	}
+}
@@ -0,0 +1,88 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"reflect"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+var ConvertIssueAttributesMeta = plugin.SubTaskMeta{
+	Name:             "convertIssueAttributes",
+	EntryPoint:       ConvertIssueAttributes,
+	EnabledByDefault: true,
+	Description:      "Convert tool layer table jira_issue_attributes into domain layer table issue_attributes",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+// ConvertIssueAttributes copies the attributes of the issues of the board, which are only extracted when
+// materializeIssueAttributes is set
+func ConvertIssueAttributes(taskCtx plugin.SubTaskContext) errors.Error {
+	db := taskCtx.GetDal()
+	data := taskCtx.GetData().(*JiraTaskData)
+	connectionId := data.Options.ConnectionId
+
+	cursor, err := db.Cursor(
+		dal.Select("jia.*"),
+		dal.From("_tool_jira_issue_attributes jia"),
+		dal.Join(`LEFT JOIN _tool_jira_board_issues jbi
+              ON jia.connection_id = jbi.connection_id AND jia.issue_id = jbi.issue_id`),
+		dal.Where("jia.connection_id = ? AND jbi.board_id = ?", connectionId, data.Options.BoardId),
+		securityLevelFilter("jia.connection_id", "jia.issue_id"),
+	)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+	issueIdGen := didgen.NewDomainIdGenerator(&models.JiraIssue{})
+
+	converter, err := api.NewDataConverter(api.DataConverterArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: JiraApiParams{
+				ConnectionId: connectionId,
+				BoardId:      data.Options.BoardId,
+			},
+			Table: RAW_ISSUE_TABLE,
+		},
+		InputRowType: reflect.TypeOf(models.JiraIssueAttribute{}),
+		Input:        cursor,
+		Convert: func(inputRow interface{}) ([]interface{}, errors.Error) {
+			attribute := inputRow.(*models.JiraIssueAttribute)
+			return []interface{}{
+				&ticket.IssueAttribute{
+					IssueId:       issueIdGen.Generate(connectionId, attribute.IssueId),
+					AttributeType: attribute.AttributeType,
+					Value:         attribute.Value,
+				},
+			}, nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return converter.Execute()
+}
@@ -0,0 +1,73 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/apache/incubator-devlake/plugins/jira/tasks/apiv2models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractIssueAttributes(t *testing.T) {
+	allFields := map[string]interface{}{
+		"customfield_10001": map[string]interface{}{"id": "1", "value": "High"},
+		"customfield_10002": []interface{}{map[string]interface{}{"value": "iOS"}, map[string]interface{}{"value": "Android"}},
+		"customfield_10003": nil,
+	}
+	results := extractIssueAttributes(1, 10,
+		[]string{"backend", "backend"}, []string{"api"},
+		[]apiv2models.Version{{ID: 1, Name: "v1.0"}}, []apiv2models.Version{{ID: 1, Name: "v1.0"}, {ID: 2, Name: ""}},
+		allFields, []string{"customfield_10001", "customfield_10002", "customfield_10003"},
+	)
+	attribute := func(attributeType, value string) *models.JiraIssueAttribute {
+		return &models.JiraIssueAttribute{ConnectionId: 1, IssueId: 10, AttributeType: attributeType, Value: value}
+	}
+	assert.Equal(t, []interface{}{
+		attribute(ticket.ISSUE_ATTRIBUTE_LABEL, "backend"),
+		attribute(ticket.ISSUE_ATTRIBUTE_COMPONENT, "api"),
+		attribute(ticket.ISSUE_ATTRIBUTE_FIX_VERSION, "v1.0"),
+		attribute(ticket.ISSUE_ATTRIBUTE_AFFECTED_VERSION, "v1.0"),
+		attribute("customfield_10001", "High"),
+		attribute("customfield_10002", "iOS"),
+		attribute("customfield_10002", "Android"),
+	}, results)
+}
+
+func TestExtractIssueAttributesTruncatesLongValues(t *testing.T) {
+	prefix := strings.Repeat("é", attributeValueLength)
+	results := extractIssueAttributes(1, 10, []string{prefix + "a", prefix + "b", "short"}, nil, nil, nil, nil, nil)
+	if assert.Len(t, results, 2) {
+		value := results[0].(*models.JiraIssueAttribute).Value
+		assert.Equal(t, prefix, value)
+		assert.True(t, utf8.ValidString(value))
+		assert.Equal(t, "short", results[1].(*models.JiraIssueAttribute).Value)
+	}
+}
+
+func TestParseSelectValues(t *testing.T) {
+	assert.Equal(t, []string{"Gold"}, parseSelectValues(map[string]interface{}{"name": "Gold"}))
+	assert.Equal(t, []string{"plain"}, parseSelectValues("plain"))
+	assert.Equal(t, []string{"3"}, parseSelectValues(float64(3)))
+	assert.Nil(t, parseSelectValues(map[string]interface{}{"id": "1"}))
+	assert.Nil(t, parseSelectValues(nil))
+}
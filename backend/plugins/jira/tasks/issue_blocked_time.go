@@ -0,0 +1,91 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"sort"
+	"time"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+)
+
+// flagTransition is an issue being flagged or unflagged, Jira records the flag as `Impediment` and clears it when
+// the issue gets unflagged
+type flagTransition struct {
+	IssueId  uint64
+	Created  time.Time
+	ToString string
+}
+
+// loadFlagTransitions returns the changes of the flagged field of all issues belonging to the board by issue
+func loadFlagTransitions(db dal.Dal, connectionId, boardId uint64, field string) (map[uint64][]*flagTransition, errors.Error) {
+	var transitions []*flagTransition
+	err := db.All(&transitions,
+		dal.Select("c.issue_id, c.created, i.to_string"),
+		dal.From("_tool_jira_issue_changelog_items i"),
+		dal.Join(`JOIN _tool_jira_issue_changelogs c ON (c.connection_id = i.connection_id AND c.changelog_id = i.changelog_id)`),
+		dal.Join(`JOIN _tool_jira_board_issues bi ON (bi.connection_id = c.connection_id AND bi.issue_id = c.issue_id)`),
+		dal.Where("i.connection_id = ? AND bi.board_id = ? AND i.field = ?", connectionId, boardId, field),
+	)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[uint64][]*flagTransition)
+	for _, t := range transitions {
+		result[t.IssueId] = append(result[t.IssueId], t)
+	}
+	return result, nil
+}
+
+// getBlockedMinutes sums up the time an issue spent flagged until end, i.e. its resolution date or now, an issue
+// still flagged is considered blocked until end and the time flagged past end is left out
+func getBlockedMinutes(transitions []*flagTransition, end time.Time) int64 {
+	sorted := make([]*flagTransition, len(transitions))
+	copy(sorted, transitions)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Created.Before(sorted[j].Created)
+	})
+	var blocked time.Duration
+	var flaggedAt *time.Time
+	addInterval := func(unflaggedAt time.Time) {
+		if unflaggedAt.After(end) {
+			unflaggedAt = end
+		}
+		if unflaggedAt.After(*flaggedAt) {
+			blocked += unflaggedAt.Sub(*flaggedAt)
+		}
+		flaggedAt = nil
+	}
+	for _, t := range sorted {
+		if t.ToString != "" {
+			// flagging an issue already flagged doesn't restart the interval
+			if flaggedAt == nil {
+				flaggedAt = &t.Created
+			}
+			continue
+		}
+		if flaggedAt != nil {
+			addInterval(t.Created)
+		}
+	}
+	if flaggedAt != nil {
+		addInterval(end)
+	}
+	return int64(blocked.Minutes())
+}
@@ -0,0 +1,49 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetBlockedMinutes(t *testing.T) {
+	at := func(hour int) time.Time {
+		return time.Date(2023, 8, 1, hour, 0, 0, 0, time.UTC)
+	}
+	flag := func(hour int) *flagTransition {
+		return &flagTransition{Created: at(hour), ToString: "Impediment"}
+	}
+	unflag := func(hour int) *flagTransition {
+		return &flagTransition{Created: at(hour)}
+	}
+	assert.Equal(t, int64(0), getBlockedMinutes(nil, at(23)))
+	assert.Equal(t, int64(180), getBlockedMinutes([]*flagTransition{unflag(5), flag(2)}, at(23)))
+	// two intervals, the second one still open
+	assert.Equal(t, int64(120+60), getBlockedMinutes([]*flagTransition{flag(1), unflag(3), flag(22)}, at(23)))
+	// flagged twice in a row
+	assert.Equal(t, int64(240), getBlockedMinutes([]*flagTransition{flag(1), flag(2), unflag(5)}, at(23)))
+	// unflagged without having been flagged, e.g. flagged before the changelogs were kept
+	assert.Equal(t, int64(0), getBlockedMinutes([]*flagTransition{unflag(1)}, at(23)))
+	// intervals get clipped to the end, e.g. issues resolved while flagged
+	assert.Equal(t, int64(120), getBlockedMinutes([]*flagTransition{flag(1), unflag(5)}, at(3)))
+	assert.Equal(t, int64(60), getBlockedMinutes([]*flagTransition{flag(1), unflag(2), flag(4), unflag(6)}, at(3)))
+	assert.Equal(t, int64(0), getBlockedMinutes([]*flagTransition{flag(4)}, at(3)))
+}
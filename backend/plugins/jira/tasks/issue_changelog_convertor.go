@@ -58,6 +58,10 @@ func ConvertIssueChangelogs(taskCtx plugin.SubTaskContext) errors.Error {
 	boardId := data.Options.BoardId
 	logger := taskCtx.GetLogger()
 	db := taskCtx.GetDal()
+	if !keepsChangelogItems(data) {
+		logger.Info("changelog items are not kept in the transitions changelog mode, skipping")
+		return nil
+	}
 	logger.Info("covert changelog")
 	var allStatus []models.JiraStatus
 	err := db.All(&allStatus, dal.Where("connection_id = ?", connectionId))
@@ -81,6 +85,7 @@ func ConvertIssueChangelogs(taskCtx plugin.SubTaskContext) errors.Error {
 			AND _tool_jira_board_issues.issue_id = _tool_jira_issue_changelogs.issue_id
 		)`),
 		dal.Where("_tool_jira_issue_changelog_items.connection_id = ? AND _tool_jira_board_issues.board_id = ?", connectionId, boardId),
+		securityLevelFilter("_tool_jira_issue_changelogs.connection_id", "_tool_jira_issue_changelogs.issue_id"),
 	}
 	cursor, err := db.Cursor(clauses...)
 	if err != nil {
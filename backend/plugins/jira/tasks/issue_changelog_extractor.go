@@ -19,6 +19,8 @@ package tasks
 
 import (
 	"encoding/json"
+
+	"github.com/apache/incubator-devlake/core/dal"
 	"github.com/apache/incubator-devlake/core/errors"
 	"github.com/apache/incubator-devlake/core/plugin"
 	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
@@ -42,15 +44,55 @@ func ExtractIssueChangelogs(taskCtx plugin.SubTaskContext) errors.Error {
 		return nil
 	}
 	connectionId := data.Options.ConnectionId
+	logger := taskCtx.GetLogger()
+	params := JiraApiParams{
+		ConnectionId: data.Options.ConnectionId,
+		BoardId:      data.Options.BoardId,
+	}
+	var dedup *changelogDeduplicator
+	if data.Options.ScopeConfig != nil && data.Options.ScopeConfig.DedupChangelogItems {
+		// every changelog gets observed upfront so the earliest one keeps the repeated items
+		dedup = newChangelogDeduplicator()
+		err := observeChangelogItems(taskCtx.GetDal(), connectionId, params, dedup)
+		if err != nil {
+			return err
+		}
+	}
+	statusMappings, projectStatusMappings := getStatusMappings(data)
+	transitions, err := newStatusTransitionMapper(taskCtx.GetDal(), data, statusMappings, projectStatusMappings)
+	if err != nil {
+		return err
+	}
+	// status mappings depend on the type and project of issues
+	issueTypes := make(map[uint64]string)
+	issueProjectKeys := make(map[uint64]string)
+	if transitions != nil {
+		var issues []models.JiraIssue
+		err = taskCtx.GetDal().All(&issues,
+			dal.Select("_tool_jira_issues.issue_id, _tool_jira_issues.issue_key, _tool_jira_issues.type"),
+			dal.From(&models.JiraIssue{}),
+			dal.Join(`left join _tool_jira_board_issues on (
+				_tool_jira_board_issues.connection_id = _tool_jira_issues.connection_id
+				AND _tool_jira_board_issues.issue_id = _tool_jira_issues.issue_id
+			)`),
+			dal.Where("_tool_jira_issues.connection_id = ? AND _tool_jira_board_issues.board_id = ?", connectionId, data.Options.BoardId),
+		)
+		if err != nil {
+			return err
+		}
+		for _, issue := range issues {
+			issueTypes[issue.IssueId] = issue.Type
+			issueProjectKeys[issue.IssueId] = getProjectKey(issue.IssueKey)
+		}
+	}
 	extractor, err := api.NewApiExtractor(api.ApiExtractorArgs{
 		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
-			Ctx: taskCtx,
-			Params: JiraApiParams{
-				ConnectionId: data.Options.ConnectionId,
-				BoardId:      data.Options.BoardId,
-			},
-			Table: RAW_CHANGELOG_TABLE,
+			Ctx:    taskCtx,
+			Params: params,
+			Table:  RAW_CHANGELOG_TABLE,
 		},
+		// Extract only reads shared state, EXTRACTOR_CONCURRENCY overrides the default
+		Concurrency: 4,
 		Extract: func(row *api.RawData) ([]interface{}, errors.Error) {
 			// process input
 			var input apiv2models.Input
@@ -75,7 +117,21 @@ func ExtractIssueChangelogs(taskCtx plugin.SubTaskContext) errors.Error {
 			}
 			// collect changelog_items
 			for _, item := range changelog.Items {
-				result = append(result, item.ToToolLayer(connectionId, changelog.ID))
+				changelogItem := item.ToToolLayer(connectionId, changelog.ID)
+				if dedup != nil && !dedup.keep(input.IssueId, cl.Created, changelogItem) {
+					continue
+				}
+				if transitions != nil {
+					if transition := transitions.toTransition(issueProjectKeys[input.IssueId], issueTypes[input.IssueId], cl, changelogItem); transition != nil {
+						result = append(result, transition)
+					}
+				}
+				if history := toEstimateHistory(cl, changelogItem); history != nil {
+					result = append(result, history)
+				}
+				if transitions == nil || transitions.keepItems {
+					result = append(result, changelogItem)
+				}
 				for _, u := range item.ExtractUser(connectionId) {
 					if u != nil && u.AccountId != "" {
 						result = append(result, u)
@@ -90,5 +146,46 @@ func ExtractIssueChangelogs(taskCtx plugin.SubTaskContext) errors.Error {
 		return err
 	}
 
-	return extractor.Execute()
+	err = extractor.Execute()
+	if err != nil {
+		return err
+	}
+	if dedup != nil && dedup.collapsedCount() > 0 {
+		logger.Info("collapsed %d duplicate changelog items", dedup.collapsedCount())
+	}
+	return nil
+}
+
+// observeChangelogItems feeds the deduplicator with the items of every changelog collected for the board
+func observeChangelogItems(db dal.Dal, connectionId uint64, params JiraApiParams, dedup *changelogDeduplicator) errors.Error {
+	rawTable := "_raw_" + RAW_CHANGELOG_TABLE
+	if !db.HasTable(rawTable) {
+		return nil
+	}
+	cursor, err := db.Cursor(dal.From(rawTable), dal.Where("params = ?", plugin.MarshalScopeParams(params)))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+	for cursor.Next() {
+		var row api.RawData
+		err = db.Fetch(cursor, &row)
+		if err != nil {
+			return err
+		}
+		var input apiv2models.Input
+		err = errors.Convert(json.Unmarshal(row.Input, &input))
+		if err != nil {
+			return err
+		}
+		var changelog apiv2models.Changelog
+		err = errors.Convert(json.Unmarshal(row.Data, &changelog))
+		if err != nil {
+			return err
+		}
+		for _, item := range changelog.Items {
+			dedup.observe(input.IssueId, changelog.Created.ToTime(), item.ToToolLayer(connectionId, changelog.ID))
+		}
+	}
+	return nil
 }
@@ -25,6 +25,9 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/apache/incubator-devlake/core/errors"
@@ -40,6 +43,7 @@ var CollectIssuesMeta = plugin.SubTaskMeta{
 	Name:             "collectIssues",
 	EntryPoint:       CollectIssues,
 	EnabledByDefault: true,
+	RunsWhenStaged:   true,
 	Description:      "collect Jira issues, supports both timeFilter and diffSync.",
 	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET, plugin.DOMAIN_TYPE_CROSS},
 }
@@ -76,12 +80,35 @@ func CollectIssues(taskCtx plugin.SubTaskContext) errors.Error {
 	} else {
 		logger.Info("got user's timezone: %v", loc.String())
 	}
-	jql := buildJQL(data.TimeAfter, collectorWithState.LatestState.LatestSuccessStart, incremental, loc)
+	var statusFilter string
+	if data.Options.ScopeConfig != nil {
+		statusFilter = buildStatusFilterJQL(data.Options.ScopeConfig.CollectStatuses, data.Options.ScopeConfig.CollectStatusCategories)
+	}
+	jql := buildJQL(data.TimeAfter, collectorWithState.LatestState.LatestSuccessStart, incremental, loc, statusFilter)
+
+	// a collection stopped by the request budget is completed before starting a new one, keeping what it collected
+	db := taskCtx.GetDal()
+	cursor := &models.JiraIssueCollectorCursor{}
+	err = db.First(cursor, dal.Where("connection_id = ? AND board_id = ?", data.Options.ConnectionId, data.Options.BoardId))
+	if err != nil && !db.IsErrorNotFound(err) {
+		return err
+	}
+	// collect and diff runs neither resume nor leave a cursor behind, they must not affect the production collection
+	staged := data.IsRawDataStaged()
+	resuming := err == nil && !staged
+	if resuming {
+		logger.Info("resuming the issue collection started at %v from issues created since %v", cursor.ExecuteStart, cursor.CreatedSince)
+		collectorWithState.ExecuteStart = cursor.ExecuteStart
+		jql = buildResumeJQL(jql, cursor.CreatedSince, loc)
+	}
+	// the issues are sorted by creation, the last one of each page tells how far the collection went
+	var lastIssuesMutex sync.Mutex
+	var lastIssues []json.RawMessage
 
 	err = collectorWithState.InitCollector(api.ApiCollectorArgs{
 		ApiClient:   data.ApiClient,
 		PageSize:    data.Options.PageSize,
-		Incremental: incremental,
+		Incremental: incremental || resuming,
 		/*
 			url may use arbitrary variables from different connection in any order, we need GoTemplate to allow more
 			flexible for all kinds of possibility.
@@ -134,6 +161,11 @@ func CollectIssues(taskCtx plugin.SubTaskContext) errors.Error {
 			if err != nil {
 				return nil, errors.Convert(err)
 			}
+			if len(data.Issues) > 0 {
+				lastIssuesMutex.Lock()
+				lastIssues = append(lastIssues, data.Issues[len(data.Issues)-1])
+				lastIssuesMutex.Unlock()
+			}
 			return data.Issues, nil
 		},
 	})
@@ -141,11 +173,58 @@ func CollectIssues(taskCtx plugin.SubTaskContext) errors.Error {
 		return err
 	}
 
-	return collectorWithState.Execute()
+	err = collectorWithState.Execute()
+	if err != nil {
+		return err
+	}
+	if staged {
+		return nil
+	}
+	if !data.ApiClient.IsBudgetExhausted() {
+		if resuming {
+			return db.Delete(cursor)
+		}
+		return nil
+	}
+	createdSince, err := getLatestCreated(lastIssues)
+	if err != nil {
+		return err
+	}
+	if createdSince == nil {
+		// nothing was collected, the next run starts where this one would have
+		return nil
+	}
+	logger.Info("request budget is exhausted, the next issue collection resumes from issues created since %v", *createdSince)
+	cursor.ConnectionId = data.Options.ConnectionId
+	cursor.BoardId = data.Options.BoardId
+	cursor.CreatedSince = *createdSince
+	cursor.ExecuteStart = collectorWithState.ExecuteStart
+	return db.CreateOrUpdate(cursor)
 }
 
-// buildJQL build jql based on timeAfter and incremental mode
-func buildJQL(timeAfter, latestSuccessStart *time.Time, isIncremental bool, location *time.Location) string {
+// getLatestCreated returns the latest creation time of the issues, nil if there is none
+func getLatestCreated(issues []json.RawMessage) (*time.Time, errors.Error) {
+	var latest *time.Time
+	for _, raw := range issues {
+		var issue struct {
+			Fields struct {
+				Created *api.Iso8601Time `json:"created"`
+			} `json:"fields"`
+		}
+		err := errors.Convert(json.Unmarshal(raw, &issue))
+		if err != nil {
+			return nil, err
+		}
+		created := issue.Fields.Created.ToNullableTime()
+		if created != nil && (latest == nil || created.After(*latest)) {
+			latest = created
+		}
+	}
+	return latest, nil
+}
+
+// buildJQL build jql based on timeAfter, incremental mode and the optional status filter
+func buildJQL(timeAfter, latestSuccessStart *time.Time, isIncremental bool, location *time.Location, statusFilter string) string {
 	jql := "ORDER BY created ASC"
 	var moment time.Time
 	if timeAfter != nil {
@@ -163,9 +242,62 @@ func buildJQL(timeAfter, latestSuccessStart *time.Time, isIncremental bool, loca
 		}
 		jql = fmt.Sprintf("updated >= '%s' %s", moment.Format("2006/01/02 15:04"), jql)
 	}
+	if statusFilter != "" {
+		// issues transitioned out of the filter since the last run must be collected once more to get closed out
+		if isIncremental && !moment.IsZero() {
+			statusFilter = fmt.Sprintf("(%s OR status CHANGED AFTER '%s')", statusFilter, moment.Format("2006/01/02 15:04"))
+		}
+		jql = prependJQLClause(statusFilter, jql)
+	}
 	return jql
 }
 
+// buildResumeJQL restricts the jql to issues created since createdSince, issues created within the same minute
+// are collected again since jql dates have no seconds
+func buildResumeJQL(jql string, createdSince time.Time, location *time.Location) string {
+	if location != nil {
+		createdSince = createdSince.In(location)
+	} else {
+		createdSince = createdSince.In(time.UTC).Add(-24 * time.Hour)
+	}
+	return prependJQLClause(fmt.Sprintf("created >= '%s'", createdSince.Format("2006/01/02 15:04")), jql)
+}
+
+func prependJQLClause(clause, jql string) string {
+	if strings.HasPrefix(jql, "ORDER BY") {
+		return fmt.Sprintf("%s %s", clause, jql)
+	}
+	return fmt.Sprintf("%s AND %s", clause, jql)
+}
+
+// buildStatusFilterJQL returns the jql clause matching issues in any of the statuses or status categories, or an
+// empty string if both are empty
+func buildStatusFilterJQL(statuses, statusCategories []string) string {
+	var clauses []string
+	if len(statuses) > 0 {
+		clauses = append(clauses, fmt.Sprintf("status in (%s)", quoteJQLValues(statuses)))
+	}
+	if len(statusCategories) > 0 {
+		clauses = append(clauses, fmt.Sprintf("statusCategory in (%s)", quoteJQLValues(statusCategories)))
+	}
+	switch len(clauses) {
+	case 0:
+		return ""
+	case 1:
+		return clauses[0]
+	default:
+		return fmt.Sprintf("(%s)", strings.Join(clauses, " OR "))
+	}
+}
+
+func quoteJQLValues(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = strconv.Quote(v)
+	}
+	return strings.Join(quoted, ", ")
+}
+
 // getTimeZone get user's timezone from jira API
 func getTimeZone(taskCtx plugin.SubTaskContext) (*time.Location, errors.Error) {
 	data := taskCtx.GetData().(*JiraTaskData)
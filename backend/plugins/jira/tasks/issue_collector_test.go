@@ -18,8 +18,11 @@ limitations under the License.
 package tasks
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func Test_buildJQL(t *testing.T) {
@@ -33,6 +36,7 @@ func Test_buildJQL(t *testing.T) {
 		latestSuccessStart *time.Time
 		isIncremental      bool
 		location           *time.Location
+		statusFilter       string
 	}
 	tests := []struct {
 		name string
@@ -95,13 +99,67 @@ func Test_buildJQL(t *testing.T) {
 			},
 			want: "updated >= '2021/02/02 04:05' ORDER BY created ASC",
 		},
+		{
+			name: "test status filter",
+			args: args{
+				statusFilter: `status in ("To Do")`,
+			},
+			want: `status in ("To Do") ORDER BY created ASC`,
+		},
+		{
+			name: "test status filter with time after",
+			args: args{
+				timeAfter:    &timeAfter,
+				statusFilter: `status in ("To Do")`,
+			},
+			want: `status in ("To Do") AND updated >= '2021/02/02 04:05' ORDER BY created ASC`,
+		},
+		{
+			name: "test incremental status filter",
+			args: args{
+				timeAfter:          &timeAfter,
+				latestSuccessStart: &add48,
+				isIncremental:      true,
+				statusFilter:       `status in ("To Do")`,
+			},
+			want: `(status in ("To Do") OR status CHANGED AFTER '2021/02/04 04:05') AND updated >= '2021/02/04 04:05' ORDER BY created ASC`,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := buildJQL(tt.args.timeAfter, tt.args.latestSuccessStart, tt.args.isIncremental, tt.args.location); got != tt.want {
+			if got := buildJQL(tt.args.timeAfter, tt.args.latestSuccessStart, tt.args.isIncremental, tt.args.location, tt.args.statusFilter); got != tt.want {
 				t.Errorf("buildJQL() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
+
+func Test_buildStatusFilterJQL(t *testing.T) {
+	assert.Equal(t, "", buildStatusFilterJQL(nil, nil))
+	assert.Equal(t, `status in ("To Do", "In \"Review\"")`, buildStatusFilterJQL([]string{"To Do", `In "Review"`}, nil))
+	assert.Equal(t, `statusCategory in ("In Progress")`, buildStatusFilterJQL(nil, []string{"In Progress"}))
+	assert.Equal(t, `(status in ("Blocked") OR statusCategory in ("To Do", "In Progress"))`,
+		buildStatusFilterJQL([]string{"Blocked"}, []string{"To Do", "In Progress"}))
+}
+
+func Test_buildResumeJQL(t *testing.T) {
+	createdSince := time.Date(2021, 2, 3, 4, 5, 6, 7, time.UTC)
+	loc, _ := time.LoadLocation("Asia/Shanghai")
+	assert.Equal(t, "created >= '2021/02/03 12:05' ORDER BY created ASC", buildResumeJQL("ORDER BY created ASC", createdSince, loc))
+	assert.Equal(t, "created >= '2021/02/02 04:05' AND updated >= '2021/02/01 04:05' ORDER BY created ASC",
+		buildResumeJQL("updated >= '2021/02/01 04:05' ORDER BY created ASC", createdSince, nil))
+}
+
+func Test_getLatestCreated(t *testing.T) {
+	latest, err := getLatestCreated(nil)
+	assert.Nil(t, err)
+	assert.Nil(t, latest)
+	latest, err = getLatestCreated([]json.RawMessage{
+		json.RawMessage(`{"fields":{"created":"2021-02-03T04:05:06.000+0000"}}`),
+		json.RawMessage(`{"fields":{"created":"2021-02-05T04:05:06.000+0000"}}`),
+		json.RawMessage(`{"fields":{}}`),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2021, 2, 5, 4, 5, 6, 0, time.UTC), latest.UTC())
+}
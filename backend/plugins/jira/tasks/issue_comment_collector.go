@@ -41,6 +41,7 @@ var CollectIssueCommentsMeta = plugin.SubTaskMeta{
 	Name:             "collectIssueComments",
 	EntryPoint:       CollectIssueComments,
 	EnabledByDefault: false,
+	RunsWhenStaged:   true,
 	Description:      "collect Jira issue comments, supports both timeFilter and diffSync.",
 	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET, plugin.DOMAIN_TYPE_CROSS},
 }
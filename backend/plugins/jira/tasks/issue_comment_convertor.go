@@ -54,6 +54,7 @@ func ConvertIssueComments(taskCtx plugin.SubTaskContext) errors.Error {
 			AND jbi.issue_id = jic.issue_id
 		)`),
 		dal.Where("jbi.connection_id = ? AND jbi.board_id = ?", connectionId, boardId),
+		securityLevelFilter("jic.connection_id", "jic.issue_id"),
 		dal.Orderby("jbi.connection_id, jbi.issue_id"),
 	}
 	cursor, err := db.Cursor(clauses...)
@@ -87,6 +88,7 @@ func ConvertIssueComments(taskCtx plugin.SubTaskContext) errors.Error {
 				Body:        issueComment.Body,
 				AccountId:   accountIdGen.Generate(data.Options.ConnectionId, issueComment.CreatorAccountId),
 				CreatedDate: issueComment.Created,
+				IsEdited:    &issueComment.Edited,
 			}
 			if !issueComment.Updated.IsZero() {
 				domainIssueComment.UpdatedDate = &issueComment.Updated
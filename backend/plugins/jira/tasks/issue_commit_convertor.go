@@ -52,6 +52,7 @@ func ConvertIssueCommits(taskCtx plugin.SubTaskContext) errors.Error {
 			AND jbi.issue_id = jic.issue_id
 		)`),
 		dal.Where("jbi.connection_id = ? AND jbi.board_id = ?", connectionId, boardId),
+		securityLevelFilter("jic.connection_id", "jic.issue_id"),
 		dal.Orderby("jbi.connection_id, jbi.issue_id"),
 	}
 	cursor, err := db.Cursor(clauses...)
@@ -21,7 +21,9 @@ import (
 	"net/url"
 	"path/filepath"
 	"reflect"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/apache/incubator-devlake/core/dal"
 	"github.com/apache/incubator-devlake/core/errors"
@@ -45,6 +47,60 @@ func ConvertIssues(taskCtx plugin.SubTaskContext) errors.Error {
 	db := taskCtx.GetDal()
 	data := taskCtx.GetData().(*JiraTaskData)
 
+	var discrepancyMinutes int
+	doneDateStrategy := getDoneDateStrategy(data.Options.ScopeConfig)
+	var combinedStatusTemplate string
+	if data.Options.ScopeConfig != nil {
+		discrepancyMinutes = data.Options.ScopeConfig.ResolutionDateDiscrepancyMinutes
+		combinedStatusTemplate = data.Options.ScopeConfig.CombinedStatusTemplate
+	}
+	// reassignments and blocked time are built on changelog items the transitions-only mode doesn't keep
+	var reassignments map[uint64]*reassignment
+	if keepsChangelogItems(data) {
+		var err errors.Error
+		reassignments, err = loadReassignments(db, data.Options.ConnectionId, data.Options.BoardId)
+		if err != nil {
+			return err
+		}
+	}
+	triages, err := loadTriages(db, data)
+	if err != nil {
+		return err
+	}
+	reworks, err := loadReworks(db, data)
+	if err != nil {
+		return err
+	}
+	var doneTransitions map[uint64]*doneTransition
+	if discrepancyMinutes > 0 || doneDateStrategy == models.DoneDateStrategyFirstDone || doneDateStrategy == models.DoneDateStrategyLastDone {
+		var err errors.Error
+		doneTransitions, err = loadDoneTransitions(db, data)
+		if err != nil {
+			return err
+		}
+	}
+
+	var issueLabels map[uint64][]string
+	if data.Options.ScopeConfig != nil && data.Options.ScopeConfig.DenormalizeIssueFacets {
+		var err errors.Error
+		issueLabels, err = loadIssueLabels(db, data.Options.ConnectionId, data.Options.BoardId)
+		if err != nil {
+			return err
+		}
+	}
+
+	var flagTransitions map[uint64][]*flagTransition
+	var excludeBlockedTime bool
+	if data.Options.ScopeConfig != nil && data.Options.ScopeConfig.BlockedTimeField != "" && keepsChangelogItems(data) {
+		var err errors.Error
+		flagTransitions, err = loadFlagTransitions(db, data.Options.ConnectionId, data.Options.BoardId, data.Options.ScopeConfig.BlockedTimeField)
+		if err != nil {
+			return err
+		}
+		excludeBlockedTime = data.Options.ScopeConfig.ExcludeBlockedTimeFromLeadTime
+	}
+	now := time.Now()
+
 	jiraIssue := &models.JiraIssue{}
 	// select all issues belongs to the board
 	clauses := []dal.Clause{
@@ -54,11 +110,26 @@ func ConvertIssues(taskCtx plugin.SubTaskContext) errors.Error {
 			on _tool_jira_board_issues.issue_id = _tool_jira_issues.issue_id
 			and _tool_jira_board_issues.connection_id = _tool_jira_issues.connection_id`),
 		dal.Where(
-			"_tool_jira_board_issues.connection_id = ? AND _tool_jira_board_issues.board_id = ?",
+			"_tool_jira_board_issues.connection_id = ? AND _tool_jira_board_issues.board_id = ? AND _tool_jira_issues.security_excluded = ?",
 			data.Options.ConnectionId,
 			data.Options.BoardId,
+			false,
 		),
 	}
+	rawDataSubTaskArgs := api.RawDataSubTaskArgs{
+		Ctx: taskCtx,
+		Params: JiraApiParams{
+			ConnectionId: data.Options.ConnectionId,
+			BoardId:      data.Options.BoardId,
+		},
+		Table: RAW_ISSUE_TABLE,
+	}
+	// boards with a huge number of issues resume from the last saved batch after a failure
+	checkpoint, err := api.NewConvertorCheckpoint(rawDataSubTaskArgs, "_tool_jira_issues.issue_id", "IssueId")
+	if err != nil {
+		return err
+	}
+	clauses = append(clauses, checkpoint.Clauses()...)
 	cursor, err := db.Cursor(clauses...)
 	if err != nil {
 		return err
@@ -68,19 +139,14 @@ func ConvertIssues(taskCtx plugin.SubTaskContext) errors.Error {
 	issueIdGen := didgen.NewDomainIdGenerator(&models.JiraIssue{})
 	accountIdGen := didgen.NewDomainIdGenerator(&models.JiraAccount{})
 	boardIdGen := didgen.NewDomainIdGenerator(&models.JiraBoard{})
+	projectIdGen := didgen.NewDomainIdGenerator(&models.JiraProject{})
 	boardId := boardIdGen.Generate(data.Options.ConnectionId, data.Options.BoardId)
 
 	converter, err := api.NewDataConverter(api.DataConverterArgs{
-		InputRowType: reflect.TypeOf(models.JiraIssue{}),
-		Input:        cursor,
-		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
-			Ctx: taskCtx,
-			Params: JiraApiParams{
-				ConnectionId: data.Options.ConnectionId,
-				BoardId:      data.Options.BoardId,
-			},
-			Table: RAW_ISSUE_TABLE,
-		},
+		InputRowType:       reflect.TypeOf(models.JiraIssue{}),
+		Input:              cursor,
+		RawDataSubTaskArgs: rawDataSubTaskArgs,
+		Checkpoint:         checkpoint,
 		Convert: func(inputRow interface{}) ([]interface{}, errors.Error) {
 			jiraIssue := inputRow.(*models.JiraIssue)
 			issue := &ticket.Issue{
@@ -106,6 +172,14 @@ func ConvertIssues(taskCtx plugin.SubTaskContext) errors.Error {
 				LeadTimeMinutes:         int64(jiraIssue.LeadTimeMinutes),
 				TimeSpentMinutes:        jiraIssue.SpentMinutes,
 				OriginalProject:         jiraIssue.ProjectName,
+				AcceptanceCriteria:      jiraIssue.AcceptanceCriteria,
+				HasAcceptanceCriteria:   jiraIssue.AcceptanceCriteria != "",
+				StartDate:               jiraIssue.StartDate,
+				CommentCount:            int(jiraIssue.CommentTotal),
+				LastCommentedDate:       jiraIssue.LastCommentedDate,
+				CommentsTruncated:       jiraIssue.CommentsTruncated,
+				HierarchyLevel:          jiraIssue.HierarchyLevel,
+				Categories:              jiraIssue.Categories,
 			}
 			if jiraIssue.CreatorAccountId != "" {
 				issue.CreatorId = accountIdGen.Generate(data.Options.ConnectionId, jiraIssue.CreatorAccountId)
@@ -126,9 +200,67 @@ func ConvertIssues(taskCtx plugin.SubTaskContext) errors.Error {
 			if jiraIssue.AssigneeDisplayName != "" {
 				issue.AssigneeName = jiraIssue.AssigneeDisplayName
 			}
+			if t, ok := doneTransitions[jiraIssue.IssueId]; ok {
+				issue.ResolutionDateMismatch = isResolutionDateMismatch(jiraIssue.ResolutionDate, t.LastDone, discrepancyMinutes)
+				if jiraIssue.StdStatus == ticket.DONE {
+					issue.ResolutionDate = getDoneDate(doneDateStrategy, jiraIssue.ResolutionDate, t)
+					if issue.ResolutionDate != nil {
+						issue.LeadTimeMinutes = int64(issue.ResolutionDate.Sub(jiraIssue.Created).Minutes())
+					}
+				}
+			}
+			if flagTransitions != nil {
+				// resolved issues stop being blocked when they get resolved
+				end := now
+				if issue.Status == ticket.DONE && issue.ResolutionDate != nil && issue.ResolutionDate.Before(end) {
+					end = *issue.ResolutionDate
+				}
+				issue.BlockedMinutes = getBlockedMinutes(flagTransitions[jiraIssue.IssueId], end)
+				if excludeBlockedTime && issue.LeadTimeMinutes > 0 {
+					issue.LeadTimeMinutes -= issue.BlockedMinutes
+					if issue.LeadTimeMinutes < 0 {
+						issue.LeadTimeMinutes = 0
+					}
+				}
+			}
+			teamId := jiraIssue.TeamId
+			if teamId == "" {
+				teamId = getComponentTeam(jiraIssue.Components, data.Options.ScopeConfig)
+			}
+			// issues no team matches are left without team rather than an empty one
+			if teamId != "" {
+				issue.TeamId = &teamId
+			}
+			if issueLabels != nil {
+				issue.Facets = getIssueFacets(issueLabels[jiraIssue.IssueId], jiraIssue.Components, data.Options.ScopeConfig.LabelHierarchySeparator)
+			}
+			if jiraIssue.ProjectId != 0 {
+				issue.ProjectId = projectIdGen.Generate(data.Options.ConnectionId, strconv.FormatUint(jiraIssue.ProjectId, 10))
+			}
 			if jiraIssue.ParentId != 0 {
 				issue.ParentIssueId = issueIdGen.Generate(data.Options.ConnectionId, jiraIssue.ParentId)
 			}
+			if r, ok := reassignments[jiraIssue.IssueId]; ok {
+				issue.ReassignmentCount = r.Count
+				issue.ReassignmentCountIsMinimum = r.Minimum
+			}
+			reworkCount, reworkCountIsMinimum := 0, false
+			if r, ok := reworks[jiraIssue.IssueId]; ok {
+				reworkCount, reworkCountIsMinimum = r.Count, r.Minimum
+			}
+			issue.ReworkCount = &reworkCount
+			issue.ReworkCountIsMinimum = &reworkCountIsMinimum
+			if t, ok := triages[jiraIssue.IssueId]; ok {
+				triageMinutes := getTriageMinutes(jiraIssue.Created, t)
+				issue.TriageMinutes = &triageMinutes
+				issue.TriageMinutesIsProvisional = t.Provisional
+			}
+			if combinedStatusTemplate != "" {
+				issue.CombinedStatus = renderCombinedStatus(combinedStatusTemplate, jiraIssue.StatusName, jiraIssue.StdStatus, jiraIssue.ResolutionName)
+			}
+			if jiraIssue.StdResolution != "" {
+				issue.StdResolution = &jiraIssue.StdResolution
+			}
 			result = append(result, issue)
 			boardIssue := &ticket.BoardIssue{
 				BoardId: boardId,
@@ -154,3 +286,15 @@ func convertURL(api, issueKey string) string {
 	u.Path = filepath.Join(before, "browse", issueKey)
 	return u.String()
 }
+
+// renderCombinedStatus fills the placeholders of the template, issues without resolution get their status only
+func renderCombinedStatus(template, status, stdStatus, resolution string) string {
+	if resolution == "" {
+		return status
+	}
+	return strings.NewReplacer(
+		"{status}", status,
+		"{stdStatus}", stdStatus,
+		"{resolution}", resolution,
+	).Replace(template)
+}
@@ -58,3 +58,23 @@ func Test_convertURL(t *testing.T) {
 		})
 	}
 }
+
+func Test_renderCombinedStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		template   string
+		resolution string
+		want       string
+	}{
+		{"resolved", "{status} ({resolution})", "Won't Do", "Done (Won't Do)"},
+		{"unresolved", "{status} ({resolution})", "", "Done"},
+		{"std status", "{stdStatus}: {resolution}", "Completed", "DONE: Completed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := renderCombinedStatus(tt.template, "Done", "DONE", tt.resolution); got != tt.want {
+				t.Errorf("renderCombinedStatus() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
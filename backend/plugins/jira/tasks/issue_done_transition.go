@@ -0,0 +1,110 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"time"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+// doneTransition holds the first and the last time an issue was moved into a `done` status
+type doneTransition struct {
+	IssueId   uint64
+	FirstDone *time.Time
+	LastDone  *time.Time
+}
+
+// loadDoneTransitions returns the done-transitions of all issues belonging to the board, derived from the
+// extracted changelogs, issues without any done-transition are absent from the result. The compact transitions
+// count the ones to the standard DONE status instead of the done status category
+func loadDoneTransitions(db dal.Dal, data *JiraTaskData) (map[uint64]*doneTransition, errors.Error) {
+	connectionId, boardId := data.Options.ConnectionId, data.Options.BoardId
+	var transitions []*doneTransition
+	var err errors.Error
+	if readsStatusTransitions(data) {
+		err = db.All(&transitions,
+			dal.Select("t.issue_id, MIN(t.created) AS first_done, MAX(t.created) AS last_done"),
+			dal.From("_tool_jira_issue_status_transitions t"),
+			dal.Join(`JOIN _tool_jira_board_issues bi ON (bi.connection_id = t.connection_id AND bi.issue_id = t.issue_id)`),
+			dal.Where("t.connection_id = ? AND bi.board_id = ? AND t.to_std_status = ?", connectionId, boardId, ticket.DONE),
+			dal.Groupby("t.issue_id"),
+		)
+	} else {
+		err = db.All(&transitions,
+			dal.Select("c.issue_id, MIN(c.created) AS first_done, MAX(c.created) AS last_done"),
+			dal.From("_tool_jira_issue_changelog_items i"),
+			dal.Join(`JOIN _tool_jira_issue_changelogs c ON (c.connection_id = i.connection_id AND c.changelog_id = i.changelog_id)`),
+			dal.Join(`JOIN _tool_jira_board_issues bi ON (bi.connection_id = c.connection_id AND bi.issue_id = c.issue_id)`),
+			dal.Join(`JOIN _tool_jira_statuses s ON (s.connection_id = i.connection_id AND s.id = i.to_value)`),
+			dal.Where("i.connection_id = ? AND bi.board_id = ? AND i.field = 'status' AND s.status_category = 'done'", connectionId, boardId),
+			dal.Groupby("c.issue_id"),
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[uint64]*doneTransition, len(transitions))
+	for _, t := range transitions {
+		result[t.IssueId] = t
+	}
+	return result, nil
+}
+
+// getDoneDateStrategy returns the done date strategy of the scope config, or the default one, so that every
+// convertor dates done issues the same way
+func getDoneDateStrategy(scopeConfig *models.JiraScopeConfig) string {
+	if scopeConfig == nil || scopeConfig.DoneDateStrategy == "" {
+		return models.DefaultDoneDateStrategy
+	}
+	return scopeConfig.DoneDateStrategy
+}
+
+// getDoneDate returns the date an issue is considered done at according to the strategy, falling back to the
+// resolution date when the issue has no done-transition
+func getDoneDate(strategy string, resolutionDate *time.Time, transition *doneTransition) *time.Time {
+	if transition != nil {
+		switch strategy {
+		case models.DoneDateStrategyFirstDone:
+			if transition.FirstDone != nil {
+				return transition.FirstDone
+			}
+		case models.DoneDateStrategyLastDone:
+			if transition.LastDone != nil {
+				return transition.LastDone
+			}
+		}
+	}
+	return resolutionDate
+}
+
+// isResolutionDateMismatch tells whether the resolution date is further than thresholdMinutes away from the
+// last done-transition, a non-positive threshold disables the check
+func isResolutionDateMismatch(resolutionDate, lastDone *time.Time, thresholdMinutes int) bool {
+	if thresholdMinutes <= 0 || resolutionDate == nil || lastDone == nil {
+		return false
+	}
+	gap := resolutionDate.Sub(*lastDone)
+	if gap < 0 {
+		gap = -gap
+	}
+	return gap > time.Duration(thresholdMinutes)*time.Minute
+}
@@ -0,0 +1,57 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_getDoneDate(t *testing.T) {
+	firstDone := time.Date(2023, 7, 1, 10, 0, 0, 0, time.UTC)
+	lastDone := time.Date(2023, 7, 5, 10, 0, 0, 0, time.UTC)
+	resolution := lastDone.Add(time.Minute)
+	transition := &doneTransition{FirstDone: &firstDone, LastDone: &lastDone}
+
+	assert.Equal(t, &resolution, getDoneDate("", &resolution, transition))
+	assert.Equal(t, &resolution, getDoneDate(models.DoneDateStrategyResolutionField, &resolution, transition))
+	assert.Equal(t, &firstDone, getDoneDate(models.DoneDateStrategyFirstDone, &resolution, transition))
+	assert.Equal(t, &lastDone, getDoneDate(models.DoneDateStrategyLastDone, &resolution, transition))
+	assert.Equal(t, &resolution, getDoneDate(models.DoneDateStrategyFirstDone, &resolution, nil))
+}
+
+func Test_getDoneDateStrategy(t *testing.T) {
+	assert.Equal(t, models.DoneDateStrategyResolutionField, getDoneDateStrategy(nil))
+	assert.Equal(t, models.DoneDateStrategyResolutionField, getDoneDateStrategy(&models.JiraScopeConfig{}))
+	assert.Equal(t, models.DoneDateStrategyLastDone, getDoneDateStrategy(&models.JiraScopeConfig{DoneDateStrategy: models.DoneDateStrategyLastDone}))
+}
+
+func Test_isResolutionDateMismatch(t *testing.T) {
+	lastDone := time.Date(2023, 7, 1, 10, 0, 0, 0, time.UTC)
+	near := lastDone.Add(30 * time.Minute)
+	far := lastDone.Add(-72 * time.Hour)
+
+	assert.False(t, isResolutionDateMismatch(&far, &lastDone, 0), "disabled by default")
+	assert.False(t, isResolutionDateMismatch(nil, &lastDone, 60), "unresolved issue")
+	assert.False(t, isResolutionDateMismatch(&far, nil, 60), "no done-transition")
+	assert.False(t, isResolutionDateMismatch(&near, &lastDone, 60))
+	assert.True(t, isResolutionDateMismatch(&far, &lastDone, 60))
+}
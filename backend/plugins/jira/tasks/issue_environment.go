@@ -0,0 +1,99 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+// environmentParser is the compiled EnvironmentParser of the scope config
+type environmentParser struct {
+	pattern           *regexp.Regexp
+	pairSeparator     string
+	keyValueSeparator string
+}
+
+func newEnvironmentParser(config *models.EnvironmentParser) (*environmentParser, errors.Error) {
+	parser := &environmentParser{
+		pairSeparator:     config.PairSeparator,
+		keyValueSeparator: config.KeyValueSeparator,
+	}
+	if parser.pairSeparator == "" {
+		parser.pairSeparator = "\n"
+	}
+	if parser.keyValueSeparator == "" {
+		parser.keyValueSeparator = ":"
+	}
+	if config.Pattern != "" {
+		pattern, err := regexp.Compile(config.Pattern)
+		if err != nil {
+			return nil, errors.BadInput.Wrap(err, "invalid environmentParser pattern")
+		}
+		parser.pattern = pattern
+	}
+	return parser, nil
+}
+
+// parse returns the fields found in the environment by their lowercased name, fields repeated keep their last value
+func (p *environmentParser) parse(environment string) map[string]string {
+	fields := make(map[string]string)
+	if p.pattern != nil {
+		match := p.pattern.FindStringSubmatch(environment)
+		for i, name := range p.pattern.SubexpNames() {
+			if match != nil && name != "" && strings.TrimSpace(match[i]) != "" {
+				fields[strings.ToLower(name)] = strings.TrimSpace(match[i])
+			}
+		}
+		return fields
+	}
+	for _, pair := range strings.Split(environment, p.pairSeparator) {
+		key, value, ok := strings.Cut(pair, p.keyValueSeparator)
+		key, value = strings.ToLower(strings.TrimSpace(key)), strings.TrimSpace(value)
+		if ok && key != "" && value != "" {
+			fields[key] = value
+		}
+	}
+	return fields
+}
+
+// extractIssueEnvironment returns the fields parsed out of the environment of an issue, or the environment as a
+// whole when none could be
+func extractIssueEnvironment(connectionId uint64, issueId uint64, environment string, parser *environmentParser) []interface{} {
+	environment = strings.TrimSpace(environment)
+	if environment == "" {
+		return nil
+	}
+	fields := parser.parse(environment)
+	if len(fields) == 0 {
+		fields[models.EnvironmentRawField] = environment
+	}
+	results := make([]interface{}, 0, len(fields))
+	for field, value := range fields {
+		results = append(results, &models.JiraIssueEnvironment{
+			ConnectionId: connectionId,
+			IssueId:      issueId,
+			Field:        field,
+			Value:        value,
+		})
+	}
+	return results
+}
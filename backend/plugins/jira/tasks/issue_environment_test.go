@@ -0,0 +1,51 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvironmentParser(t *testing.T) {
+	parser, err := newEnvironmentParser(&models.EnvironmentParser{})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"os": "Ubuntu 22.04", "browser": "Firefox 116"},
+		parser.parse("OS: Ubuntu 22.04\nBrowser : Firefox 116\nreproduced twice"))
+
+	parser, err = newEnvironmentParser(&models.EnvironmentParser{PairSeparator: ";", KeyValueSeparator: "="})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"os": "macOS", "version": "2.3"}, parser.parse("os=macOS; version=2.3"))
+
+	parser, err = newEnvironmentParser(&models.EnvironmentParser{Pattern: `(?P<Browser>Chrome|Firefox) (?P<version>[\d.]+)`})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"browser": "Chrome", "version": "115.0"}, parser.parse("seen on Chrome 115.0 only"))
+
+	assert.Nil(t, extractIssueEnvironment(1, 10, "  ", parser))
+	assert.Equal(t, []interface{}{&models.JiraIssueEnvironment{
+		ConnectionId: 1,
+		IssueId:      10,
+		Field:        models.EnvironmentRawField,
+		Value:        "any browser",
+	}}, extractIssueEnvironment(1, 10, "any browser", parser))
+
+	assert.NotNil(t, (&models.EnvironmentParser{Pattern: `Chrome \d+`}).Validate())
+	assert.NotNil(t, (&models.EnvironmentParser{Pattern: `(?P<os>`}).Validate())
+}
@@ -0,0 +1,72 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+// estimateFields maps the changelog fields of the time tracking to the estimate types, the changelogs name them
+// after their field ids rather than their display names, `Remaining Estimate` and `Original Estimate`
+var estimateFields = map[string]string{
+	"timeestimate":         models.EstimateTypeRemaining,
+	"timeoriginalestimate": models.EstimateTypeOriginal,
+}
+
+// toEstimateHistory returns the estimate change recorded by the changelog item, nil if it changed anything else
+func toEstimateHistory(changelog *models.JiraIssueChangelogs, item *models.JiraIssueChangelogItems) *models.JiraIssueEstimateHistory {
+	field := item.FieldId
+	if field == "" {
+		field = item.Field
+	}
+	estimateType, ok := estimateFields[field]
+	if !ok {
+		return nil
+	}
+	history := &models.JiraIssueEstimateHistory{
+		ConnectionId:    changelog.ConnectionId,
+		ChangelogId:     changelog.ChangelogId,
+		EstimateType:    estimateType,
+		IssueId:         changelog.IssueId,
+		Created:         changelog.Created,
+		FromSeconds:     parseEstimateSeconds(item.FromValue),
+		ToSeconds:       parseEstimateSeconds(item.ToValue),
+		AuthorAccountId: changelog.AuthorAccountId,
+	}
+	var from, to int64
+	if history.FromSeconds != nil {
+		from = *history.FromSeconds
+	}
+	if history.ToSeconds != nil {
+		to = *history.ToSeconds
+	}
+	history.Increased = to > from
+	return history
+}
+
+// parseEstimateSeconds parses the seconds of an estimate, nil for cleared estimates
+func parseEstimateSeconds(value string) *int64 {
+	seconds, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &seconds
+}
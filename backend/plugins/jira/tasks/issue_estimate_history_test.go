@@ -0,0 +1,63 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToEstimateHistory(t *testing.T) {
+	created := time.Date(2023, 8, 1, 10, 0, 0, 0, time.UTC)
+	changelog := &models.JiraIssueChangelogs{ConnectionId: 1, ChangelogId: 100, IssueId: 10, Created: created, AuthorAccountId: "abc"}
+
+	assert.Nil(t, toEstimateHistory(changelog, &models.JiraIssueChangelogItems{Field: "status", FieldId: "status"}))
+
+	history := toEstimateHistory(changelog, &models.JiraIssueChangelogItems{
+		Field:     "timeestimate",
+		FieldId:   "timeestimate",
+		FromValue: "3600",
+		ToValue:   "7200",
+	})
+	from, to := int64(3600), int64(7200)
+	assert.Equal(t, &models.JiraIssueEstimateHistory{
+		ConnectionId:    1,
+		ChangelogId:     100,
+		EstimateType:    models.EstimateTypeRemaining,
+		IssueId:         10,
+		Created:         created,
+		FromSeconds:     &from,
+		ToSeconds:       &to,
+		Increased:       true,
+		AuthorAccountId: "abc",
+	}, history)
+
+	// cleared estimate
+	history = toEstimateHistory(changelog, &models.JiraIssueChangelogItems{Field: "timeoriginalestimate", FromValue: "3600"})
+	assert.Equal(t, models.EstimateTypeOriginal, history.EstimateType)
+	assert.Nil(t, history.ToSeconds)
+	assert.False(t, history.Increased)
+
+	// first estimate
+	history = toEstimateHistory(changelog, &models.JiraIssueChangelogItems{FieldId: "timeestimate", ToValue: "600"})
+	assert.Nil(t, history.FromSeconds)
+	assert.True(t, history.Increased)
+}
@@ -0,0 +1,319 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+var ConvertIssueEventsMeta = plugin.SubTaskMeta{
+	Name:             "convertIssueEvents",
+	EntryPoint:       ConvertIssueEvents,
+	EnabledByDefault: true,
+	Description:      "Merge changelogs, comments and worklogs of Jira issues into the issue timeline in domain layer table issue_events",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+// changelog fields turning into issue events
+var issueEventTypes = map[string]string{
+	"status":   ticket.EVENT_STATUS_CHANGED,
+	"assignee": ticket.EVENT_ASSIGNEE_CHANGED,
+	"labels":   ticket.EVENT_LABEL_CHANGED,
+}
+
+type issueEventPayload struct {
+	From             string   `json:"from,omitempty"`
+	To               string   `json:"to,omitempty"`
+	Added            []string `json:"added,omitempty"`
+	Removed          []string `json:"removed,omitempty"`
+	CommentId        string   `json:"commentId,omitempty"`
+	WorklogId        string   `json:"worklogId,omitempty"`
+	TimeSpentMinutes int      `json:"timeSpentMinutes,omitempty"`
+}
+
+func (p issueEventPayload) String() string {
+	payload, _ := json.Marshal(p)
+	return string(payload)
+}
+
+// ConvertIssueEvents converts every source of issue events on its own, so each of them only replaces the events
+// it produced by the previous run
+func ConvertIssueEvents(taskCtx plugin.SubTaskContext) errors.Error {
+	err := convertChangelogEvents(taskCtx)
+	if err != nil {
+		return err
+	}
+	err = convertCommentEvents(taskCtx)
+	if err != nil {
+		return err
+	}
+	return convertWorklogEvents(taskCtx)
+}
+
+func convertChangelogEvents(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*JiraTaskData)
+	if !keepsChangelogItems(data) {
+		return nil
+	}
+	db := taskCtx.GetDal()
+	connectionId := data.Options.ConnectionId
+	boardId := data.Options.BoardId
+
+	var allStatus []models.JiraStatus
+	err := db.All(&allStatus, dal.Where("connection_id = ?", connectionId))
+	if err != nil {
+		return err
+	}
+	statusNames := make(map[string]string, len(allStatus))
+	for _, status := range allStatus {
+		statusNames[status.ID] = status.Name
+	}
+
+	fields := make([]string, 0, len(issueEventTypes))
+	for field := range issueEventTypes {
+		fields = append(fields, field)
+	}
+	cursor, err := db.Cursor(
+		dal.Select("_tool_jira_issue_changelog_items.*, _tool_jira_issue_changelogs.issue_id, author_account_id, author_display_name, created"),
+		dal.From("_tool_jira_issue_changelog_items"),
+		dal.Join(`left join _tool_jira_issue_changelogs on (
+			_tool_jira_issue_changelogs.connection_id = _tool_jira_issue_changelog_items.connection_id
+			AND _tool_jira_issue_changelogs.changelog_id = _tool_jira_issue_changelog_items.changelog_id
+		)`),
+		dal.Join(`left join _tool_jira_board_issues on (
+			_tool_jira_board_issues.connection_id = _tool_jira_issue_changelogs.connection_id
+			AND _tool_jira_board_issues.issue_id = _tool_jira_issue_changelogs.issue_id
+		)`),
+		dal.Where("_tool_jira_issue_changelog_items.connection_id = ? AND _tool_jira_board_issues.board_id = ? AND _tool_jira_issue_changelog_items.field IN ?",
+			connectionId, boardId, fields),
+		securityLevelFilter("_tool_jira_issue_changelogs.connection_id", "_tool_jira_issue_changelogs.issue_id"),
+	)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+
+	eventIdGen := didgen.NewDomainIdGenerator(&models.JiraIssueChangelogItems{})
+	issueIdGen := didgen.NewDomainIdGenerator(&models.JiraIssue{})
+	accountIdGen := didgen.NewDomainIdGenerator(&models.JiraAccount{})
+	converter, err := api.NewDataConverter(api.DataConverterArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: JiraApiParams{
+				ConnectionId: connectionId,
+				BoardId:      boardId,
+			},
+			Table: RAW_CHANGELOG_TABLE,
+		},
+		InputRowType: reflect.TypeOf(IssueChangelogItemResult{}),
+		Input:        cursor,
+		Convert: func(inputRow interface{}) ([]interface{}, errors.Error) {
+			row := inputRow.(*IssueChangelogItemResult)
+			event := &ticket.IssueEvent{
+				DomainEntity: domainlayer.DomainEntity{Id: eventIdGen.Generate(row.ConnectionId, row.ChangelogId, row.Field)},
+				IssueId:      issueIdGen.Generate(row.ConnectionId, row.IssueId),
+				EventType:    issueEventTypes[row.Field],
+				EventDate:    row.Created,
+				ActorName:    row.AuthorDisplayName,
+			}
+			if row.AuthorAccountId != "" {
+				event.ActorId = accountIdGen.Generate(connectionId, row.AuthorAccountId)
+			}
+			payload := issueEventPayload{From: row.FromString, To: row.ToString}
+			switch row.Field {
+			case "status":
+				if name, ok := statusNames[row.FromValue]; ok {
+					payload.From = name
+				}
+				if name, ok := statusNames[row.ToValue]; ok {
+					payload.To = name
+				}
+			case "assignee":
+				if row.FromValue != "" {
+					payload.From = accountIdGen.Generate(connectionId, row.FromValue)
+				}
+				if row.ToValue != "" {
+					payload.To = accountIdGen.Generate(connectionId, row.ToValue)
+				}
+			case "labels":
+				payload = issueEventPayload{}
+				payload.Added, payload.Removed = labelDelta(row.FromString, row.ToString)
+			}
+			event.Payload = payload.String()
+			return []interface{}{event}, nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return converter.Execute()
+}
+
+func convertCommentEvents(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*JiraTaskData)
+	db := taskCtx.GetDal()
+	connectionId := data.Options.ConnectionId
+	boardId := data.Options.BoardId
+
+	cursor, err := db.Cursor(
+		dal.Select("jic.*"),
+		dal.From("_tool_jira_issue_comments jic"),
+		dal.Join(`left join _tool_jira_board_issues jbi on (
+			jbi.connection_id = jic.connection_id
+			AND jbi.issue_id = jic.issue_id
+		)`),
+		dal.Where("jbi.connection_id = ? AND jbi.board_id = ?", connectionId, boardId),
+		securityLevelFilter("jic.connection_id", "jic.issue_id"),
+	)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+
+	eventIdGen := didgen.NewDomainIdGenerator(&models.JiraIssueComment{})
+	issueIdGen := didgen.NewDomainIdGenerator(&models.JiraIssue{})
+	accountIdGen := didgen.NewDomainIdGenerator(&models.JiraAccount{})
+	converter, err := api.NewDataConverter(api.DataConverterArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: JiraApiParams{
+				ConnectionId: connectionId,
+				BoardId:      boardId,
+			},
+			Table: RAW_ISSUE_COMMENT_TABLE,
+		},
+		InputRowType: reflect.TypeOf(models.JiraIssueComment{}),
+		Input:        cursor,
+		Convert: func(inputRow interface{}) ([]interface{}, errors.Error) {
+			comment := inputRow.(*models.JiraIssueComment)
+			event := &ticket.IssueEvent{
+				DomainEntity: domainlayer.DomainEntity{Id: eventIdGen.Generate(comment.ConnectionId, comment.IssueId, comment.ComentId)},
+				IssueId:      issueIdGen.Generate(comment.ConnectionId, comment.IssueId),
+				EventType:    ticket.EVENT_COMMENT_ADDED,
+				EventDate:    comment.Created,
+				ActorName:    comment.CreatorDisplayName,
+				Payload:      issueEventPayload{CommentId: comment.ComentId}.String(),
+			}
+			if comment.CreatorAccountId != "" {
+				event.ActorId = accountIdGen.Generate(connectionId, comment.CreatorAccountId)
+			}
+			return []interface{}{event}, nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return converter.Execute()
+}
+
+func convertWorklogEvents(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*JiraTaskData)
+	db := taskCtx.GetDal()
+	connectionId := data.Options.ConnectionId
+	boardId := data.Options.BoardId
+
+	cursor, err := db.Cursor(
+		dal.From(&models.JiraWorklog{}),
+		dal.Select("_tool_jira_worklogs.*"),
+		dal.Join(`LEFT JOIN _tool_jira_board_issues
+              ON _tool_jira_board_issues.connection_id = _tool_jira_worklogs.connection_id
+                   AND _tool_jira_board_issues.issue_id = _tool_jira_worklogs.issue_id`),
+		dal.Where("_tool_jira_board_issues.connection_id = ? AND _tool_jira_board_issues.board_id = ?", connectionId, boardId),
+		securityLevelFilter("_tool_jira_worklogs.connection_id", "_tool_jira_worklogs.issue_id"),
+	)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+
+	eventIdGen := didgen.NewDomainIdGenerator(&models.JiraWorklog{})
+	issueIdGen := didgen.NewDomainIdGenerator(&models.JiraIssue{})
+	accountIdGen := didgen.NewDomainIdGenerator(&models.JiraAccount{})
+	converter, err := api.NewDataConverter(api.DataConverterArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: JiraApiParams{
+				ConnectionId: connectionId,
+				BoardId:      boardId,
+			},
+			Table: RAW_WORKLOGS_TABLE,
+		},
+		InputRowType: reflect.TypeOf(models.JiraWorklog{}),
+		Input:        cursor,
+		Convert: func(inputRow interface{}) ([]interface{}, errors.Error) {
+			worklog := inputRow.(*models.JiraWorklog)
+			event := &ticket.IssueEvent{
+				DomainEntity: domainlayer.DomainEntity{Id: eventIdGen.Generate(worklog.ConnectionId, worklog.IssueId, worklog.WorklogId)},
+				IssueId:      issueIdGen.Generate(worklog.ConnectionId, worklog.IssueId),
+				EventType:    ticket.EVENT_WORKLOG_ADDED,
+				EventDate:    worklog.Updated,
+				Payload: issueEventPayload{
+					WorklogId:        worklog.WorklogId,
+					TimeSpentMinutes: worklog.TimeSpentSeconds / 60,
+				}.String(),
+			}
+			// worklogs extracted before the creation date was kept
+			if worklog.Created != nil {
+				event.EventDate = *worklog.Created
+			}
+			if worklog.AuthorId != "" {
+				event.ActorId = accountIdGen.Generate(connectionId, worklog.AuthorId)
+			}
+			return []interface{}{event}, nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return converter.Execute()
+}
+
+// labelDelta tells the labels added and removed by a change of the space separated labels of an issue
+func labelDelta(from, to string) (added []string, removed []string) {
+	before := make(map[string]bool)
+	for _, label := range strings.Fields(from) {
+		before[label] = true
+	}
+	after := make(map[string]bool)
+	for _, label := range strings.Fields(to) {
+		after[label] = true
+		if !before[label] {
+			added = append(added, label)
+		}
+	}
+	for label := range before {
+		if !after[label] {
+			removed = append(removed, label)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
@@ -0,0 +1,44 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLabelDelta(t *testing.T) {
+	added, removed := labelDelta("backend frontend urgent", "backend ux urgent api")
+	assert.Equal(t, []string{"api", "ux"}, added)
+	assert.Equal(t, []string{"frontend"}, removed)
+
+	added, removed = labelDelta("", "backend")
+	assert.Equal(t, []string{"backend"}, added)
+	assert.Empty(t, removed)
+
+	added, removed = labelDelta("backend", "backend")
+	assert.Empty(t, added)
+	assert.Empty(t, removed)
+}
+
+func TestIssueEventPayload(t *testing.T) {
+	assert.Equal(t, `{"from":"To Do","to":"Done"}`, issueEventPayload{From: "To Do", To: "Done"}.String())
+	assert.Equal(t, `{"added":["ux"],"removed":["frontend"]}`, issueEventPayload{Added: []string{"ux"}, Removed: []string{"frontend"}}.String())
+	assert.Equal(t, `{"worklogId":"10001","timeSpentMinutes":90}`, issueEventPayload{WorklogId: "10001", TimeSpentMinutes: 90}.String())
+}
@@ -19,16 +19,20 @@ package tasks
 
 import (
 	"encoding/json"
-	"strconv"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/apache/incubator-devlake/core/dal"
 	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/log"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
 	"github.com/apache/incubator-devlake/core/plugin"
 	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
 	"github.com/apache/incubator-devlake/plugins/jira/models"
 	"github.com/apache/incubator-devlake/plugins/jira/tasks/apiv2models"
+
+	"golang.org/x/exp/slices"
 )
 
 var _ plugin.SubTaskEntryPoint = ExtractIssues
@@ -43,8 +47,22 @@ var ExtractIssuesMeta = plugin.SubTaskMeta{
 
 type typeMappings struct {
 	typeIdMappings         map[string]string
+	hierarchyLevels        map[string]int
 	stdTypeMappings        map[string]string
 	standardStatusMappings map[string]models.StatusMappings
+	// projectStdTypeMappings and projectStatusMappings override the mappings above by project key
+	projectStdTypeMappings map[string]map[string]string
+	projectStatusMappings  map[string]map[string]models.StatusMappings
+	issueLinkTypes         map[string]*models.JiraIssueLinkType
+	transitions            *statusTransitionMapper
+	// canonicalLabels maps lowercased label spellings to their canonical label
+	canonicalLabels map[string]string
+	// environmentParser is nil unless the environment of issues is to be parsed
+	environmentParser *environmentParser
+	// storyPointFields are the story point fields of the scope config, or the estimation field of the board
+	storyPointFields []string
+	// fieldMappings are the other fields mapped by the scope config
+	fieldMappings api.FieldMappings
 }
 
 func ExtractIssues(taskCtx plugin.SubTaskContext) errors.Error {
@@ -75,7 +93,7 @@ func ExtractIssues(taskCtx plugin.SubTaskContext) errors.Error {
 			Table: RAW_ISSUE_TABLE,
 		},
 		Extract: func(row *api.RawData) ([]interface{}, errors.Error) {
-			return extractIssues(data, mappings, row)
+			return extractIssues(data, mappings, row, logger)
 		},
 	})
 	if err != nil {
@@ -84,7 +102,7 @@ func ExtractIssues(taskCtx plugin.SubTaskContext) errors.Error {
 	return extractor.Execute()
 }
 
-func extractIssues(data *JiraTaskData, mappings *typeMappings, row *api.RawData) ([]interface{}, errors.Error) {
+func extractIssues(data *JiraTaskData, mappings *typeMappings, row *api.RawData, logger log.Logger) ([]interface{}, errors.Error) {
 	var apiIssue apiv2models.Issue
 	err := errors.Convert(json.Unmarshal(row.Data, &apiIssue))
 	if err != nil {
@@ -113,30 +131,47 @@ func extractIssues(data *JiraTaskData, mappings *typeMappings, row *api.RawData)
 	if issue.ResolutionDate != nil {
 		issue.LeadTimeMinutes = uint(issue.ResolutionDate.Unix()-issue.Created.Unix()) / 60
 	}
-	if data.Options.ScopeConfig != nil && data.Options.ScopeConfig.StoryPointField != "" {
-		unknownStoryPoint := apiIssue.Fields.AllFields[data.Options.ScopeConfig.StoryPointField]
-		switch sp := unknownStoryPoint.(type) {
-		case string:
-			// string, try to parse
-			issue.StoryPoint, _ = strconv.ParseFloat(sp, 32)
-		case nil:
-		default:
-			// not string, convert to float64, ignore it if failed
-			issue.StoryPoint, _ = unknownStoryPoint.(float64)
+	applyFieldMappings(issue, mappings.fieldMappings, mappings.storyPointFields, apiIssue.Fields.AllFields, logger)
+	if data.Options.ScopeConfig != nil {
+		if field := data.Options.ScopeConfig.AcceptanceCriteriaField; field != "" {
+			issue.AcceptanceCriteria = strings.TrimSpace(adfToText(apiIssue.Fields.AllFields[field]))
+		}
+		issue.SecurityExcluded = data.Options.ScopeConfig.IsSecurityLevelExcluded(issue.SecurityLevel)
+		if field := data.Options.ScopeConfig.RequestParticipantsField; field != "" {
+			for _, participant := range parseRequestParticipants(apiIssue.Fields.AllFields[field]) {
+				account := participant.ToToolLayer(data.Options.ConnectionId)
+				if account == nil {
+					continue
+				}
+				results = append(results, account, &models.JiraIssueParticipant{
+					ConnectionId: data.Options.ConnectionId,
+					IssueId:      issue.IssueId,
+					AccountId:    account.AccountId,
+					Role:         ticket.PARTICIPANT,
+				})
+			}
 		}
-
 	}
 
+	if issue.HierarchyLevel == nil {
+		if level, ok := mappings.hierarchyLevels[issue.Type]; ok {
+			issue.HierarchyLevel = &level
+		}
+	}
 	// code in next line will set issue.Type to issueType.Name
 	issue.Type = mappings.typeIdMappings[issue.Type]
-	issue.StdType = mappings.stdTypeMappings[issue.Type]
+	projectKey := apiIssue.Fields.Project.Key
+	issue.StdType = mappings.stdType(projectKey, issue.Type)
 	if issue.StdType == "" {
 		issue.StdType = strings.ToUpper(issue.Type)
 	}
 	issue.StdStatus = getStdStatus(issue.StatusKey)
-	if value, ok := mappings.standardStatusMappings[issue.Type][issue.StatusKey]; ok {
+	if value, ok := lookupStatusMappings(mappings.standardStatusMappings, mappings.projectStatusMappings, projectKey, issue.Type)[issue.StatusKey]; ok {
 		issue.StdStatus = value.StandardStatus
 	}
+	if data.Options.ScopeConfig != nil {
+		issue.StdResolution = data.Options.ScopeConfig.GetStdResolution(issue.StdType, issue.ResolutionName, issue.StdStatus == ticket.DONE)
+	}
 	results = append(results, issue)
 	for _, comment := range comments {
 		results = append(results, comment)
@@ -151,12 +186,46 @@ func extractIssues(data *JiraTaskData, mappings *typeMappings, row *api.RawData)
 	} else {
 		issueUpdated = &issue.Updated
 	}
+	changelogsById := make(map[uint64]*models.JiraIssueChangelogs, len(changelogs))
 	for _, changelog := range changelogs {
 		changelog.IssueUpdated = issueUpdated
+		changelogsById[changelog.ChangelogId] = changelog
 		results = append(results, changelog)
 	}
+	// transitions follow the order of changelogs regardless of the order Jira returned them in
+	sort.SliceStable(changelogItems, func(i, j int) bool {
+		return changelogItems[i].ChangelogId < changelogItems[j].ChangelogId
+	})
+	changelogOf := func(changelogItem *models.JiraIssueChangelogItems) *models.JiraIssueChangelogs {
+		if changelog, ok := changelogsById[changelogItem.ChangelogId]; ok {
+			return changelog
+		}
+		return &models.JiraIssueChangelogs{
+			ConnectionId: data.Options.ConnectionId,
+			ChangelogId:  changelogItem.ChangelogId,
+			IssueId:      issue.IssueId,
+		}
+	}
+	var dedup *changelogDeduplicator
+	if data.Options.ScopeConfig != nil && data.Options.ScopeConfig.DedupChangelogItems {
+		dedup = newChangelogDeduplicator()
+		for _, changelogItem := range changelogItems {
+			dedup.observe(issue.IssueId, changelogOf(changelogItem).Created, changelogItem)
+		}
+	}
 	for _, changelogItem := range changelogItems {
-		results = append(results, changelogItem)
+		changelog := changelogOf(changelogItem)
+		if dedup != nil && !dedup.keep(issue.IssueId, changelog.Created, changelogItem) {
+			continue
+		}
+		if mappings.transitions != nil {
+			if transition := mappings.transitions.toTransition(projectKey, issue.Type, changelog, changelogItem); transition != nil {
+				results = append(results, transition)
+			}
+		}
+		if mappings.transitions == nil || mappings.transitions.keepItems {
+			results = append(results, changelogItem)
+		}
 	}
 	for _, user := range users {
 		if user.AccountId != "" {
@@ -168,18 +237,110 @@ func extractIssues(data *JiraTaskData, mappings *typeMappings, row *api.RawData)
 		BoardId:      data.Options.BoardId,
 		IssueId:      issue.IssueId,
 	})
+	results = append(results, extractIssueVersions(data.Options.ConnectionId, issue, apiIssue.Fields.FixVersions, apiIssue.Fields.Versions)...)
+	if mappings.environmentParser != nil {
+		results = append(results, extractIssueEnvironment(data.Options.ConnectionId, issue.IssueId, adfToText(apiIssue.Fields.Environment), mappings.environmentParser)...)
+	}
 	labels := apiIssue.Fields.Labels
+	if data.Options.ScopeConfig != nil && len(data.Options.ScopeConfig.LabelCategories) > 0 {
+		var conflicts []string
+		labels, issue.Categories, conflicts = extractLabelCategories(labels, data.Options.ScopeConfig.LabelCategories)
+		if len(conflicts) > 0 {
+			logger.Warn(nil, "issue %s has conflicting values for the label categories %v", issue.IssueKey, conflicts)
+		}
+	}
 	for _, v := range labels {
 		issueLabel := &models.JiraIssueLabel{
-			IssueId:      issue.IssueId,
-			LabelName:    v,
-			ConnectionId: data.Options.ConnectionId,
+			IssueId:       issue.IssueId,
+			LabelName:     v,
+			CanonicalName: mappings.canonicalLabel(v),
+			ConnectionId:  data.Options.ConnectionId,
 		}
 		results = append(results, issueLabel)
 	}
+	if data.Options.ScopeConfig != nil && data.Options.ScopeConfig.MaterializeIssueAttributes {
+		canonicalLabels := make([]string, 0, len(labels))
+		for _, label := range labels {
+			canonicalLabels = append(canonicalLabels, mappings.canonicalLabel(label))
+		}
+		results = append(results, extractIssueAttributes(
+			data.Options.ConnectionId, issue.IssueId, canonicalLabels, issue.Components,
+			apiIssue.Fields.FixVersions, apiIssue.Fields.Versions,
+			apiIssue.Fields.AllFields, data.Options.ScopeConfig.IssueAttributeFields,
+		)...)
+	}
+	for _, link := range apiIssue.Fields.Issuelinks {
+		relationship := link.ToToolLayer(data.Options.ConnectionId, issue.IssueId, mappings.issueLinkTypes[link.Type.ID])
+		if relationship != nil {
+			results = append(results, relationship)
+		}
+	}
 	return results, nil
 }
 
+// parseRequestParticipants reads the users of a request participants field, issues outside service desks don't
+// populate it and get none
+func parseRequestParticipants(value interface{}) []apiv2models.Account {
+	if value == nil {
+		return nil
+	}
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+	var participants []apiv2models.Account
+	if json.Unmarshal(raw, &participants) != nil {
+		return nil
+	}
+	return participants
+}
+
+// parseDateField converts the value of a date custom field to midnight UTC of the date it holds, the time part and
+// timezone of datetime values are dropped. Returns nil if the field was not populated or could not be parsed
+func parseDateField(value interface{}) *time.Time {
+	s, ok := value.(string)
+	if !ok || strings.TrimSpace(s) == "" {
+		return nil
+	}
+	t, err := api.ConvertStringToTime(strings.TrimSpace(s))
+	if err != nil {
+		return nil
+	}
+	date := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	return &date
+}
+
+// applyFieldMappings sets the fields of the issue mapped from its source fields, the story point is read from the
+// first populated of its candidate fields and start dates keep their date part only, as seen in the timezone of the
+// value
+func applyFieldMappings(issue *models.JiraIssue, mappings api.FieldMappings, storyPointFields []string, fields map[string]interface{}, logger log.Logger) {
+	var storyPointField string
+	for _, field := range storyPointFields {
+		storyPoint, ok := api.NewFieldResolver(api.FieldMappings{api.FieldMappingStoryPoint: field}, fields).Float(api.FieldMappingStoryPoint)
+		if !ok {
+			continue
+		}
+		if storyPointField == "" {
+			storyPointField = field
+			issue.StoryPoint = storyPoint
+		} else {
+			logger.Warn(nil, "issue %s has both %s and %s populated, story point was taken from %s", issue.IssueKey, storyPointField, field, storyPointField)
+			break
+		}
+	}
+	if len(mappings) == 0 {
+		return
+	}
+	resolver := api.NewFieldResolver(mappings, fields)
+	if startDate, ok := resolver.Time(api.FieldMappingStartDate); ok {
+		date := time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 0, 0, 0, 0, time.UTC)
+		issue.StartDate = &date
+	}
+	if teamId, ok := resolver.String(api.FieldMappingTeamId); ok {
+		issue.TeamId = teamId
+	}
+}
+
 func getTypeMappings(data *JiraTaskData, db dal.Dal) (*typeMappings, errors.Error) {
 	typeIdMapping := make(map[string]string)
 	issueTypes := make([]models.JiraIssueType, 0)
@@ -191,20 +352,141 @@ func getTypeMappings(data *JiraTaskData, db dal.Dal) (*typeMappings, errors.Erro
 	if err != nil {
 		return nil, err
 	}
+	// Jira Server doesn't return hierarchy levels, leave them unknown rather than all zero
+	hierarchyLevels := make(map[string]int)
 	for _, issueType := range issueTypes {
 		typeIdMapping[issueType.Id] = issueType.Name
+		if data.JiraServerInfo.DeploymentType != models.DeploymentServer {
+			hierarchyLevels[issueType.Id] = issueType.HierarchyLevel
+		}
 	}
 	stdTypeMappings := make(map[string]string)
-	standardStatusMappings := make(map[string]models.StatusMappings)
+	projectStdTypeMappings := make(map[string]map[string]string)
 	if data.Options.ScopeConfig != nil {
 		for userType, stdType := range data.Options.ScopeConfig.TypeMappings {
 			stdTypeMappings[userType] = strings.ToUpper(stdType.StandardType)
-			standardStatusMappings[userType] = stdType.StatusMappings
+		}
+		for projectKey, projectMappings := range data.Options.ScopeConfig.ProjectTypeMappings {
+			projectStdTypeMappings[projectKey] = make(map[string]string, len(projectMappings))
+			for userType, stdType := range projectMappings {
+				projectStdTypeMappings[projectKey][userType] = strings.ToUpper(stdType.StandardType)
+			}
+		}
+	}
+	standardStatusMappings, projectStatusMappings := getStatusMappings(data)
+	var linkTypes []*models.JiraIssueLinkType
+	err = db.All(&linkTypes, dal.Where("connection_id = ?", data.Options.ConnectionId))
+	if err != nil {
+		return nil, err
+	}
+	issueLinkTypes := make(map[string]*models.JiraIssueLinkType, len(linkTypes))
+	for _, linkType := range linkTypes {
+		issueLinkTypes[linkType.Id] = linkType
+	}
+	transitions, err := newStatusTransitionMapper(db, data, standardStatusMappings, projectStatusMappings)
+	if err != nil {
+		return nil, err
+	}
+	var boardConfigurations []*models.JiraBoardConfiguration
+	err = db.All(&boardConfigurations, dal.Where("connection_id = ? AND board_id = ?", data.Options.ConnectionId, data.Options.BoardId))
+	if err != nil {
+		return nil, err
+	}
+	var boardConfiguration *models.JiraBoardConfiguration
+	if len(boardConfigurations) > 0 {
+		boardConfiguration = boardConfigurations[0]
+	}
+	storyPointFields := getStoryPointFields(data.Options.ScopeConfig, boardConfiguration)
+	var canonicalLabels map[string]string
+	var environmentParser *environmentParser
+	var fieldMappings api.FieldMappings
+	if data.Options.ScopeConfig != nil {
+		fieldMappings = data.Options.ScopeConfig.GetFieldMappings()
+		canonicalLabels, err = data.Options.ScopeConfig.GetCanonicalLabels()
+		if err != nil {
+			return nil, err
+		}
+		if data.Options.ScopeConfig.EnvironmentParser != nil {
+			environmentParser, err = newEnvironmentParser(data.Options.ScopeConfig.EnvironmentParser)
+			if err != nil {
+				return nil, err
+			}
 		}
 	}
 	return &typeMappings{
 		typeIdMappings:         typeIdMapping,
+		hierarchyLevels:        hierarchyLevels,
 		stdTypeMappings:        stdTypeMappings,
 		standardStatusMappings: standardStatusMappings,
+		projectStdTypeMappings: projectStdTypeMappings,
+		projectStatusMappings:  projectStatusMappings,
+		issueLinkTypes:         issueLinkTypes,
+		transitions:            transitions,
+		canonicalLabels:        canonicalLabels,
+		environmentParser:      environmentParser,
+		storyPointFields:       storyPointFields,
+		fieldMappings:          fieldMappings,
 	}, nil
 }
+
+// getStoryPointFields returns the story point fields of the scope config, falling back to the estimation field of
+// the board when the scope config sets none and the board estimates in a custom field, unlike time estimates
+func getStoryPointFields(scopeConfig *models.JiraScopeConfig, boardConfiguration *models.JiraBoardConfiguration) []string {
+	if scopeConfig != nil {
+		if fields := scopeConfig.GetStoryPointFields(); len(fields) > 0 {
+			return fields
+		}
+	}
+	if boardConfiguration != nil && strings.HasPrefix(boardConfiguration.EstimationFieldId, "customfield_") {
+		return []string{boardConfiguration.EstimationFieldId}
+	}
+	return nil
+}
+
+// extractLabelCategories splits the labels into the ones left as labels and the values of the label categories they
+// match, along with the categories matched by several distinct values
+func extractLabelCategories(labels []string, categories []models.LabelCategory) ([]string, map[string]string, []string) {
+	var rest, conflicts []string
+	var values map[string]string
+	for _, label := range labels {
+		matched := false
+		for _, category := range categories {
+			if !strings.HasPrefix(label, category.Prefix) {
+				continue
+			}
+			matched = true
+			value := strings.TrimSpace(strings.TrimPrefix(label, category.Prefix))
+			if values == nil {
+				values = make(map[string]string)
+			}
+			current, ok := values[category.Category]
+			if ok && current != value && !slices.Contains(conflicts, category.Category) {
+				conflicts = append(conflicts, category.Category)
+			}
+			if !ok || category.Pick == models.LabelCategoryPickLast {
+				values[category.Category] = value
+			}
+			break
+		}
+		if !matched {
+			rest = append(rest, label)
+		}
+	}
+	return rest, values, conflicts
+}
+
+// canonicalLabel returns the canonical label of a label spelling, unmapped labels are returned unchanged
+func (m *typeMappings) canonicalLabel(label string) string {
+	if canonical, ok := m.canonicalLabels[strings.ToLower(label)]; ok {
+		return canonical
+	}
+	return label
+}
+
+// stdType returns the standard type an issue type is mapped to within a project, empty if it isn't mapped
+func (m *typeMappings) stdType(projectKey, issueType string) string {
+	if stdType, ok := m.projectStdTypeMappings[projectKey][issueType]; ok {
+		return stdType
+	}
+	return m.stdTypeMappings[issueType]
+}
@@ -0,0 +1,226 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/impls/logruslog"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDateField(t *testing.T) {
+	date := time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, &date, parseDateField("2023-08-01"))
+	// the date is taken as seen in the timezone of the value
+	assert.Equal(t, &date, parseDateField("2023-08-01T23:30:00.000+0800"))
+	assert.Equal(t, &date, parseDateField(" 2023-08-01 "))
+	assert.Nil(t, parseDateField(nil))
+	assert.Nil(t, parseDateField(""))
+	assert.Nil(t, parseDateField("not a date"))
+	assert.Nil(t, parseDateField(float64(20230801)))
+}
+
+func TestApplyFieldMappingsStartDate(t *testing.T) {
+	date := time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)
+	startDate := func(value interface{}) *time.Time {
+		issue := &models.JiraIssue{}
+		mappings := (&models.JiraScopeConfig{StartDateField: "customfield_1"}).GetFieldMappings()
+		applyFieldMappings(issue, mappings, nil, map[string]interface{}{"customfield_1": value}, logruslog.Global)
+		return issue.StartDate
+	}
+	assert.Equal(t, &date, startDate("2023-08-01"))
+	// the date is taken as seen in the timezone of the value
+	assert.Equal(t, &date, startDate("2023-08-01T23:30:00.000+0800"))
+	assert.Equal(t, &date, startDate(" 2023-08-01 "))
+	assert.Nil(t, startDate(nil))
+	assert.Nil(t, startDate(""))
+	assert.Nil(t, startDate("not a date"))
+	assert.Nil(t, startDate(float64(20230801)))
+}
+
+func TestTypeMappingsStdType(t *testing.T) {
+	mappings := &typeMappings{
+		stdTypeMappings:        map[string]string{"Bug": "BUG", "Story": "REQUIREMENT"},
+		projectStdTypeMappings: map[string]map[string]string{"OPS": {"Bug": "INCIDENT"}},
+	}
+	assert.Equal(t, "INCIDENT", mappings.stdType("OPS", "Bug"))
+	assert.Equal(t, "REQUIREMENT", mappings.stdType("OPS", "Story"))
+	assert.Equal(t, "BUG", mappings.stdType("DL", "Bug"))
+	assert.Equal(t, "", mappings.stdType("DL", "Task"))
+}
+
+func TestApplyFieldMappings(t *testing.T) {
+	fields := map[string]interface{}{
+		"customfield_1": "8",
+		"customfield_2": "2023-08-01T23:30:00.000+0800",
+		"customfield_3": nil,
+		"customfield_4": map[string]interface{}{"id": "42", "name": "Platform"},
+		"customfield_5": 5.0,
+	}
+	scopeConfig := &models.JiraScopeConfig{
+		StoryPointField: "customfield_5",
+		StartDateField:  "customfield_3",
+		FieldMappings: api.FieldMappings{
+			api.FieldMappingStoryPoint: "customfield_1",
+			api.FieldMappingStartDate:  "customfield_2",
+			api.FieldMappingTeamId:     "customfield_4",
+		},
+	}
+	issue := &models.JiraIssue{}
+	applyFieldMappings(issue, scopeConfig.GetFieldMappings(), scopeConfig.GetStoryPointFields(), fields, logruslog.Global)
+	assert.Equal(t, 8.0, issue.StoryPoint)
+	date := time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, &date, issue.StartDate)
+	assert.Equal(t, "Platform", issue.TeamId)
+
+	// empty mapped fields fall back to the legacy story point fields
+	scopeConfig.FieldMappings = api.FieldMappings{api.FieldMappingStoryPoint: "customfield_3"}
+	issue = &models.JiraIssue{}
+	applyFieldMappings(issue, scopeConfig.GetFieldMappings(), scopeConfig.GetStoryPointFields(), fields, logruslog.Global)
+	assert.Equal(t, 5.0, issue.StoryPoint)
+	assert.Nil(t, issue.StartDate)
+	assert.Equal(t, "", issue.TeamId)
+}
+
+func TestGetFieldMappings(t *testing.T) {
+	scopeConfig := &models.JiraScopeConfig{StartDateField: "customfield_1"}
+	assert.Equal(t, api.FieldMappings{api.FieldMappingStartDate: "customfield_1"}, scopeConfig.GetFieldMappings())
+
+	// the mapped fields take precedence over the legacy ones, story points are left to GetStoryPointFields
+	scopeConfig.FieldMappings = api.FieldMappings{
+		api.FieldMappingStartDate:  "customfield_2",
+		api.FieldMappingStoryPoint: "customfield_3",
+	}
+	assert.Equal(t, api.FieldMappings{api.FieldMappingStartDate: "customfield_2"}, scopeConfig.GetFieldMappings())
+	assert.Equal(t, []string{"customfield_3"}, scopeConfig.GetStoryPointFields())
+}
+
+func TestParseRequestParticipants(t *testing.T) {
+	var fields map[string]interface{}
+	assert.Nil(t, json.Unmarshal([]byte(`{
+		"customfield_10027": [
+			{"accountId": "abc", "displayName": "Alice", "emailAddress": "alice@example.com"},
+			{"accountId": "def", "displayName": "Bob"}
+		],
+		"customfield_10028": "not users"
+	}`), &fields))
+	participants := parseRequestParticipants(fields["customfield_10027"])
+	assert.Len(t, participants, 2)
+	assert.Equal(t, "abc", participants[0].AccountId)
+	assert.Equal(t, "Bob", participants[1].DisplayName)
+	assert.Empty(t, parseRequestParticipants(fields["customfield_10028"]))
+	assert.Empty(t, parseRequestParticipants(nil))
+}
+
+func TestIsSecurityLevelExcluded(t *testing.T) {
+	scopeConfig := &models.JiraScopeConfig{
+		SecurityLevels:   []string{"Public", "Internal", "Restricted"},
+		MaxSecurityLevel: "Internal",
+	}
+	assert.False(t, scopeConfig.IsSecurityLevelExcluded(""))
+	assert.False(t, scopeConfig.IsSecurityLevelExcluded("Public"))
+	assert.False(t, scopeConfig.IsSecurityLevelExcluded("Internal"))
+	assert.True(t, scopeConfig.IsSecurityLevelExcluded("Restricted"))
+	assert.True(t, scopeConfig.IsSecurityLevelExcluded("Unknown"))
+	assert.Nil(t, scopeConfig.Validate())
+
+	scopeConfig.MaxSecurityLevel = "Secret"
+	assert.NotNil(t, scopeConfig.Validate())
+
+	assert.False(t, (&models.JiraScopeConfig{}).IsSecurityLevelExcluded("Restricted"))
+}
+
+func TestCanonicalLabel(t *testing.T) {
+	scopeConfig := &models.JiraScopeConfig{
+		LabelSynonyms: map[string][]string{"frontend": {"front-end", "FE"}},
+	}
+	canonicalLabels, err := scopeConfig.GetCanonicalLabels()
+	assert.Nil(t, err)
+	mappings := &typeMappings{canonicalLabels: canonicalLabels}
+	assert.Equal(t, "frontend", mappings.canonicalLabel("Front-End"))
+	assert.Equal(t, "frontend", mappings.canonicalLabel("fe"))
+	assert.Equal(t, "frontend", mappings.canonicalLabel("FRONTEND"))
+	assert.Equal(t, "Backend", mappings.canonicalLabel("Backend"))
+	assert.Equal(t, "Saas", (&typeMappings{}).canonicalLabel("Saas"))
+
+	scopeConfig.LabelSynonyms["ui"] = []string{"fe"}
+	assert.NotNil(t, scopeConfig.Validate())
+}
+
+func TestGetStdResolution(t *testing.T) {
+	scopeConfig := &models.JiraScopeConfig{
+		ResolutionMappings: map[string]models.ResolutionMapping{
+			"BUG": {Resolutions: map[string]string{"Won't Fix": "wont_fix", "Fixed": "done"}, Default: "done"},
+			"*":   {Resolutions: map[string]string{"Duplicate": "duplicate"}, Default: "unresolved"},
+		},
+	}
+	assert.Equal(t, "WONT_FIX", scopeConfig.GetStdResolution("BUG", "Won't Fix", true))
+	assert.Equal(t, "DUPLICATE", scopeConfig.GetStdResolution("BUG", "Duplicate", true))
+	assert.Equal(t, "DONE", scopeConfig.GetStdResolution("BUG", "", true))
+	assert.Equal(t, "", scopeConfig.GetStdResolution("BUG", "", false))
+	assert.Equal(t, "UNRESOLVED", scopeConfig.GetStdResolution("REQUIREMENT", "", true))
+	assert.Equal(t, "", scopeConfig.GetStdResolution("REQUIREMENT", "Fixed", true))
+	assert.Nil(t, scopeConfig.Validate())
+
+	scopeConfig.ResolutionMappings["BUG"].Resolutions["Cannot Reproduce"] = ""
+	assert.NotNil(t, scopeConfig.Validate())
+}
+
+func TestExtractLabelCategories(t *testing.T) {
+	categories := []models.LabelCategory{
+		{Prefix: "team:", Category: "team"},
+		{Prefix: "status:", Category: "status", Pick: models.LabelCategoryPickLast},
+	}
+	labels, values, conflicts := extractLabelCategories(
+		[]string{"backend", "status:blocked", "team:core", "status:ready", "team:core", "urgent"},
+		categories,
+	)
+	assert.Equal(t, []string{"backend", "urgent"}, labels)
+	assert.Equal(t, map[string]string{"team": "core", "status": "ready"}, values)
+	assert.Equal(t, []string{"status"}, conflicts)
+
+	labels, values, conflicts = extractLabelCategories([]string{"team:web", "team:core"}, categories)
+	assert.Empty(t, labels)
+	assert.Equal(t, map[string]string{"team": "web"}, values)
+	assert.Equal(t, []string{"team"}, conflicts)
+
+	labels, values, _ = extractLabelCategories([]string{"frontend"}, categories)
+	assert.Equal(t, []string{"frontend"}, labels)
+	assert.Nil(t, values)
+
+	assert.NotNil(t, (&models.JiraScopeConfig{LabelCategories: []models.LabelCategory{{Prefix: "team:", Category: "team", Pick: "any"}}}).Validate())
+}
+
+func TestGetStoryPointFields(t *testing.T) {
+	board := &models.JiraBoardConfiguration{EstimationType: "field", EstimationFieldId: "customfield_10016"}
+	assert.Equal(t, []string{"customfield_10016"}, getStoryPointFields(nil, board))
+	assert.Equal(t, []string{"customfield_10016"}, getStoryPointFields(&models.JiraScopeConfig{}, board))
+	assert.Equal(t, []string{"customfield_10026"}, getStoryPointFields(&models.JiraScopeConfig{StoryPointField: "customfield_10026"}, board))
+	assert.Equal(t, []string{"customfield_10030", "customfield_10026"}, getStoryPointFields(&models.JiraScopeConfig{
+		StoryPointField: "customfield_10026",
+		FieldMappings:   api.FieldMappings{api.FieldMappingStoryPoint: "customfield_10030"},
+	}, board))
+	assert.Nil(t, getStoryPointFields(nil, &models.JiraBoardConfiguration{EstimationType: "field", EstimationFieldId: "timeoriginalestimate"}))
+	assert.Nil(t, getStoryPointFields(nil, &models.JiraBoardConfiguration{EstimationType: "issueCount"}))
+	assert.Nil(t, getStoryPointFields(nil, nil))
+}
@@ -0,0 +1,86 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"strings"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+// loadIssueLabels returns the labels of the issues belonging to the board, read from the table issue_labels are
+// converted from so that both stay in sync
+func loadIssueLabels(db dal.Dal, connectionId, boardId uint64) (map[uint64][]string, errors.Error) {
+	var labels []*models.JiraIssueLabel
+	err := db.All(&labels,
+		dal.Select("l.issue_id, l.label_name, l.canonical_name"),
+		dal.From("_tool_jira_issue_labels l"),
+		dal.Join(`JOIN _tool_jira_board_issues bi ON (bi.connection_id = l.connection_id AND bi.issue_id = l.issue_id)`),
+		dal.Where("l.connection_id = ? AND bi.board_id = ?", connectionId, boardId),
+		dal.Orderby("l.issue_id, l.label_name"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[uint64][]string)
+	for _, label := range labels {
+		result[label.IssueId] = append(result[label.IssueId], label.GetCanonicalName())
+	}
+	return result, nil
+}
+
+// getIssueFacets merges labels and components into a single list, prefixed to tell them apart, hierarchical labels
+// are expanded by expandLabel
+func getIssueFacets(labels, components []string, separator string) []string {
+	facets := make([]string, 0, len(labels)+len(components))
+	seen := make(map[string]bool, len(labels))
+	for _, label := range labels {
+		for _, level := range expandLabel(label, separator) {
+			if !seen[level] {
+				seen[level] = true
+				facets = append(facets, ticket.FACET_LABEL_PREFIX+level)
+			}
+		}
+	}
+	for _, component := range components {
+		facets = append(facets, ticket.FACET_COMPONENT_PREFIX+component)
+	}
+	return facets
+}
+
+// expandLabel returns the ancestors of a hierarchical label followed by the label itself, `area/backend/api` gives
+// `area`, `area/backend` and `area/backend/api`. Labels without separator, or with an empty separator, are returned
+// unchanged
+func expandLabel(label, separator string) []string {
+	if separator == "" || !strings.Contains(label, separator) {
+		return []string{label}
+	}
+	var levels []string
+	parts := strings.Split(label, separator)
+	for i := 1; i < len(parts); i++ {
+		// leading, trailing or repeated separators do not make a level
+		if parts[i-1] == "" {
+			continue
+		}
+		levels = append(levels, strings.Join(parts[:i], separator))
+	}
+	return append(levels, label)
+}
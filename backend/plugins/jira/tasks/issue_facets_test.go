@@ -0,0 +1,44 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandLabel(t *testing.T) {
+	assert.Equal(t, []string{"backend"}, expandLabel("backend", "/"))
+	assert.Equal(t, []string{"area/backend"}, expandLabel("area/backend", ""))
+	assert.Equal(t, []string{"area", "area/backend", "area/backend/api"}, expandLabel("area/backend/api", "/"))
+	assert.Equal(t, []string{"area", "area::ui"}, expandLabel("area::ui", "::"))
+	assert.Equal(t, []string{"/area"}, expandLabel("/area", "/"))
+	assert.Equal(t, []string{"area", "area/"}, expandLabel("area/", "/"))
+}
+
+func TestGetIssueFacets(t *testing.T) {
+	assert.Equal(t,
+		[]string{"label:area", "label:area/backend", "label:area/frontend", "label:urgent", "component:api"},
+		getIssueFacets([]string{"area/backend", "area/frontend", "urgent"}, []string{"api"}, "/"),
+	)
+	assert.Equal(t,
+		[]string{"label:area/backend", "component:api"},
+		getIssueFacets([]string{"area/backend"}, []string{"api"}, ""),
+	)
+}
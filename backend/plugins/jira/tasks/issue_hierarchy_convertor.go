@@ -0,0 +1,147 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"fmt"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ConvertIssueHierarchy
+
+var ConvertIssueHierarchyMeta = plugin.SubTaskMeta{
+	Name:             "convertIssueHierarchy",
+	EntryPoint:       ConvertIssueHierarchy,
+	EnabledByDefault: true,
+	Description:      "compute the hierarchy depth and root issue of Jira issues",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+type hierarchyIssue struct {
+	IssueId  uint64
+	IssueKey string
+	ParentId uint64
+	EpicKey  string
+	// HierarchyLevel and SecurityExcluded are only loaded by ConvertPortfolioItems
+	HierarchyLevel   *int
+	SecurityExcluded bool
+	// SpentMinutes is only loaded by ConvertWorklogRollups
+	SpentMinutes int64
+}
+
+// issueAncestry is where the walk up the parents of an issue ended, Cut is set when it was stopped by a cycle or
+// by the maximum depth rather than by reaching a top-level issue
+type issueAncestry struct {
+	Depth  int
+	RootId uint64
+	Cut    bool
+}
+
+// ConvertIssueHierarchy writes the depth and the root of the issues of the board. Parents are looked up across
+// the whole connection, like ConvertSubtaskCounts, so that ancestors collected by other boards count as well. Issues
+// excluded by their security level still link their children to their ancestors but get no depth of their own
+func ConvertIssueHierarchy(taskCtx plugin.SubTaskContext) errors.Error {
+	db := taskCtx.GetDal()
+	logger := taskCtx.GetLogger()
+	data := taskCtx.GetData().(*JiraTaskData)
+	connectionId := data.Options.ConnectionId
+	maxDepth := models.DefaultMaxHierarchyDepth
+	if data.Options.ScopeConfig != nil && data.Options.ScopeConfig.MaxHierarchyDepth > 0 {
+		maxDepth = data.Options.ScopeConfig.MaxHierarchyDepth
+	}
+
+	var issues []*hierarchyIssue
+	err := db.All(&issues,
+		dal.Select("issue_id, issue_key, parent_id, epic_key"),
+		dal.From(&models.JiraIssue{}),
+		dal.Where("connection_id = ?", connectionId),
+	)
+	if err != nil {
+		return err
+	}
+	parents := getIssueParents(issues)
+
+	var boardIssues []*models.JiraIssue
+	err = db.All(&boardIssues,
+		dal.Select("ji.issue_id"),
+		dal.From("_tool_jira_issues ji"),
+		dal.Join(`JOIN _tool_jira_board_issues bi ON (bi.connection_id = ji.connection_id AND bi.issue_id = ji.issue_id)`),
+		dal.Where("ji.connection_id = ? AND bi.board_id = ?", connectionId, data.Options.BoardId),
+		securityLevelFilter("ji.connection_id", "ji.issue_id"),
+	)
+	if err != nil {
+		return err
+	}
+	issueIdGen := didgen.NewDomainIdGenerator(&models.JiraIssue{})
+	for _, issue := range boardIssues {
+		ancestry := getIssueAncestry(issue.IssueId, parents, maxDepth)
+		if ancestry.Cut {
+			logger.Warn(nil, fmt.Sprintf("the parents of issue %d loop or go deeper than %d, cut at issue %d", issue.IssueId, maxDepth, ancestry.RootId))
+		}
+		err = db.UpdateColumns(&ticket.Issue{}, []dal.DalSet{
+			{ColumnName: "hierarchy_depth", Value: ancestry.Depth},
+			{ColumnName: "root_issue_id", Value: issueIdGen.Generate(connectionId, ancestry.RootId)},
+		}, dal.Where("id = ?", issueIdGen.Generate(connectionId, issue.IssueId)))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getIssueParents maps issues to their parent, falling back to their epic for the company-managed projects
+// linking stories to epics through the epic link field
+func getIssueParents(issues []*hierarchyIssue) map[uint64]uint64 {
+	idsByKey := make(map[string]uint64, len(issues))
+	for _, issue := range issues {
+		idsByKey[issue.IssueKey] = issue.IssueId
+	}
+	parents := make(map[uint64]uint64)
+	for _, issue := range issues {
+		if issue.ParentId != 0 {
+			parents[issue.IssueId] = issue.ParentId
+		} else if epicId, ok := idsByKey[issue.EpicKey]; ok && issue.EpicKey != "" {
+			parents[issue.IssueId] = epicId
+		}
+	}
+	return parents
+}
+
+// getIssueAncestry walks up the parents of the issue until a top-level issue, or until maxDepth parents or an
+// issue already visited, in which case the last issue reached stands for the root
+func getIssueAncestry(issueId uint64, parents map[uint64]uint64, maxDepth int) issueAncestry {
+	visited := map[uint64]bool{issueId: true}
+	current := issueId
+	for depth := 0; ; depth++ {
+		parentId, ok := parents[current]
+		if !ok || parentId == 0 {
+			return issueAncestry{Depth: depth, RootId: current}
+		}
+		if depth == maxDepth || visited[parentId] {
+			return issueAncestry{Depth: depth, RootId: current, Cut: true}
+		}
+		visited[parentId] = true
+		current = parentId
+	}
+}
@@ -0,0 +1,43 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetIssueParents(t *testing.T) {
+	parents := getIssueParents([]*hierarchyIssue{
+		{IssueId: 1, IssueKey: "P-1"},
+		{IssueId: 2, IssueKey: "P-2", ParentId: 1},
+		{IssueId: 3, IssueKey: "P-3", EpicKey: "P-2"},
+		{IssueId: 4, IssueKey: "P-4", ParentId: 3, EpicKey: "P-1"},
+		{IssueId: 5, IssueKey: "P-5", EpicKey: "OTHER-1"},
+	})
+	assert.Equal(t, map[uint64]uint64{2: 1, 3: 2, 4: 3}, parents)
+}
+
+func TestGetIssueAncestry(t *testing.T) {
+	parents := map[uint64]uint64{2: 1, 3: 2, 4: 3, 10: 11, 11: 12, 12: 10}
+	assert.Equal(t, issueAncestry{Depth: 0, RootId: 1}, getIssueAncestry(1, parents, 10))
+	assert.Equal(t, issueAncestry{Depth: 3, RootId: 1}, getIssueAncestry(4, parents, 10))
+	assert.Equal(t, issueAncestry{Depth: 2, RootId: 2, Cut: true}, getIssueAncestry(4, parents, 2))
+	assert.Equal(t, issueAncestry{Depth: 2, RootId: 12, Cut: true}, getIssueAncestry(10, parents, 10))
+}
@@ -0,0 +1,166 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/crossdomain"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ReconcileMovedIssues
+
+var ReconcileMovedIssuesMeta = plugin.SubTaskMeta{
+	Name:             "reconcileMovedIssues",
+	EntryPoint:       ReconcileMovedIssues,
+	EnabledByDefault: true,
+	Description:      "re-key references to Jira issues moved to another project",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+// keyChange is a `Key` changelog item of an issue along with the key the issue has now
+type keyChange struct {
+	IssueId    uint64
+	OldKey     string
+	CurrentKey string
+	Created    time.Time
+}
+
+// ReconcileMovedIssues detects issues whose key changed because they were moved to another project, records their
+// old keys and points the references made by key to the new ones, in the tool and domain layers. Domain ids are
+// derived from issue ids, so they stay the same and only key based references need to be fixed
+func ReconcileMovedIssues(taskCtx plugin.SubTaskContext) errors.Error {
+	db := taskCtx.GetDal()
+	data := taskCtx.GetData().(*JiraTaskData)
+	logger := taskCtx.GetLogger()
+	connectionId := data.Options.ConnectionId
+	if !keepsChangelogItems(data) {
+		logger.Info("changelog items are not kept in the transitions changelog mode, skipping")
+		return nil
+	}
+
+	var changes []*keyChange
+	err := db.All(&changes,
+		dal.Select("c.issue_id, i.from_string AS old_key, ji.issue_key AS current_key, c.created"),
+		dal.From("_tool_jira_issue_changelog_items i"),
+		dal.Join(`JOIN _tool_jira_issue_changelogs c ON (c.connection_id = i.connection_id AND c.changelog_id = i.changelog_id)`),
+		dal.Join(`JOIN _tool_jira_board_issues bi ON (bi.connection_id = c.connection_id AND bi.issue_id = c.issue_id)`),
+		dal.Join(`JOIN _tool_jira_issues ji ON (ji.connection_id = c.connection_id AND ji.issue_id = c.issue_id)`),
+		dal.Where("i.connection_id = ? AND bi.board_id = ? AND i.field = 'Key'", connectionId, data.Options.BoardId),
+	)
+	if err != nil {
+		return err
+	}
+	keyChanges := resolveKeyChanges(connectionId, changes)
+	for _, change := range keyChanges {
+		err = db.CreateOrUpdate(change)
+		if err != nil {
+			return err
+		}
+		for _, ref := range getKeyReferences(connectionId, change) {
+			err = db.UpdateColumn(ref.table, ref.column, ref.value, ref.where)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if len(keyChanges) > 0 {
+		logger.Info("re-keyed references to %d old keys of moved issues", len(keyChanges))
+	}
+	return nil
+}
+
+// keyReference is a column referring to issues by key, where picks the rows to re-key
+type keyReference struct {
+	table  interface{}
+	column string
+	value  interface{}
+	where  dal.Clause
+}
+
+// getKeyReferences lists the references to re-key for a key change: the tool tables and the epics of the domain
+// issues of the connection refer to issues by key, the domain links of pull requests and refs to the moved issue
+// keep the number part of the key
+func getKeyReferences(connectionId uint64, change *models.JiraIssueKeyChange) []keyReference {
+	issueIdGen := didgen.NewDomainIdGenerator(&models.JiraIssue{})
+	refs := []keyReference{
+		{&models.JiraIssue{}, "epic_key", change.NewKey, dal.Where("connection_id = ? AND epic_key = ?", connectionId, change.OldKey)},
+		{&models.JiraIssue{}, "parent_key", change.NewKey, dal.Where("connection_id = ? AND parent_key = ?", connectionId, change.OldKey)},
+		{&models.JiraIssueRelationship{}, "related_issue_key", change.NewKey, dal.Where("connection_id = ? AND related_issue_key = ?", connectionId, change.OldKey)},
+		{&ticket.Issue{}, "epic_key", change.NewKey, dal.Where("id LIKE ? AND epic_key = ?", issueIdGen.Generate(connectionId, didgen.WILDCARD), change.OldKey)},
+	}
+	oldNumber, oldOk := getKeyNumber(change.OldKey)
+	newNumber, newOk := getKeyNumber(change.NewKey)
+	if oldOk && newOk && oldNumber != newNumber {
+		issueId := issueIdGen.Generate(connectionId, change.IssueId)
+		refs = append(refs,
+			keyReference{&crossdomain.PullRequestIssue{}, "issue_key", newNumber, dal.Where("issue_id = ? AND issue_key = ?", issueId, oldNumber)},
+			keyReference{&crossdomain.RefsIssuesDiffs{}, "issue_number", strconv.Itoa(newNumber), dal.Where("issue_id = ? AND issue_number = ?", issueId, strconv.Itoa(oldNumber))},
+		)
+	}
+	return refs
+}
+
+// getKeyNumber returns the number part of an issue key like `PROJ-12`
+func getKeyNumber(key string) (int, bool) {
+	i := strings.LastIndex(key, "-")
+	if i < 0 {
+		return 0, false
+	}
+	number, err := strconv.Atoi(key[i+1:])
+	return number, err == nil
+}
+
+// resolveKeyChanges maps every old key to the key its issue has now, so that an issue moved several times gets
+// all its former keys re-keyed in one go. Keys the issue got back to are not changes anymore and are dropped
+func resolveKeyChanges(connectionId uint64, changes []*keyChange) []*models.JiraIssueKeyChange {
+	byOldKey := make(map[string]*models.JiraIssueKeyChange)
+	for _, change := range changes {
+		if change.OldKey == "" || change.OldKey == change.CurrentKey {
+			continue
+		}
+		existing, ok := byOldKey[change.OldKey]
+		if ok && !change.Created.After(existing.ChangedAt) {
+			continue
+		}
+		byOldKey[change.OldKey] = &models.JiraIssueKeyChange{
+			ConnectionId: connectionId,
+			OldKey:       change.OldKey,
+			IssueId:      change.IssueId,
+			NewKey:       change.CurrentKey,
+			ChangedAt:    change.Created,
+		}
+	}
+	result := make([]*models.JiraIssueKeyChange, 0, len(byOldKey))
+	for _, change := range byOldKey {
+		result = append(result, change)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].OldKey < result[j].OldKey
+	})
+	return result
+}
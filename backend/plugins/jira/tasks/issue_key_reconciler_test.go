@@ -0,0 +1,80 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/crossdomain"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveKeyChanges(t *testing.T) {
+	t1 := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+	t2 := t1.Add(24 * time.Hour)
+	changes := []*keyChange{
+		// moved twice, from OLD-1 to MID-1 and then to NEW-1
+		{IssueId: 1, OldKey: "OLD-1", CurrentKey: "NEW-1", Created: t1},
+		{IssueId: 1, OldKey: "MID-1", CurrentKey: "NEW-1", Created: t2},
+		// moved away and back again
+		{IssueId: 2, OldKey: "OLD-2", CurrentKey: "OLD-2", Created: t1},
+		{IssueId: 3, OldKey: "", CurrentKey: "NEW-3", Created: t1},
+		// the key was later reused by another issue which was moved too
+		{IssueId: 4, OldKey: "OLD-4", CurrentKey: "NEW-4", Created: t1},
+		{IssueId: 5, OldKey: "OLD-4", CurrentKey: "NEW-5", Created: t2},
+	}
+	assert.Equal(t, []*models.JiraIssueKeyChange{
+		{ConnectionId: 1, OldKey: "MID-1", IssueId: 1, NewKey: "NEW-1", ChangedAt: t2},
+		{ConnectionId: 1, OldKey: "OLD-1", IssueId: 1, NewKey: "NEW-1", ChangedAt: t1},
+		{ConnectionId: 1, OldKey: "OLD-4", IssueId: 5, NewKey: "NEW-5", ChangedAt: t2},
+	}, resolveKeyChanges(1, changes))
+}
+
+func TestGetKeyReferences(t *testing.T) {
+	registerJiraForTest(t)
+	refs := getKeyReferences(1, &models.JiraIssueKeyChange{ConnectionId: 1, OldKey: "OLD-12", IssueId: 100, NewKey: "NEW-7"})
+	if assert.Len(t, refs, 6) {
+		assert.Equal(t, keyReference{&models.JiraIssue{}, "epic_key", "NEW-7", dal.Where("connection_id = ? AND epic_key = ?", uint64(1), "OLD-12")}, refs[0])
+		// the domain epics of the connection only
+		assert.Equal(t, keyReference{&ticket.Issue{}, "epic_key", "NEW-7", dal.Where("id LIKE ? AND epic_key = ?", "jira:JiraIssue:1:%", "OLD-12")}, refs[3])
+		// the links of the moved issue to pull requests and refs keep the number part of its key
+		assert.Equal(t, keyReference{&crossdomain.PullRequestIssue{}, "issue_key", 7, dal.Where("issue_id = ? AND issue_key = ?", "jira:JiraIssue:1:100", 12)}, refs[4])
+		assert.Equal(t, keyReference{&crossdomain.RefsIssuesDiffs{}, "issue_number", "7", dal.Where("issue_id = ? AND issue_number = ?", "jira:JiraIssue:1:100", "12")}, refs[5])
+	}
+
+	// moved without its number changing, or to a key without number
+	assert.Len(t, getKeyReferences(1, &models.JiraIssueKeyChange{ConnectionId: 1, OldKey: "OLD-12", IssueId: 100, NewKey: "NEW-12"}), 4)
+	assert.Len(t, getKeyReferences(1, &models.JiraIssueKeyChange{ConnectionId: 1, OldKey: "OLD-12", IssueId: 100, NewKey: "NEW"}), 4)
+}
+
+func TestGetKeyNumber(t *testing.T) {
+	number, ok := getKeyNumber("PROJ-12")
+	assert.True(t, ok)
+	assert.Equal(t, 12, number)
+	number, ok = getKeyNumber("MY-PROJ-3")
+	assert.True(t, ok)
+	assert.Equal(t, 3, number)
+	_, ok = getKeyNumber("PROJ")
+	assert.False(t, ok)
+	_, ok = getKeyNumber("PROJ-x")
+	assert.False(t, ok)
+}
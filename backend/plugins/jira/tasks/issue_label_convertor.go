@@ -47,6 +47,7 @@ func ConvertIssueLabels(taskCtx plugin.SubTaskContext) errors.Error {
 		dal.Join(`LEFT JOIN _tool_jira_board_issues jbi
               ON jil.connection_id = jbi.connection_id AND jil.issue_id = jbi.issue_id`),
 		dal.Where("jil.connection_id = ? AND jbi.board_id = ?", data.Options.ConnectionId, data.Options.BoardId),
+		securityLevelFilter("jil.connection_id", "jil.issue_id"),
 		dal.Orderby("issue_id ASC"),
 	)
 	if err != nil {
@@ -54,6 +55,13 @@ func ConvertIssueLabels(taskCtx plugin.SubTaskContext) errors.Error {
 	}
 	defer cursor.Close()
 	issueIdGen := didgen.NewDomainIdGenerator(&models.JiraIssue{})
+	var separator string
+	if data.Options.ScopeConfig != nil {
+		separator = data.Options.ScopeConfig.LabelHierarchySeparator
+	}
+	// labels sharing ancestors must not emit them twice, rows are sorted by issue
+	var lastIssueId uint64
+	var emitted map[string]bool
 
 	converter, err := helper.NewDataConverter(helper.DataConverterArgs{
 		RawDataSubTaskArgs: helper.RawDataSubTaskArgs{
@@ -68,13 +76,22 @@ func ConvertIssueLabels(taskCtx plugin.SubTaskContext) errors.Error {
 		Input:        cursor,
 		Convert: func(inputRow interface{}) ([]interface{}, errors.Error) {
 			issueLabel := inputRow.(*models.JiraIssueLabel)
-			domainIssueLabel := &ticket.IssueLabel{
-				IssueId:   issueIdGen.Generate(data.Options.ConnectionId, issueLabel.IssueId),
-				LabelName: issueLabel.LabelName,
+			if emitted == nil || issueLabel.IssueId != lastIssueId {
+				lastIssueId = issueLabel.IssueId
+				emitted = make(map[string]bool)
+			}
+			var result []interface{}
+			for _, level := range expandLabel(issueLabel.GetCanonicalName(), separator) {
+				if emitted[level] {
+					continue
+				}
+				emitted[level] = true
+				result = append(result, &ticket.IssueLabel{
+					IssueId:   issueIdGen.Generate(data.Options.ConnectionId, issueLabel.IssueId),
+					LabelName: level,
+				})
 			}
-			return []interface{}{
-				domainIssueLabel,
-			}, nil
+			return result, nil
 		},
 	})
 	if err != nil {
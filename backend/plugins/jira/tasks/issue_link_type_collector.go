@@ -0,0 +1,72 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+)
+
+const RAW_ISSUE_LINK_TYPE_TABLE = "jira_api_issue_link_types"
+
+var _ plugin.SubTaskEntryPoint = CollectIssueLinkTypes
+
+var CollectIssueLinkTypesMeta = plugin.SubTaskMeta{
+	Name:             "collectIssueLinkTypes",
+	EntryPoint:       CollectIssueLinkTypes,
+	EnabledByDefault: true,
+	RunsWhenStaged:   true,
+	Description:      "collect Jira issue link types, does not support either timeFilter or diffSync.",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+func CollectIssueLinkTypes(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*JiraTaskData)
+	collector, err := api.NewApiCollector(api.ApiCollectorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: JiraApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				BoardId:      data.Options.BoardId,
+			},
+			Table: RAW_ISSUE_LINK_TYPE_TABLE,
+		},
+		ApiClient:   data.ApiClient,
+		UrlTemplate: "api/2/issueLinkType",
+		ResponseParser: func(res *http.Response) ([]json.RawMessage, errors.Error) {
+			var result struct {
+				IssueLinkTypes []json.RawMessage `json:"issueLinkTypes"`
+			}
+			err := api.UnmarshalResponse(res, &result)
+			if err != nil {
+				return nil, err
+			}
+			return result.IssueLinkTypes, nil
+		},
+		// issue linking might be disabled on the instance
+		AfterResponse: ignoreHTTPStatus404,
+	})
+	if err != nil {
+		return err
+	}
+	return collector.Execute()
+}
@@ -0,0 +1,102 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ExtractIssueMentions
+
+var ExtractIssueMentionsMeta = plugin.SubTaskMeta{
+	Name:             "extractIssueMentions",
+	EntryPoint:       ExtractIssueMentions,
+	EnabledByDefault: true,
+	Description:      "extract issue keys and urls referenced inline by Jira issue descriptions and comments",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+// issueTexts holds the free-text parts of an issue, kept raw since they are ADF documents or plain strings
+// depending on the API version
+type issueTexts struct {
+	ID     uint64 `json:"id,string"`
+	Key    string `json:"key"`
+	Fields struct {
+		Description json.RawMessage `json:"description"`
+		Comment     struct {
+			Comments []struct {
+				ID   string          `json:"id"`
+				Body json.RawMessage `json:"body"`
+			} `json:"comments"`
+		} `json:"comment"`
+	} `json:"fields"`
+}
+
+func ExtractIssueMentions(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*JiraTaskData)
+	connectionId := data.Options.ConnectionId
+	extractor, err := api.NewApiExtractor(api.ApiExtractorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: JiraApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				BoardId:      data.Options.BoardId,
+			},
+			Table: RAW_ISSUE_TABLE,
+		},
+		Extract: func(row *api.RawData) ([]interface{}, errors.Error) {
+			var issue issueTexts
+			err := errors.Convert(json.Unmarshal(row.Data, &issue))
+			if err != nil {
+				return nil, err
+			}
+			var results []interface{}
+			toMentions := func(source string, body json.RawMessage) {
+				for i, ref := range extractTextReferences(body) {
+					// an issue mentioning itself carries no relationship
+					if ref.Kind == models.MentionKindIssueKey && ref.Value == issue.Key {
+						continue
+					}
+					results = append(results, &models.JiraIssueMention{
+						ConnectionId: connectionId,
+						IssueId:      issue.ID,
+						Source:       source,
+						Position:     i,
+						Kind:         ref.Kind,
+						Value:        ref.Value,
+					})
+				}
+			}
+			toMentions("description", issue.Fields.Description)
+			for _, comment := range issue.Fields.Comment.Comments {
+				toMentions(fmt.Sprintf("comment:%s", comment.ID), comment.Body)
+			}
+			return results, nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return extractor.Execute()
+}
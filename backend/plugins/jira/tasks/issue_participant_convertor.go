@@ -0,0 +1,89 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"reflect"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+var ConvertIssueParticipantsMeta = plugin.SubTaskMeta{
+	Name:             "convertIssueParticipants",
+	EntryPoint:       ConvertIssueParticipants,
+	EnabledByDefault: true,
+	Description:      "Convert tool layer table _tool_jira_issue_participants into domain layer table issue_participants",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET, plugin.DOMAIN_TYPE_CROSS},
+}
+
+func ConvertIssueParticipants(taskCtx plugin.SubTaskContext) errors.Error {
+	db := taskCtx.GetDal()
+	data := taskCtx.GetData().(*JiraTaskData)
+	if data.Options.ScopeConfig == nil || data.Options.ScopeConfig.RequestParticipantsField == "" {
+		return nil
+	}
+
+	cursor, err := db.Cursor(
+		dal.Select("jip.*"),
+		dal.From("_tool_jira_issue_participants jip"),
+		dal.Join(`LEFT JOIN _tool_jira_board_issues jbi
+              ON jip.connection_id = jbi.connection_id AND jip.issue_id = jbi.issue_id`),
+		dal.Where("jip.connection_id = ? AND jbi.board_id = ?", data.Options.ConnectionId, data.Options.BoardId),
+		securityLevelFilter("jip.connection_id", "jip.issue_id"),
+	)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+	issueIdGen := didgen.NewDomainIdGenerator(&models.JiraIssue{})
+	accountIdGen := didgen.NewDomainIdGenerator(&models.JiraAccount{})
+
+	converter, err := api.NewDataConverter(api.DataConverterArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: JiraApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				BoardId:      data.Options.BoardId,
+			},
+			Table: RAW_ISSUE_TABLE,
+		},
+		InputRowType: reflect.TypeOf(models.JiraIssueParticipant{}),
+		Input:        cursor,
+		Convert: func(inputRow interface{}) ([]interface{}, errors.Error) {
+			participant := inputRow.(*models.JiraIssueParticipant)
+			return []interface{}{
+				&ticket.IssueParticipant{
+					IssueId:   issueIdGen.Generate(data.Options.ConnectionId, participant.IssueId),
+					AccountId: accountIdGen.Generate(data.Options.ConnectionId, participant.AccountId),
+					Role:      participant.Role,
+				},
+			}, nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return converter.Execute()
+}
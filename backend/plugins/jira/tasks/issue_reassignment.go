@@ -0,0 +1,101 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"time"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+)
+
+// assigneeChange is an assignee changelog item along with whether the changelog of its issue may be incomplete
+type assigneeChange struct {
+	IssueId   uint64
+	FromValue string
+	Created   time.Time
+	Truncated bool
+}
+
+// reassignment holds the number of times an issue changed hands, Minimum is set when its changelog was truncated
+type reassignment struct {
+	Count   int
+	Minimum bool
+}
+
+// loadReassignments returns the reassignments of the issues belonging to the board, derived from the extracted
+// changelogs, issues without any assignee change are absent from the result
+func loadReassignments(db dal.Dal, connectionId, boardId uint64) (map[uint64]*reassignment, errors.Error) {
+	var changes []*assigneeChange
+	err := db.All(&changes,
+		dal.Select("c.issue_id, i.from_value, c.created"),
+		dal.From("_tool_jira_issue_changelog_items i"),
+		dal.Join(`JOIN _tool_jira_issue_changelogs c ON (c.connection_id = i.connection_id AND c.changelog_id = i.changelog_id)`),
+		dal.Join(`JOIN _tool_jira_board_issues bi ON (bi.connection_id = c.connection_id AND bi.issue_id = c.issue_id)`),
+		dal.Where("i.connection_id = ? AND bi.board_id = ? AND i.field = 'assignee'", connectionId, boardId),
+		dal.Orderby("c.issue_id, c.created, c.changelog_id"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	truncatedIssues, err := loadTruncatedChangelogIssues(db, connectionId, boardId)
+	if err != nil {
+		return nil, err
+	}
+	for _, change := range changes {
+		change.Truncated = truncatedIssues[change.IssueId]
+	}
+	return countReassignments(changes), nil
+}
+
+// loadTruncatedChangelogIssues returns the issues of the board whose changelogs may be incomplete, changelogs
+// extracted along with issues are left without issue_updated when more were to be collected
+func loadTruncatedChangelogIssues(db dal.Dal, connectionId, boardId uint64) (map[uint64]bool, errors.Error) {
+	var truncated []uint64
+	err := db.Pluck("DISTINCT c.issue_id", &truncated,
+		dal.From("_tool_jira_issue_changelogs c"),
+		dal.Join(`JOIN _tool_jira_board_issues bi ON (bi.connection_id = c.connection_id AND bi.issue_id = c.issue_id)`),
+		dal.Where("c.connection_id = ? AND bi.board_id = ? AND c.issue_updated IS NULL", connectionId, boardId),
+	)
+	if err != nil {
+		return nil, err
+	}
+	truncatedIssues := make(map[uint64]bool, len(truncated))
+	for _, issueId := range truncated {
+		truncatedIssues[issueId] = true
+	}
+	return truncatedIssues, nil
+}
+
+// countReassignments counts the assignee changes of each issue, sorted by issue and time, leaving out the initial
+// assignment of issues created unassigned
+func countReassignments(changes []*assigneeChange) map[uint64]*reassignment {
+	result := make(map[uint64]*reassignment)
+	for _, change := range changes {
+		r, ok := result[change.IssueId]
+		if !ok {
+			r = &reassignment{Minimum: change.Truncated}
+			result[change.IssueId] = r
+			if change.FromValue == "" {
+				continue
+			}
+		}
+		r.Count++
+	}
+	return result
+}
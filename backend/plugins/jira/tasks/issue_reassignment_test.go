@@ -0,0 +1,43 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountReassignments(t *testing.T) {
+	result := countReassignments([]*assigneeChange{
+		// created unassigned, then handed over twice
+		{IssueId: 1, FromValue: ""},
+		{IssueId: 1, FromValue: "alice"},
+		{IssueId: 1, FromValue: "bob"},
+		// created assigned, then unassigned and assigned again
+		{IssueId: 2, FromValue: "alice"},
+		{IssueId: 2, FromValue: ""},
+		// only the initial assignment is known
+		{IssueId: 3, FromValue: "", Truncated: true},
+	})
+	assert.Equal(t, map[uint64]*reassignment{
+		1: {Count: 2},
+		2: {Count: 2},
+		3: {Count: 0, Minimum: true},
+	}, result)
+}
@@ -0,0 +1,121 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/common"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ConvertIssueRelationships
+
+var ConvertIssueRelationshipsMeta = plugin.SubTaskMeta{
+	Name:             "convertIssueRelationships",
+	EntryPoint:       ConvertIssueRelationships,
+	EnabledByDefault: true,
+	Description:      "Convert the links between Jira issues and the issue keys they mention into domain layer table issue_relationships",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+// issueRelationship is a link or a mention from an issue of the board to another issue
+type issueRelationship struct {
+	SourceIssueId uint64
+	TargetIssueId uint64
+	OriginalType  string
+	OriginalLabel string
+	common.RawDataOrigin
+}
+
+// issueRelationshipsQuery unions the links of the issues of the board, labelled as seen from the issue holding them,
+// with the issue keys they mention matching a collected issue, the urls being left out. Both come out of the raw
+// issues, so they are converted together for the outdated relationships of either kind to be cleared on every run
+var issueRelationshipsQuery = fmt.Sprintf(`(
+	SELECT jir.connection_id, jir.issue_id AS source_issue_id, jir.related_issue_id AS target_issue_id,
+		jir.link_type_name AS original_type, jir.label AS original_label,
+		jir._raw_data_params, jir._raw_data_table, jir._raw_data_id, jir._raw_data_remark
+	FROM _tool_jira_issue_relationships jir
+	INNER JOIN _tool_jira_board_issues jbi ON jir.connection_id = jbi.connection_id AND jir.issue_id = jbi.issue_id
+	WHERE jir.connection_id = ? AND jbi.board_id = ? AND jir.related_issue_id != 0
+	UNION ALL
+	SELECT DISTINCT jim.connection_id, jim.issue_id AS source_issue_id, ji.issue_id AS target_issue_id,
+		'%[1]s' AS original_type, '%[1]s' AS original_label,
+		jim._raw_data_params, jim._raw_data_table, jim._raw_data_id, jim._raw_data_remark
+	FROM _tool_jira_issue_mentions jim
+	INNER JOIN _tool_jira_board_issues jbi ON jim.connection_id = jbi.connection_id AND jim.issue_id = jbi.issue_id
+	INNER JOIN _tool_jira_issues ji ON jim.connection_id = ji.connection_id AND jim.value = ji.issue_key
+	WHERE jim.connection_id = ? AND jbi.board_id = ? AND jim.kind = ? AND ji.issue_id <> jim.issue_id
+) r`, ticket.ISSUE_RELATIONSHIP_MENTIONS)
+
+// ConvertIssueRelationships turns the links and the mentions of the issues of the board into relationships, leaving
+// out the ones of either end excluded by its security level
+func ConvertIssueRelationships(taskCtx plugin.SubTaskContext) errors.Error {
+	db := taskCtx.GetDal()
+	data := taskCtx.GetData().(*JiraTaskData)
+	connectionId := data.Options.ConnectionId
+	boardId := data.Options.BoardId
+
+	cursor, err := db.Cursor(
+		dal.Select("r.*"),
+		dal.From(issueRelationshipsQuery, connectionId, boardId, connectionId, boardId, models.MentionKindIssueKey),
+		securityLevelFilter("r.connection_id", "r.source_issue_id"),
+		securityLevelFilter("r.connection_id", "r.target_issue_id"),
+	)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+	issueIdGen := didgen.NewDomainIdGenerator(&models.JiraIssue{})
+
+	converter, err := api.NewDataConverter(api.DataConverterArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: JiraApiParams{
+				ConnectionId: connectionId,
+				BoardId:      boardId,
+			},
+			Table: RAW_ISSUE_TABLE,
+		},
+		InputRowType: reflect.TypeOf(issueRelationship{}),
+		Input:        cursor,
+		Convert: func(inputRow interface{}) ([]interface{}, errors.Error) {
+			relationship := inputRow.(*issueRelationship)
+			return []interface{}{
+				&ticket.IssueRelationship{
+					SourceIssueId: issueIdGen.Generate(connectionId, relationship.SourceIssueId),
+					TargetIssueId: issueIdGen.Generate(connectionId, relationship.TargetIssueId),
+					OriginalType:  relationship.OriginalType,
+					OriginalLabel: relationship.OriginalLabel,
+				},
+			}, nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return converter.Execute()
+}
@@ -0,0 +1,235 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"strings"
+	"time"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+// reworkTransition is a status change of an issue along with the standard statuses it went from and to
+type reworkTransition struct {
+	IssueId       uint64
+	IssueKey      string
+	Type          string
+	FromValue     string
+	FromStatus    string
+	FromStdStatus string
+	ToValue       string
+	ToStatus      string
+	ToStdStatus   string
+	Created       time.Time
+}
+
+// stagedTransition is a transition of an issue into a stage, Rework being set when it went back to an earlier one
+type stagedTransition struct {
+	IssueId uint64
+	Created time.Time
+	Rework  bool
+}
+
+// rework holds the number of times an issue went back to an earlier stage, Minimum is set when its changelog was
+// truncated
+type rework struct {
+	Count   int
+	Minimum bool
+}
+
+// reworkStages looks up the stage of statuses by their Jira name first, then by their standard status
+type reworkStages struct {
+	names       map[string]int
+	stdStatuses map[string]int
+}
+
+func newReworkStages(stages []models.ReworkStage) *reworkStages {
+	if len(stages) == 0 {
+		stages = models.DefaultReworkStages
+	}
+	result := &reworkStages{
+		names:       make(map[string]int),
+		stdStatuses: make(map[string]int),
+	}
+	for i, stage := range stages {
+		for _, status := range stage.Statuses {
+			if _, ok := result.names[strings.ToLower(status)]; !ok {
+				result.names[strings.ToLower(status)] = i
+			}
+			if _, ok := result.stdStatuses[strings.ToUpper(status)]; !ok {
+				result.stdStatuses[strings.ToUpper(status)] = i
+			}
+		}
+	}
+	return result
+}
+
+// stage returns the stage a transition went to, false when its status belongs to no stage
+func (s *reworkStages) stage(transition *reworkTransition) (int, bool) {
+	if stage, ok := s.names[strings.ToLower(transition.ToStatus)]; ok {
+		return stage, true
+	}
+	if transition.ToStdStatus == "" {
+		return 0, false
+	}
+	stage, ok := s.stdStatuses[transition.ToStdStatus]
+	return stage, ok
+}
+
+// loadReworkTransitions returns the status transitions of the issues belonging to the board sorted by issue and
+// time, read from the compact transitions when the changelog mode keeps them and from the changelog items otherwise
+func loadReworkTransitions(db dal.Dal, data *JiraTaskData) ([]*reworkTransition, errors.Error) {
+	connectionId, boardId := data.Options.ConnectionId, data.Options.BoardId
+	var transitions []*reworkTransition
+	if readsStatusTransitions(data) {
+		err := db.All(&transitions,
+			dal.Select("t.issue_id, t.from_status, t.from_std_status, t.to_status, t.to_std_status, t.created"),
+			dal.From("_tool_jira_issue_status_transitions t"),
+			dal.Join(`JOIN _tool_jira_board_issues bi ON (bi.connection_id = t.connection_id AND bi.issue_id = t.issue_id)`),
+			dal.Where("t.connection_id = ? AND bi.board_id = ?", connectionId, boardId),
+			securityLevelFilter("t.connection_id", "t.issue_id"),
+			dal.Orderby("t.issue_id, t.created, t.changelog_id"),
+		)
+		return transitions, err
+	}
+	err := db.All(&transitions,
+		dal.Select("c.issue_id, ji.issue_key, ji.type, i.from_value, i.from_string AS from_status, i.to_value, i.to_string AS to_status, c.created"),
+		dal.From("_tool_jira_issue_changelog_items i"),
+		dal.Join(`JOIN _tool_jira_issue_changelogs c ON (c.connection_id = i.connection_id AND c.changelog_id = i.changelog_id)`),
+		dal.Join(`JOIN _tool_jira_board_issues bi ON (bi.connection_id = c.connection_id AND bi.issue_id = c.issue_id)`),
+		dal.Join(`JOIN _tool_jira_issues ji ON (ji.connection_id = c.connection_id AND ji.issue_id = c.issue_id)`),
+		dal.Where("i.connection_id = ? AND bi.board_id = ? AND i.field = 'status' AND ji.security_excluded = ?", connectionId, boardId, false),
+		dal.Orderby("c.issue_id, c.created, c.changelog_id"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	statusMappings, projectStatusMappings := getStatusMappings(data)
+	mapper, err := newStdStatusMapper(db, connectionId, statusMappings, projectStatusMappings)
+	if err != nil {
+		return nil, err
+	}
+	for _, transition := range transitions {
+		projectKey := getProjectKey(transition.IssueKey)
+		transition.FromStdStatus = mapper.stdStatus(projectKey, transition.Type, transition.FromValue)
+		transition.ToStdStatus = mapper.stdStatus(projectKey, transition.Type, transition.ToValue)
+	}
+	return transitions, nil
+}
+
+// loadStagedTransitions returns the staged transitions of the issues belonging to the board along with the issues
+// whose changelog was truncated
+func loadStagedTransitions(db dal.Dal, data *JiraTaskData) ([]*stagedTransition, map[uint64]bool, errors.Error) {
+	transitions, err := loadReworkTransitions(db, data)
+	if err != nil {
+		return nil, nil, err
+	}
+	truncatedIssues, err := loadTruncatedChangelogIssues(db, data.Options.ConnectionId, data.Options.BoardId)
+	if err != nil {
+		return nil, nil, err
+	}
+	var stages []models.ReworkStage
+	if data.Options.ScopeConfig != nil {
+		stages = data.Options.ScopeConfig.ReworkStages
+	}
+	return getStagedTransitions(transitions, newReworkStages(stages)), truncatedIssues, nil
+}
+
+// loadReworks returns the rework of the issues belonging to the board, issues never going back are absent from the
+// result unless their changelog was truncated
+func loadReworks(db dal.Dal, data *JiraTaskData) (map[uint64]*rework, errors.Error) {
+	staged, truncatedIssues, err := loadStagedTransitions(db, data)
+	if err != nil {
+		return nil, err
+	}
+	return countReworks(staged, truncatedIssues), nil
+}
+
+// getStagedTransitions keeps the transitions into a stage, sorted by issue and time, flagging the ones going back to
+// an earlier stage than the latest one of the issue. Statuses outside of the stages leave the latest stage as is
+func getStagedTransitions(transitions []*reworkTransition, stages *reworkStages) []*stagedTransition {
+	var result []*stagedTransition
+	latestStages := make(map[uint64]int)
+	for _, transition := range transitions {
+		stage, ok := stages.stage(transition)
+		if !ok {
+			continue
+		}
+		latestStage, seen := latestStages[transition.IssueId]
+		latestStages[transition.IssueId] = stage
+		result = append(result, &stagedTransition{
+			IssueId: transition.IssueId,
+			Created: transition.Created,
+			Rework:  seen && stage < latestStage,
+		})
+	}
+	return result
+}
+
+// countReworks counts the rework transitions of each issue
+func countReworks(staged []*stagedTransition, truncatedIssues map[uint64]bool) map[uint64]*rework {
+	result := make(map[uint64]*rework)
+	for issueId := range truncatedIssues {
+		result[issueId] = &rework{Minimum: true}
+	}
+	for _, transition := range staged {
+		if !transition.Rework {
+			continue
+		}
+		r, ok := result[transition.IssueId]
+		if !ok {
+			r = &rework{}
+			result[transition.IssueId] = r
+		}
+		r.Count++
+	}
+	return result
+}
+
+// getReworkRates sums up the staged transitions by the week and the month they happened in
+func getReworkRates(staged []*stagedTransition, truncatedIssues map[uint64]bool) map[throughputKey]*models.JiraBoardReworkRate {
+	result := make(map[throughputKey]*models.JiraBoardReworkRate)
+	activeIssues := make(map[throughputKey]map[uint64]bool)
+	reworkedIssues := make(map[throughputKey]map[uint64]bool)
+	for _, transition := range staged {
+		for _, periodType := range []string{models.ThroughputPeriodWeek, models.ThroughputPeriodMonth} {
+			key := throughputKey{periodType, getThroughputPeriodStart(transition.Created, periodType)}
+			rate, ok := result[key]
+			if !ok {
+				rate = &models.JiraBoardReworkRate{PeriodType: key.PeriodType, PeriodStart: key.PeriodStart}
+				result[key] = rate
+				activeIssues[key] = make(map[uint64]bool)
+				reworkedIssues[key] = make(map[uint64]bool)
+			}
+			rate.IsMinimum = rate.IsMinimum || truncatedIssues[transition.IssueId]
+			activeIssues[key][transition.IssueId] = true
+			if transition.Rework {
+				rate.ReworkCount++
+				reworkedIssues[key][transition.IssueId] = true
+			}
+		}
+	}
+	for key, rate := range result {
+		rate.ActiveIssueCount = len(activeIssues[key])
+		rate.ReworkedIssueCount = len(reworkedIssues[key])
+		rate.ReworkRate = float64(rate.ReworkedIssueCount) / float64(rate.ActiveIssueCount)
+	}
+	return result
+}
@@ -0,0 +1,90 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetStagedTransitions(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2023, 8, d, 10, 0, 0, 0, time.UTC)
+	}
+	stages := newReworkStages([]models.ReworkStage{
+		{Name: "Development", Statuses: []string{"IN_PROGRESS"}},
+		{Name: "QA", Statuses: []string{"In QA"}},
+		{Name: "Done", Statuses: []string{"DONE"}},
+	})
+	transitions := []*reworkTransition{
+		{IssueId: 1, ToStatus: "In Progress", ToStdStatus: ticket.IN_PROGRESS, Created: day(1)},
+		{IssueId: 1, ToStatus: "In QA", ToStdStatus: ticket.IN_PROGRESS, Created: day(2)},
+		{IssueId: 1, ToStatus: "In Progress", ToStdStatus: ticket.IN_PROGRESS, Created: day(3)},
+		{IssueId: 1, ToStatus: "in qa", ToStdStatus: ticket.IN_PROGRESS, Created: day(4)},
+		{IssueId: 1, ToStatus: "Done", ToStdStatus: ticket.DONE, Created: day(8)},
+		{IssueId: 1, ToStatus: "Reopened", ToStdStatus: ticket.TODO, Created: day(9)},
+		{IssueId: 1, ToStatus: "In Progress", ToStdStatus: ticket.IN_PROGRESS, Created: day(10)},
+		{IssueId: 2, ToStatus: "In Progress", ToStdStatus: ticket.IN_PROGRESS, Created: day(2)},
+		{IssueId: 2, ToStatus: "Done", ToStdStatus: ticket.DONE, Created: day(3)},
+	}
+	staged := getStagedTransitions(transitions, stages)
+	assert.Len(t, staged, 8)
+	var reworkDays []int
+	for _, transition := range staged {
+		if transition.Rework {
+			reworkDays = append(reworkDays, transition.Created.Day())
+		}
+	}
+	assert.Equal(t, []int{3, 10}, reworkDays)
+
+	reworks := countReworks(staged, map[uint64]bool{3: true})
+	assert.Equal(t, &rework{Count: 2}, reworks[1])
+	assert.Nil(t, reworks[2])
+	assert.Equal(t, &rework{Minimum: true}, reworks[3])
+
+	rates := getReworkRates(staged, map[uint64]bool{2: true})
+	week := rates[throughputKey{models.ThroughputPeriodWeek, time.Date(2023, 7, 31, 0, 0, 0, 0, time.UTC)}]
+	assert.Equal(t, 1, week.ReworkCount)
+	assert.Equal(t, 1, week.ReworkedIssueCount)
+	assert.Equal(t, 2, week.ActiveIssueCount)
+	assert.Equal(t, 0.5, week.ReworkRate)
+	assert.True(t, week.IsMinimum)
+	week = rates[throughputKey{models.ThroughputPeriodWeek, time.Date(2023, 8, 7, 0, 0, 0, 0, time.UTC)}]
+	assert.Equal(t, 1, week.ReworkCount)
+	assert.Equal(t, 1.0, week.ReworkRate)
+	assert.False(t, week.IsMinimum)
+	month := rates[throughputKey{models.ThroughputPeriodMonth, time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)}]
+	assert.Equal(t, 2, month.ReworkCount)
+	assert.Equal(t, 2, month.ActiveIssueCount)
+}
+
+func TestDefaultReworkStages(t *testing.T) {
+	staged := getStagedTransitions([]*reworkTransition{
+		{IssueId: 1, ToStatus: "In Progress", ToStdStatus: ticket.IN_PROGRESS},
+		{IssueId: 1, ToStatus: "Done", ToStdStatus: ticket.DONE},
+		{IssueId: 1, ToStatus: "To Do", ToStdStatus: ticket.TODO},
+		{IssueId: 1, ToStatus: "Unknown"},
+		{IssueId: 1, ToStatus: "In Review", ToStdStatus: ticket.IN_PROGRESS},
+	}, newReworkStages(nil))
+	assert.Len(t, staged, 3)
+	assert.True(t, staged[2].Rework)
+}
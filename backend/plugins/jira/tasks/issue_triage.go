@@ -0,0 +1,110 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"time"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+)
+
+// statusChange is a status changelog item of an issue
+type statusChange struct {
+	IssueId   uint64
+	FromValue string
+	ToValue   string
+	Created   time.Time
+}
+
+// triage is when an issue first left its initial status, Provisional is set when its changelog was truncated and
+// an earlier change may be missing
+type triage struct {
+	Left        time.Time
+	Provisional bool
+}
+
+// loadTriages returns when the issues belonging to the board first left their initial status, derived from the
+// extracted changelogs, issues that never moved are absent from the result. Statuses are compared by name when
+// read from the compact transitions
+func loadTriages(db dal.Dal, data *JiraTaskData) (map[uint64]*triage, errors.Error) {
+	connectionId, boardId := data.Options.ConnectionId, data.Options.BoardId
+	var changes []*statusChange
+	var err errors.Error
+	if readsStatusTransitions(data) {
+		err = db.All(&changes,
+			dal.Select("t.issue_id, t.from_status AS from_value, t.to_status AS to_value, t.created"),
+			dal.From("_tool_jira_issue_status_transitions t"),
+			dal.Join(`JOIN _tool_jira_board_issues bi ON (bi.connection_id = t.connection_id AND bi.issue_id = t.issue_id)`),
+			dal.Where("t.connection_id = ? AND bi.board_id = ?", connectionId, boardId),
+			dal.Orderby("t.issue_id, t.created, t.changelog_id"),
+		)
+	} else {
+		err = db.All(&changes,
+			dal.Select("c.issue_id, i.from_value, i.to_value, c.created"),
+			dal.From("_tool_jira_issue_changelog_items i"),
+			dal.Join(`JOIN _tool_jira_issue_changelogs c ON (c.connection_id = i.connection_id AND c.changelog_id = i.changelog_id)`),
+			dal.Join(`JOIN _tool_jira_board_issues bi ON (bi.connection_id = c.connection_id AND bi.issue_id = c.issue_id)`),
+			dal.Where("i.connection_id = ? AND bi.board_id = ? AND i.field = 'status'", connectionId, boardId),
+			dal.Orderby("c.issue_id, c.created, c.changelog_id"),
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+	truncatedIssues, err := loadTruncatedChangelogIssues(db, connectionId, boardId)
+	if err != nil {
+		return nil, err
+	}
+	return getTriages(changes, truncatedIssues), nil
+}
+
+// getTriages finds the first change of each issue to a status other than the one its first change started from,
+// the changes being sorted by issue and time
+func getTriages(changes []*statusChange, truncatedIssues map[uint64]bool) map[uint64]*triage {
+	result := make(map[uint64]*triage)
+	initialStatuses := make(map[uint64]string)
+	for _, change := range changes {
+		if _, ok := result[change.IssueId]; ok {
+			continue
+		}
+		initialStatus, ok := initialStatuses[change.IssueId]
+		if !ok {
+			initialStatus = change.FromValue
+			initialStatuses[change.IssueId] = initialStatus
+		}
+		if change.ToValue == initialStatus {
+			continue
+		}
+		result[change.IssueId] = &triage{
+			Left:        change.Created,
+			Provisional: truncatedIssues[change.IssueId],
+		}
+	}
+	return result
+}
+
+// getTriageMinutes returns the minutes from the creation of an issue to its triage, changelogs dated before the
+// creation of imported issues count as immediate
+func getTriageMinutes(created time.Time, t *triage) int64 {
+	minutes := int64(t.Left.Sub(created).Minutes())
+	if minutes < 0 {
+		return 0
+	}
+	return minutes
+}
@@ -0,0 +1,48 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTriages(t *testing.T) {
+	t0 := time.Date(2023, 8, 1, 9, 0, 0, 0, time.UTC)
+	triages := getTriages([]*statusChange{
+		{IssueId: 1, FromValue: "10000", ToValue: "10001", Created: t0.Add(time.Hour)},
+		{IssueId: 1, FromValue: "10001", ToValue: "10002", Created: t0.Add(2 * time.Hour)},
+		// moved back and forth before leaving the initial status
+		{IssueId: 2, FromValue: "10000", ToValue: "10000", Created: t0.Add(time.Hour)},
+		{IssueId: 2, FromValue: "10000", ToValue: "10001", Created: t0.Add(3 * time.Hour)},
+		{IssueId: 3, FromValue: "10001", ToValue: "10002", Created: t0.Add(4 * time.Hour)},
+	}, map[uint64]bool{3: true})
+	assert.Equal(t, map[uint64]*triage{
+		1: {Left: t0.Add(time.Hour)},
+		2: {Left: t0.Add(3 * time.Hour)},
+		3: {Left: t0.Add(4 * time.Hour), Provisional: true},
+	}, triages)
+}
+
+func TestGetTriageMinutes(t *testing.T) {
+	t0 := time.Date(2023, 8, 1, 9, 0, 0, 0, time.UTC)
+	assert.Equal(t, int64(90), getTriageMinutes(t0, &triage{Left: t0.Add(90 * time.Minute)}))
+	assert.Equal(t, int64(0), getTriageMinutes(t0, &triage{Left: t0.Add(-time.Hour)}))
+}
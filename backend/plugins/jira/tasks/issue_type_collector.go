@@ -35,6 +35,7 @@ var CollectIssueTypesMeta = plugin.SubTaskMeta{
 	Name:             "collectIssueTypes",
 	EntryPoint:       CollectIssueTypes,
 	EnabledByDefault: true,
+	RunsWhenStaged:   true,
 	Description:      "collect Jira issue_types, does not support either timeFilter or diffSync.",
 	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
 }
@@ -0,0 +1,64 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/apache/incubator-devlake/plugins/jira/tasks/apiv2models"
+)
+
+type issueVersionKey struct {
+	versionId   uint64
+	versionType string
+}
+
+// extractIssueVersions returns the versions an issue is fixed in and found in along with its joins to them, a
+// version listed as both is emitted once
+func extractIssueVersions(connectionId uint64, issue *models.JiraIssue, fixVersions, affectedVersions []apiv2models.Version) []interface{} {
+	var results []interface{}
+	versions := make(map[uint64]bool)
+	joins := make(map[issueVersionKey]bool)
+	extract := func(list []apiv2models.Version, versionType string) {
+		for _, version := range list {
+			if version.ID == 0 {
+				continue
+			}
+			if !versions[version.ID] {
+				versions[version.ID] = true
+				jiraVersion := version.ToToolLayer(connectionId, issue.ProjectId)
+				jiraVersion.ReleaseDate = parseDateField(version.ReleaseDate)
+				results = append(results, jiraVersion)
+			}
+			key := issueVersionKey{version.ID, versionType}
+			if joins[key] {
+				continue
+			}
+			joins[key] = true
+			results = append(results, &models.JiraIssueVersion{
+				ConnectionId: connectionId,
+				IssueId:      issue.IssueId,
+				VersionId:    version.ID,
+				VersionType:  versionType,
+			})
+		}
+	}
+	extract(fixVersions, ticket.FIX_VERSION)
+	extract(affectedVersions, ticket.AFFECTED_VERSION)
+	return results
+}
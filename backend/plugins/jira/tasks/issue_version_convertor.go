@@ -0,0 +1,117 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"reflect"
+	"strconv"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+var ConvertIssueVersionsMeta = plugin.SubTaskMeta{
+	Name:             "convertIssueVersions",
+	EntryPoint:       ConvertIssueVersions,
+	EnabledByDefault: true,
+	Description:      "Convert the fix and affected versions of Jira issues into domain layer tables versions and issue_versions",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+func ConvertIssueVersions(taskCtx plugin.SubTaskContext) errors.Error {
+	db := taskCtx.GetDal()
+	data := taskCtx.GetData().(*JiraTaskData)
+	connectionId := data.Options.ConnectionId
+
+	// fix and affected versions share the version entities
+	var jiraVersions []*models.JiraVersion
+	err := db.All(&jiraVersions, dal.Where("connection_id = ?", connectionId))
+	if err != nil {
+		return err
+	}
+	versionsById := make(map[uint64]*models.JiraVersion, len(jiraVersions))
+	for _, version := range jiraVersions {
+		versionsById[version.VersionId] = version
+	}
+
+	cursor, err := db.Cursor(
+		dal.Select("jiv.*"),
+		dal.From("_tool_jira_issue_versions jiv"),
+		dal.Join(`LEFT JOIN _tool_jira_board_issues jbi
+              ON jiv.connection_id = jbi.connection_id AND jiv.issue_id = jbi.issue_id`),
+		dal.Where("jiv.connection_id = ? AND jbi.board_id = ?", connectionId, data.Options.BoardId),
+		securityLevelFilter("jiv.connection_id", "jiv.issue_id"),
+	)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+	issueIdGen := didgen.NewDomainIdGenerator(&models.JiraIssue{})
+	versionIdGen := didgen.NewDomainIdGenerator(&models.JiraVersion{})
+	projectIdGen := didgen.NewDomainIdGenerator(&models.JiraProject{})
+
+	converter, err := api.NewDataConverter(api.DataConverterArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: JiraApiParams{
+				ConnectionId: connectionId,
+				BoardId:      data.Options.BoardId,
+			},
+			Table: RAW_ISSUE_TABLE,
+		},
+		InputRowType: reflect.TypeOf(models.JiraIssueVersion{}),
+		Input:        cursor,
+		Convert: func(inputRow interface{}) ([]interface{}, errors.Error) {
+			issueVersion := inputRow.(*models.JiraIssueVersion)
+			versionId := versionIdGen.Generate(connectionId, issueVersion.VersionId)
+			results := []interface{}{
+				&ticket.IssueVersion{
+					IssueId:     issueIdGen.Generate(connectionId, issueVersion.IssueId),
+					VersionId:   versionId,
+					VersionType: issueVersion.VersionType,
+				},
+			}
+			if jiraVersion, ok := versionsById[issueVersion.VersionId]; ok {
+				version := &ticket.Version{
+					DomainEntity: domainlayer.DomainEntity{Id: versionId},
+					Name:         jiraVersion.Name,
+					Description:  jiraVersion.Description,
+					Archived:     jiraVersion.Archived,
+					Released:     jiraVersion.Released,
+					ReleaseDate:  jiraVersion.ReleaseDate,
+				}
+				if jiraVersion.ProjectId != 0 {
+					version.ProjectId = projectIdGen.Generate(connectionId, strconv.FormatUint(jiraVersion.ProjectId, 10))
+				}
+				results = append(results, version)
+			}
+			return results, nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return converter.Execute()
+}
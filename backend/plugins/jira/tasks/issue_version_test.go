@@ -0,0 +1,46 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/apache/incubator-devlake/plugins/jira/tasks/apiv2models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractIssueVersions(t *testing.T) {
+	issue := &models.JiraIssue{ConnectionId: 1, IssueId: 10, ProjectId: 100}
+	v1 := apiv2models.Version{ID: 1, Name: "v1.0", Released: true, ReleaseDate: "2023-08-01"}
+	v2 := apiv2models.Version{ID: 2, Name: "v1.1"}
+	results := extractIssueVersions(1, issue, []apiv2models.Version{v2}, []apiv2models.Version{v1, v2, v1})
+
+	releaseDate := time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, []interface{}{
+		&models.JiraVersion{ConnectionId: 1, VersionId: 2, ProjectId: 100, Name: "v1.1"},
+		&models.JiraIssueVersion{ConnectionId: 1, IssueId: 10, VersionId: 2, VersionType: ticket.FIX_VERSION},
+		&models.JiraVersion{ConnectionId: 1, VersionId: 1, ProjectId: 100, Name: "v1.0", Released: true, ReleaseDate: &releaseDate},
+		&models.JiraIssueVersion{ConnectionId: 1, IssueId: 10, VersionId: 1, VersionType: ticket.AFFECTED_VERSION},
+		&models.JiraIssueVersion{ConnectionId: 1, IssueId: 10, VersionId: 2, VersionType: ticket.AFFECTED_VERSION},
+	}, results)
+
+	assert.Empty(t, extractIssueVersions(1, issue, nil, nil))
+}
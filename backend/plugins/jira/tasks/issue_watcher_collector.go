@@ -0,0 +1,100 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/jira/tasks/apiv2models"
+)
+
+const RAW_ISSUE_WATCHER_TABLE = "jira_api_issue_watchers"
+
+var _ plugin.SubTaskEntryPoint = CollectIssueWatchers
+
+var CollectIssueWatchersMeta = plugin.SubTaskMeta{
+	Name:             "collectIssueWatchers",
+	EntryPoint:       CollectIssueWatchers,
+	EnabledByDefault: true,
+	RunsWhenStaged:   true,
+	Description:      "collect Jira issue watchers of issues watched by at least `watchersMinCount` accounts, the watchers are collected in full on every run.",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+// CollectIssueWatchers requests the watchers of each issue reaching the threshold of the scope config, the issue
+// payload only carries the count. Calls go through the rate-limited async client one issue at a time. Watching an
+// issue does not bump its update time, so the watchers of every issue are requested again on each run.
+func CollectIssueWatchers(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*JiraTaskData)
+	if data.Options.ScopeConfig == nil || data.Options.ScopeConfig.WatchersMinCount <= 0 {
+		return nil
+	}
+	db := taskCtx.GetDal()
+	logger := taskCtx.GetLogger()
+	logger.Info("collect issue watchers")
+
+	cursor, err := db.Cursor(
+		dal.Select("i.issue_id AS issue_id"),
+		dal.From("_tool_jira_board_issues bi"),
+		dal.Join("JOIN _tool_jira_issues i ON (bi.connection_id = i.connection_id AND bi.issue_id = i.issue_id)"),
+		dal.Where("bi.connection_id=? and bi.board_id = ? AND i.watch_count >= ?",
+			data.Options.ConnectionId, data.Options.BoardId, data.Options.ScopeConfig.WatchersMinCount),
+	)
+	if err != nil {
+		return err
+	}
+	iterator, err := api.NewDalCursorIterator(db, cursor, reflect.TypeOf(apiv2models.Input{}))
+	if err != nil {
+		return err
+	}
+
+	collector, err := api.NewApiCollector(api.ApiCollectorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: JiraApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				BoardId:      data.Options.BoardId,
+			},
+			Table: RAW_ISSUE_WATCHER_TABLE,
+		},
+		ApiClient:   data.ApiClient,
+		Input:       iterator,
+		UrlTemplate: "api/2/issue/{{ .Input.IssueId }}/watchers",
+		ResponseParser: func(res *http.Response) ([]json.RawMessage, errors.Error) {
+			var result struct {
+				Watchers []json.RawMessage `json:"watchers"`
+			}
+			err := api.UnmarshalResponse(res, &result)
+			if err != nil {
+				return nil, err
+			}
+			return result.Watchers, nil
+		},
+		AfterResponse: ignoreHTTPStatus404,
+	})
+	if err != nil {
+		return err
+	}
+	return collector.Execute()
+}
@@ -0,0 +1,123 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"sort"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ConvertLabelTeams
+
+var ConvertLabelTeamsMeta = plugin.SubTaskMeta{
+	Name:             "convertLabelTeams",
+	EntryPoint:       ConvertLabelTeams,
+	EnabledByDefault: true,
+	Description:      "assign Jira issues left without team by their components to a team by their labels",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+// ConvertLabelTeams assigns the issues of the board to teams following the label to team mappings uploaded for the
+// connection, matching the canonical names of the labels. Component teams take precedence, labels only fill the issues
+// they left without team
+func ConvertLabelTeams(taskCtx plugin.SubTaskContext) errors.Error {
+	db := taskCtx.GetDal()
+	logger := taskCtx.GetLogger()
+	data := taskCtx.GetData().(*JiraTaskData)
+	connectionId := data.Options.ConnectionId
+
+	var mappings []*models.JiraLabelTeamMapping
+	err := db.All(&mappings, dal.Where("connection_id = ?", connectionId))
+	if err != nil {
+		return err
+	}
+	if len(mappings) == 0 {
+		return nil
+	}
+
+	var labels []*models.JiraIssueLabel
+	err = db.All(&labels,
+		dal.Select("il.issue_id, il.label_name, il.canonical_name"),
+		dal.From("_tool_jira_issue_labels il"),
+		dal.Join(`JOIN _tool_jira_board_issues bi ON (bi.connection_id = il.connection_id AND bi.issue_id = il.issue_id)`),
+		dal.Where("il.connection_id = ? AND bi.board_id = ?", connectionId, data.Options.BoardId),
+		securityLevelFilter("il.connection_id", "il.issue_id"),
+	)
+	if err != nil {
+		return err
+	}
+	issueLabels := make(map[uint64][]string)
+	for _, l := range labels {
+		issueLabels[l.IssueId] = append(issueLabels[l.IssueId], l.GetCanonicalName())
+	}
+	teams, unmapped := getLabelTeams(issueLabels, mappings)
+
+	issueIdGen := didgen.NewDomainIdGenerator(&models.JiraIssue{})
+	for issueId, teamId := range teams {
+		err = db.UpdateColumn(&ticket.Issue{}, "team_id", teamId,
+			dal.Where("id = ? AND (team_id = '' OR team_id IS NULL)", issueIdGen.Generate(connectionId, issueId)),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	if len(unmapped) > 0 {
+		logger.Warn(nil, "%d labels of the board have no team mapping: %v", len(unmapped), unmapped)
+	}
+	logger.Info("assigned %d issues to teams by their labels", len(teams))
+	return nil
+}
+
+// getLabelTeams returns the team of each issue with a mapped label, the mapping with the lowest priority winning,
+// then the first label alphabetically. The labels without mapping are returned sorted for reporting
+func getLabelTeams(issueLabels map[uint64][]string, mappings []*models.JiraLabelTeamMapping) (map[uint64]string, []string) {
+	byLabel := make(map[string]*models.JiraLabelTeamMapping, len(mappings))
+	for _, m := range mappings {
+		byLabel[m.Label] = m
+	}
+	teams := make(map[uint64]string)
+	unmappedSet := make(map[string]bool)
+	for issueId, labels := range issueLabels {
+		var best *models.JiraLabelTeamMapping
+		for _, label := range labels {
+			m, ok := byLabel[label]
+			if !ok {
+				unmappedSet[label] = true
+				continue
+			}
+			if best == nil || m.Priority < best.Priority || (m.Priority == best.Priority && m.Label < best.Label) {
+				best = m
+			}
+		}
+		if best != nil {
+			teams[issueId] = best.TeamId
+		}
+	}
+	unmapped := make([]string, 0, len(unmappedSet))
+	for label := range unmappedSet {
+		unmapped = append(unmapped, label)
+	}
+	sort.Strings(unmapped)
+	return teams, unmapped
+}
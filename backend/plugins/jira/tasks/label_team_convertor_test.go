@@ -0,0 +1,44 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetLabelTeams(t *testing.T) {
+	teams, unmapped := getLabelTeams(
+		map[uint64][]string{
+			1: {"backend"},
+			2: {"frontend", "backend"},
+			3: {"infra", "security"},
+			4: {"wontfix"},
+		},
+		[]*models.JiraLabelTeamMapping{
+			{Label: "backend", TeamId: "team-be", Priority: 2},
+			{Label: "frontend", TeamId: "team-fe", Priority: 1},
+			{Label: "infra", TeamId: "team-ops", Priority: 3},
+			{Label: "security", TeamId: "team-sec", Priority: 3},
+		},
+	)
+	assert.Equal(t, map[uint64]string{1: "team-be", 2: "team-fe", 3: "team-ops"}, teams)
+	assert.Equal(t, []string{"wontfix"}, unmapped)
+}
@@ -0,0 +1,118 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ConvertPortfolioItems
+
+var ConvertPortfolioItemsMeta = plugin.SubTaskMeta{
+	Name:             "convertPortfolioItems",
+	EntryPoint:       ConvertPortfolioItems,
+	EnabledByDefault: true,
+	Description:      "roll Jira issues up to the epics and initiatives above them",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+// epicHierarchyLevel is the level of epics in the hierarchy, the levels above are initiatives
+const epicHierarchyLevel = 1
+
+// ConvertPortfolioItems rebuilds the portfolio of the connection out of the parents of its issues, the same way
+// as ConvertIssueHierarchy. Jira Server doesn't report hierarchy levels, its portfolio stays empty
+func ConvertPortfolioItems(taskCtx plugin.SubTaskContext) errors.Error {
+	db := taskCtx.GetDal()
+	logger := taskCtx.GetLogger()
+	data := taskCtx.GetData().(*JiraTaskData)
+	connectionId := data.Options.ConnectionId
+	maxDepth := models.DefaultMaxHierarchyDepth
+	if data.Options.ScopeConfig != nil && data.Options.ScopeConfig.MaxHierarchyDepth > 0 {
+		maxDepth = data.Options.ScopeConfig.MaxHierarchyDepth
+	}
+
+	var issues []*hierarchyIssue
+	err := db.All(&issues,
+		dal.Select("issue_id, issue_key, parent_id, epic_key, hierarchy_level, security_excluded"),
+		dal.From(&models.JiraIssue{}),
+		dal.Where("connection_id = ?", connectionId),
+	)
+	if err != nil {
+		return err
+	}
+	items := getPortfolioItems(connectionId, issues, maxDepth)
+
+	err = db.Delete(&models.JiraPortfolioItem{}, dal.Where("connection_id = ?", connectionId))
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		err = db.CreateOrUpdate(item)
+		if err != nil {
+			return err
+		}
+	}
+	logger.Info("rolled %d issues up to their initiatives", len(items))
+	return nil
+}
+
+// getPortfolioItems places every issue having an initiative among its ancestors, or being one, under the closest
+// initiative and the closest epic below it. Issues without hierarchy level or excluded by their security level are
+// left out, the latter still linking their children to the initiative above them
+func getPortfolioItems(connectionId uint64, issues []*hierarchyIssue, maxDepth int) []*models.JiraPortfolioItem {
+	parents := getIssueParents(issues)
+	levels := make(map[uint64]int, len(issues))
+	for _, issue := range issues {
+		if issue.HierarchyLevel != nil {
+			levels[issue.IssueId] = *issue.HierarchyLevel
+		}
+	}
+	var items []*models.JiraPortfolioItem
+	for _, issue := range issues {
+		level, ok := levels[issue.IssueId]
+		if !ok || issue.SecurityExcluded {
+			continue
+		}
+		item := &models.JiraPortfolioItem{
+			ConnectionId:   connectionId,
+			IssueId:        issue.IssueId,
+			HierarchyLevel: level,
+		}
+		visited := make(map[uint64]bool)
+		for current, depth := issue.IssueId, 0; current != 0 && !visited[current] && depth <= maxDepth; depth++ {
+			visited[current] = true
+			if currentLevel, ok := levels[current]; ok {
+				if currentLevel > epicHierarchyLevel {
+					item.InitiativeId = current
+					break
+				}
+				if currentLevel == epicHierarchyLevel && item.EpicId == 0 {
+					item.EpicId = current
+				}
+			}
+			current = parents[current]
+		}
+		if item.InitiativeId != 0 {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,61 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPortfolioItems(t *testing.T) {
+	level := func(l int) *int { return &l }
+	issues := []*hierarchyIssue{
+		{IssueId: 1, IssueKey: "I-1", HierarchyLevel: level(2)},
+		{IssueId: 2, IssueKey: "I-2", HierarchyLevel: level(2)},
+		{IssueId: 3, IssueKey: "E-3", ParentId: 1, HierarchyLevel: level(1)},
+		{IssueId: 4, IssueKey: "S-4", EpicKey: "E-3", HierarchyLevel: level(0)},
+		{IssueId: 5, IssueKey: "T-5", ParentId: 4, HierarchyLevel: level(-1)},
+		{IssueId: 6, IssueKey: "S-6", ParentId: 1, HierarchyLevel: level(0)},
+		{IssueId: 7, IssueKey: "E-7", HierarchyLevel: level(1)},
+		{IssueId: 8, IssueKey: "S-8", ParentId: 7},
+	}
+	assert.Equal(t, []*models.JiraPortfolioItem{
+		{ConnectionId: 1, IssueId: 1, InitiativeId: 1, HierarchyLevel: 2},
+		// initiatives without children are items as well
+		{ConnectionId: 1, IssueId: 2, InitiativeId: 2, HierarchyLevel: 2},
+		{ConnectionId: 1, IssueId: 3, InitiativeId: 1, EpicId: 3, HierarchyLevel: 1},
+		{ConnectionId: 1, IssueId: 4, InitiativeId: 1, EpicId: 3, HierarchyLevel: 0},
+		{ConnectionId: 1, IssueId: 5, InitiativeId: 1, EpicId: 3, HierarchyLevel: -1},
+		{ConnectionId: 1, IssueId: 6, InitiativeId: 1, HierarchyLevel: 0},
+	}, getPortfolioItems(1, issues, models.DefaultMaxHierarchyDepth))
+
+	// the walk stops at the maximum depth
+	assert.Len(t, getPortfolioItems(1, issues, 1), 4)
+
+	// issues excluded by their security level are left out while their children still roll up through them
+	issues[2].SecurityExcluded = true
+	assert.Equal(t, []*models.JiraPortfolioItem{
+		{ConnectionId: 1, IssueId: 1, InitiativeId: 1, HierarchyLevel: 2},
+		{ConnectionId: 1, IssueId: 2, InitiativeId: 2, HierarchyLevel: 2},
+		{ConnectionId: 1, IssueId: 4, InitiativeId: 1, EpicId: 3, HierarchyLevel: 0},
+		{ConnectionId: 1, IssueId: 5, InitiativeId: 1, EpicId: 3, HierarchyLevel: -1},
+		{ConnectionId: 1, IssueId: 6, InitiativeId: 1, HierarchyLevel: 0},
+	}, getPortfolioItems(1, issues, models.DefaultMaxHierarchyDepth))
+}
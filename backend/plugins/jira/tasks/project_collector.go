@@ -35,6 +35,7 @@ var CollectProjectsMeta = plugin.SubTaskMeta{
 	Name:             "collectProjects",
 	EntryPoint:       CollectProjects,
 	EnabledByDefault: true,
+	RunsWhenStaged:   true,
 	Description:      "collect Jira projects, does not support either timeFilter or diffSync.",
 	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
 }
@@ -58,6 +59,7 @@ func CollectProjects(taskCtx plugin.SubTaskContext) errors.Error {
 		Query: func(reqData *api.RequestData) (url.Values, errors.Error) {
 			query := url.Values{}
 			query.Set("jql", jql)
+			query.Set("expand", "description,lead")
 			return query, nil
 		},
 		GetTotalPages: GetTotalPagesFromResponse,
@@ -0,0 +1,114 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"net/url"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+var ConvertProjectsMeta = plugin.SubTaskMeta{
+	Name:             "convertProjects",
+	EntryPoint:       ConvertProjects,
+	EnabledByDefault: true,
+	Description:      "convert Jira projects",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+func ConvertProjects(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*JiraTaskData)
+	db := taskCtx.GetDal()
+	connectionId := data.Options.ConnectionId
+	cursor, err := db.Cursor(
+		dal.From(&models.JiraProject{}),
+		dal.Where("connection_id = ?", connectionId),
+	)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+
+	projectIdGen := didgen.NewDomainIdGenerator(&models.JiraProject{})
+	accountIdGen := didgen.NewDomainIdGenerator(&models.JiraAccount{})
+	converter, err := api.NewDataConverter(api.DataConverterArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: JiraApiParams{
+				ConnectionId: connectionId,
+				BoardId:      data.Options.BoardId,
+			},
+			Table: RAW_PROJECT_TABLE,
+		},
+		InputRowType: reflect.TypeOf(models.JiraProject{}),
+		Input:        cursor,
+		Convert: func(inputRow interface{}) ([]interface{}, errors.Error) {
+			jiraProject := inputRow.(*models.JiraProject)
+			project := &ticket.TicketProject{
+				DomainEntity: domainlayer.DomainEntity{
+					Id: projectIdGen.Generate(connectionId, jiraProject.Id),
+				},
+				Name:            jiraProject.Name,
+				ProjectKey:      jiraProject.ProjectKey,
+				Url:             convertProjectURL(jiraProject.Self, jiraProject.ProjectKey),
+				Description:     jiraProject.Description,
+				Category:        jiraProject.CategoryName,
+				Type:            strings.ToUpper(jiraProject.ProjectTypeKey),
+				ManagementStyle: getProjectManagementStyle(jiraProject.Style),
+			}
+			if jiraProject.LeadAccountId != "" {
+				project.LeadId = accountIdGen.Generate(connectionId, jiraProject.LeadAccountId)
+			}
+			return []interface{}{project}, nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return converter.Execute()
+}
+
+// getProjectManagementStyle maps the style of Jira Cloud projects, Jira Server only has company-managed projects
+func getProjectManagementStyle(style string) string {
+	if style == "next-gen" {
+		return ticket.TEAM_MANAGED
+	}
+	return ticket.COMPANY_MANAGED
+}
+
+// convertProjectURL turns the api url of a project into the url of its page
+func convertProjectURL(api, projectKey string) string {
+	u, err := url.Parse(api)
+	if err != nil || api == "" {
+		return api
+	}
+	before, _, _ := strings.Cut(u.Path, "/rest/api/")
+	u.Path = filepath.Join(before, "browse", projectKey)
+	return u.String()
+}
@@ -0,0 +1,37 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetProjectManagementStyle(t *testing.T) {
+	assert.Equal(t, ticket.TEAM_MANAGED, getProjectManagementStyle("next-gen"))
+	assert.Equal(t, ticket.COMPANY_MANAGED, getProjectManagementStyle("classic"))
+	assert.Equal(t, ticket.COMPANY_MANAGED, getProjectManagementStyle(""))
+}
+
+func TestConvertProjectURL(t *testing.T) {
+	assert.Equal(t, "https://example.atlassian.net/browse/DL", convertProjectURL("https://example.atlassian.net/rest/api/2/project/10000", "DL"))
+	assert.Equal(t, "https://example.com/jira/browse/DL", convertProjectURL("https://example.com/jira/rest/api/2/project/10000", "DL"))
+	assert.Equal(t, "", convertProjectURL("", "DL"))
+}
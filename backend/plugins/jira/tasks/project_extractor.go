@@ -52,7 +52,14 @@ func ExtractProjects(taskCtx plugin.SubTaskContext) errors.Error {
 			if err != nil {
 				return nil, err
 			}
-			return []interface{}{project.ToToolLayer(data.Options.ConnectionId)}, nil
+			results := []interface{}{project.ToToolLayer(data.Options.ConnectionId)}
+			// the project lead is registered as an account
+			if project.Lead != nil {
+				if lead := project.Lead.ToToolLayer(data.Options.ConnectionId); lead != nil {
+					results = append(results, lead)
+				}
+			}
+			return results, nil
 		},
 	})
 
@@ -0,0 +1,93 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+)
+
+const RAW_PROJECT_STATUS_TABLE = "jira_api_project_statuses"
+
+var _ plugin.SubTaskEntryPoint = CollectProjectStatuses
+
+var CollectProjectStatusesMeta = plugin.SubTaskMeta{
+	Name:             "collectProjectStatuses",
+	EntryPoint:       CollectProjectStatuses,
+	EnabledByDefault: true,
+	RunsWhenStaged:   true,
+	Description:      "collect the workflow statuses of the projects of the board issues, does not support either timeFilter or diffSync.",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+type ProjectInput struct {
+	ProjectId uint64
+}
+
+func CollectProjectStatuses(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*JiraTaskData)
+	db := taskCtx.GetDal()
+	logger := taskCtx.GetLogger()
+	logger.Info("collect project statuses")
+
+	cursor, err := db.Cursor(
+		dal.Select("DISTINCT i.project_id"),
+		dal.From("_tool_jira_board_issues bi"),
+		dal.Join("JOIN _tool_jira_issues i ON (bi.connection_id = i.connection_id AND bi.issue_id = i.issue_id)"),
+		dal.Where("bi.connection_id = ? AND bi.board_id = ? AND i.project_id != 0", data.Options.ConnectionId, data.Options.BoardId),
+	)
+	if err != nil {
+		return err
+	}
+	iterator, err := api.NewDalCursorIterator(db, cursor, reflect.TypeOf(ProjectInput{}))
+	if err != nil {
+		return err
+	}
+	collector, err := api.NewApiCollector(api.ApiCollectorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: JiraApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				BoardId:      data.Options.BoardId,
+			},
+			Table: RAW_PROJECT_STATUS_TABLE,
+		},
+		ApiClient:   data.ApiClient,
+		Input:       iterator,
+		UrlTemplate: "api/2/project/{{ .Input.ProjectId }}/statuses",
+		ResponseParser: func(res *http.Response) ([]json.RawMessage, errors.Error) {
+			if res.StatusCode == http.StatusNotFound {
+				return nil, nil
+			}
+			var result []json.RawMessage
+			err := api.UnmarshalResponse(res, &result)
+			return result, err
+		},
+		AfterResponse: ignoreHTTPStatus404,
+	})
+	if err != nil {
+		return err
+	}
+	return collector.Execute()
+}
@@ -0,0 +1,82 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/apache/incubator-devlake/plugins/jira/tasks/apiv2models"
+)
+
+var _ plugin.SubTaskEntryPoint = ExtractProjectStatuses
+
+var ExtractProjectStatusesMeta = plugin.SubTaskMeta{
+	Name:             "extractProjectStatuses",
+	EntryPoint:       ExtractProjectStatuses,
+	EnabledByDefault: true,
+	Description:      "extract the workflow statuses of Jira projects",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+func ExtractProjectStatuses(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*JiraTaskData)
+	connectionId := data.Options.ConnectionId
+	extractor, err := api.NewApiExtractor(api.ApiExtractorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: JiraApiParams{
+				ConnectionId: connectionId,
+				BoardId:      data.Options.BoardId,
+			},
+			Table: RAW_PROJECT_STATUS_TABLE,
+		},
+		Extract: func(row *api.RawData) ([]interface{}, errors.Error) {
+			var issueType apiv2models.IssueTypeStatuses
+			err := errors.Convert(json.Unmarshal(row.Data, &issueType))
+			if err != nil {
+				return nil, err
+			}
+			var input ProjectInput
+			err = errors.Convert(json.Unmarshal(row.Input, &input))
+			if err != nil {
+				return nil, err
+			}
+			var result []interface{}
+			for _, status := range issueType.Statuses {
+				result = append(result, &models.JiraProjectStatus{
+					ConnectionId:   connectionId,
+					ProjectId:      input.ProjectId,
+					IssueTypeId:    issueType.ID,
+					StatusId:       status.ID,
+					IssueTypeName:  issueType.Name,
+					StatusName:     status.Name,
+					StatusCategory: status.StatusCategory.Key,
+				})
+			}
+			return result, nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return extractor.Execute()
+}
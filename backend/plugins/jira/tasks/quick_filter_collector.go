@@ -0,0 +1,89 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+)
+
+const RAW_QUICK_FILTER_TABLE = "jira_api_quick_filters"
+
+var _ plugin.SubTaskEntryPoint = CollectQuickFilters
+
+var CollectQuickFiltersMeta = plugin.SubTaskMeta{
+	Name:             "collectQuickFilters",
+	EntryPoint:       CollectQuickFilters,
+	EnabledByDefault: true,
+	RunsWhenStaged:   true,
+	Description:      "collect Jira board quick filters, does not support either timeFilter or diffSync.",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+func CollectQuickFilters(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*JiraTaskData)
+	logger := taskCtx.GetLogger()
+	logger.Info("collect quick filters")
+	collector, err := api.NewApiCollector(api.ApiCollectorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: JiraApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				BoardId:      data.Options.BoardId,
+			},
+			Table: RAW_QUICK_FILTER_TABLE,
+		},
+		ApiClient:      data.ApiClient,
+		PageSize:       50,
+		UrlTemplate:    "agile/1.0/board/{{ .Params.BoardId }}/quickfilter",
+		Query:          quickFilterQuery,
+		GetTotalPages:  GetTotalPagesFromResponse,
+		ResponseParser: parseQuickFilterPage,
+		// kanban boards of some Jira Server versions do not support quick filters
+		AfterResponse: ignoreHTTPStatus404,
+	})
+	if err != nil {
+		return err
+	}
+
+	return collector.Execute()
+}
+
+func quickFilterQuery(reqData *api.RequestData) (url.Values, errors.Error) {
+	query := url.Values{}
+	query.Set("startAt", fmt.Sprintf("%v", reqData.Pager.Skip))
+	query.Set("maxResults", fmt.Sprintf("%v", reqData.Pager.Size))
+	return query, nil
+}
+
+func parseQuickFilterPage(res *http.Response) ([]json.RawMessage, errors.Error) {
+	var page struct {
+		Values []json.RawMessage `json:"values"`
+	}
+	err := api.UnmarshalResponse(res, &page)
+	if err != nil {
+		return nil, err
+	}
+	return page.Values, nil
+}
@@ -0,0 +1,140 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+const RAW_QUICK_FILTER_ISSUE_TABLE = "jira_api_quick_filter_issues"
+
+var _ plugin.SubTaskEntryPoint = CollectQuickFilterIssues
+
+var CollectQuickFilterIssuesMeta = plugin.SubTaskMeta{
+	Name:             "collectQuickFilterIssues",
+	EntryPoint:       CollectQuickFilterIssues,
+	EnabledByDefault: true,
+	RunsWhenStaged:   true,
+	Description:      "collect issues matching Jira board quick filters, clears the ones of the previous runs when materializeQuickFilters is disabled",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+type quickFilterInput struct {
+	QuickFilterId uint64 `json:"quick_filter_id"`
+	Jql           string `json:"jql"`
+}
+
+func CollectQuickFilterIssues(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*JiraTaskData)
+	db := taskCtx.GetDal()
+	if data.Options.ScopeConfig == nil || !data.Options.ScopeConfig.MaterializeQuickFilters {
+		if data.IsRawDataStaged() {
+			return nil
+		}
+		return clearQuickFilterIssues(db, data.Options.ConnectionId, data.Options.BoardId)
+	}
+	logger := taskCtx.GetLogger()
+	logger.Info("collect quick filter issues")
+
+	cursor, err := db.Cursor(
+		dal.Select("quick_filter_id, jql"),
+		dal.From(&models.JiraBoardQuickFilter{}),
+		dal.Where("connection_id = ? AND board_id = ? AND jql != ''", data.Options.ConnectionId, data.Options.BoardId),
+	)
+	if err != nil {
+		return err
+	}
+	iterator, err := api.NewDalCursorIterator(db, cursor, reflect.TypeOf(quickFilterInput{}))
+	if err != nil {
+		return err
+	}
+
+	collector, err := api.NewApiCollector(api.ApiCollectorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: JiraApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				BoardId:      data.Options.BoardId,
+			},
+			Table: RAW_QUICK_FILTER_ISSUE_TABLE,
+		},
+		ApiClient:      data.ApiClient,
+		Input:          iterator,
+		PageSize:       data.Options.PageSize,
+		UrlTemplate:    "agile/1.0/board/{{ .Params.BoardId }}/issue",
+		Query:          quickFilterIssueQuery,
+		GetTotalPages:  GetTotalPagesFromResponse,
+		ResponseParser: parseQuickFilterIssues,
+		// Jira rejects unparseable JQL with 400, such filters are kept but not materialized
+		AfterResponse: ignoreHTTPStatus400,
+	})
+	if err != nil {
+		return err
+	}
+
+	return collector.Execute()
+}
+
+// quickFilterIssueQuery searches the issues of the board matching the JQL of the quick filter, only their ids are needed
+func quickFilterIssueQuery(reqData *api.RequestData) (url.Values, errors.Error) {
+	input := reqData.Input.(*quickFilterInput)
+	query := url.Values{}
+	query.Set("jql", input.Jql)
+	query.Set("fields", "id")
+	query.Set("startAt", fmt.Sprintf("%v", reqData.Pager.Skip))
+	query.Set("maxResults", fmt.Sprintf("%v", reqData.Pager.Size))
+	return query, nil
+}
+
+func parseQuickFilterIssues(res *http.Response) ([]json.RawMessage, errors.Error) {
+	var page struct {
+		Issues []json.RawMessage `json:"issues"`
+	}
+	err := api.UnmarshalResponse(res, &page)
+	if err != nil {
+		return nil, err
+	}
+	return page.Issues, nil
+}
+
+// clearQuickFilterIssues drops the memberships materialized by the previous runs of the board, along with their raw
+// data so that the extractor does not bring them back
+func clearQuickFilterIssues(db dal.Dal, connectionId, boardId uint64) errors.Error {
+	rawTable := "_raw_" + RAW_QUICK_FILTER_ISSUE_TABLE
+	if db.HasTable(rawTable) {
+		params := plugin.MarshalScopeParams(JiraApiParams{
+			ConnectionId: connectionId,
+			BoardId:      boardId,
+		})
+		err := db.Delete(&api.RawData{}, dal.From(rawTable), dal.Where("params = ?", params))
+		if err != nil {
+			return err
+		}
+	}
+	return db.Delete(&models.JiraQuickFilterIssue{}, dal.Where("connection_id = ? AND board_id = ?", connectionId, boardId))
+}
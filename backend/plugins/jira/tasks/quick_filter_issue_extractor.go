@@ -0,0 +1,85 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ExtractQuickFilterIssues
+
+var ExtractQuickFilterIssuesMeta = plugin.SubTaskMeta{
+	Name:             "extractQuickFilterIssues",
+	EntryPoint:       ExtractQuickFilterIssues,
+	EnabledByDefault: true,
+	Description:      "extract issues matching Jira board quick filters",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+func ExtractQuickFilterIssues(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*JiraTaskData)
+	extractor, err := api.NewApiExtractor(api.ApiExtractorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: JiraApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				BoardId:      data.Options.BoardId,
+			},
+			Table: RAW_QUICK_FILTER_ISSUE_TABLE,
+		},
+		Extract: func(row *api.RawData) ([]interface{}, errors.Error) {
+			quickFilterIssue, err := extractQuickFilterIssue(row, data.Options.ConnectionId, data.Options.BoardId)
+			if err != nil {
+				return nil, err
+			}
+			return []interface{}{quickFilterIssue}, nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return extractor.Execute()
+}
+
+// extractQuickFilterIssue tells the quick filter from the input of the raw row and the issue from its data
+func extractQuickFilterIssue(row *api.RawData, connectionId, boardId uint64) (*models.JiraQuickFilterIssue, errors.Error) {
+	var input quickFilterInput
+	err := errors.Convert(json.Unmarshal(row.Input, &input))
+	if err != nil {
+		return nil, err
+	}
+	var issue struct {
+		ID uint64 `json:"id,string"`
+	}
+	err = errors.Convert(json.Unmarshal(row.Data, &issue))
+	if err != nil {
+		return nil, err
+	}
+	return &models.JiraQuickFilterIssue{
+		ConnectionId:  connectionId,
+		BoardId:       boardId,
+		QuickFilterId: input.QuickFilterId,
+		IssueId:       issue.ID,
+	}, nil
+}
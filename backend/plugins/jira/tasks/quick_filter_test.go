@@ -0,0 +1,137 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/apache/incubator-devlake/plugins/jira/tasks/apiv2models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuickFilterQuery(t *testing.T) {
+	query, err := quickFilterQuery(&api.RequestData{Pager: &api.Pager{Skip: 50, Size: 50}})
+	require.Nil(t, err)
+	assert.Equal(t, "50", query.Get("startAt"))
+	assert.Equal(t, "50", query.Get("maxResults"))
+
+	query, err = quickFilterIssueQuery(&api.RequestData{
+		Pager: &api.Pager{Skip: 0, Size: 100},
+		Input: &quickFilterInput{QuickFilterId: 1, Jql: "assignee = currentUser()"},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, "assignee = currentUser()", query.Get("jql"))
+	// the memberships only need the ids of the issues
+	assert.Equal(t, "id", query.Get("fields"))
+	assert.Equal(t, "0", query.Get("startAt"))
+	assert.Equal(t, "100", query.Get("maxResults"))
+}
+
+func TestParseQuickFilters(t *testing.T) {
+	response := func(body string) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Request:    httptest.NewRequest(http.MethodGet, "/agile/1.0/board/8/quickfilter", nil),
+		}
+	}
+	filters, err := parseQuickFilterPage(response(`{"maxResults":50,"startAt":0,"total":2,"isLast":true,
+		"values":[{"id":1,"boardId":8,"name":"Only My Issues"},{"id":2,"boardId":8,"name":"Recently Updated"}]}`))
+	require.Nil(t, err)
+	assert.Equal(t, []json.RawMessage{
+		json.RawMessage(`{"id":1,"boardId":8,"name":"Only My Issues"}`),
+		json.RawMessage(`{"id":2,"boardId":8,"name":"Recently Updated"}`),
+	}, filters)
+
+	issues, err := parseQuickFilterIssues(response(`{"startAt":0,"maxResults":50,"total":1,
+		"issues":[{"id":"10001","key":"DL-1"}]}`))
+	require.Nil(t, err)
+	assert.Equal(t, []json.RawMessage{json.RawMessage(`{"id":"10001","key":"DL-1"}`)}, issues)
+
+	_, err = parseQuickFilterPage(response(`not json`))
+	assert.NotNil(t, err)
+}
+
+func TestQuickFilterToToolLayer(t *testing.T) {
+	var filter apiv2models.QuickFilter
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"id": 1,
+		"boardId": 8,
+		"name": "Only My Issues",
+		"jql": "assignee = currentUser()",
+		"description": "issues assigned to me",
+		"position": 2
+	}`), &filter))
+	assert.Equal(t, &models.JiraBoardQuickFilter{
+		ConnectionId:  1,
+		BoardId:       8,
+		QuickFilterId: 1,
+		Name:          "Only My Issues",
+		Jql:           "assignee = currentUser()",
+		Description:   "issues assigned to me",
+		Position:      2,
+	}, filter.ToToolLayer(1, 8))
+}
+
+func TestExtractQuickFilterIssue(t *testing.T) {
+	quickFilterIssue, err := extractQuickFilterIssue(&api.RawData{
+		Input: []byte(`{"quick_filter_id":2,"jql":"updated >= -1w"}`),
+		Data:  []byte(`{"id":"10001","key":"DL-1"}`),
+	}, 1, 8)
+	require.Nil(t, err)
+	assert.Equal(t, &models.JiraQuickFilterIssue{
+		ConnectionId:  1,
+		BoardId:       8,
+		QuickFilterId: 2,
+		IssueId:       10001,
+	}, quickFilterIssue)
+
+	_, err = extractQuickFilterIssue(&api.RawData{
+		Input: []byte(`{"quick_filter_id":2}`),
+		Data:  []byte(`{"id":10001}`),
+	}, 1, 8)
+	assert.NotNil(t, err)
+}
+
+func TestClearQuickFilterIssues(t *testing.T) {
+	db := &savedFilterTestDal{hasRawTable: true}
+	require.Nil(t, clearQuickFilterIssues(db, 1, 8))
+	require.Len(t, db.deleted, 2)
+	assert.IsType(t, &api.RawData{}, db.deleted[0])
+	assert.Equal(t, []dal.Clause{
+		dal.From("_raw_jira_api_quick_filter_issues"),
+		dal.Where("params = ?", `{"ConnectionId":1,"BoardId":8}`),
+	}, db.clauses[0])
+	assert.IsType(t, &models.JiraQuickFilterIssue{}, db.deleted[1])
+	assert.Equal(t, []dal.Clause{dal.Where("connection_id = ? AND board_id = ?", uint64(1), uint64(8))}, db.clauses[1])
+
+	// the raw table is missing until the memberships were collected once
+	db = &savedFilterTestDal{}
+	require.Nil(t, clearQuickFilterIssues(db, 1, 8))
+	require.Len(t, db.deleted, 1)
+	assert.IsType(t, &models.JiraQuickFilterIssue{}, db.deleted[0])
+}
@@ -0,0 +1,101 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+)
+
+var _ plugin.SubTaskEntryPoint = DiffRawData
+
+var DiffRawDataMeta = plugin.SubTaskMeta{
+	Name:             "diffRawData",
+	EntryPoint:       DiffRawData,
+	EnabledByDefault: true,
+	RunsWhenStaged:   true,
+	Description:      "report how the raw data collected in collect and diff mode drifted from the current raw data",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+// the raw tables of a board, one per entity type
+var boardRawTables = []string{
+	RAW_STATUS_TABLE,
+	RAW_PROJECT_TABLE,
+	RAW_ISSUE_TYPE_TABLE,
+	RAW_ISSUE_LINK_TYPE_TABLE,
+	RAW_ISSUE_TABLE,
+	RAW_ISSUE_COMMENT_TABLE,
+	RAW_CHANGELOG_TABLE,
+	RAW_USERS_TABLE,
+	RAW_WORKLOGS_TABLE,
+	RAW_REMOTELINK_TABLE,
+	RAW_ISSUE_WATCHER_TABLE,
+	RAW_SPRINT_TABLE,
+	RAW_QUICK_FILTER_TABLE,
+	RAW_QUICK_FILTER_ISSUE_TABLE,
+	RAW_SAVED_FILTER_TABLE,
+	RAW_SAVED_FILTER_ISSUE_TABLE,
+	RAW_AUDIT_ISSUE_TABLE,
+	RAW_DEVELOPMENT_PANEL,
+	RAW_EPIC_TABLE,
+	RAW_BOARD_CONFIGURATION_TABLE,
+}
+
+// DiffRawData compares every staging raw table of the board against its production raw table, then clears the
+// staging data of the board
+func DiffRawData(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*JiraTaskData)
+	if !data.IsRawDataStaged() {
+		return nil
+	}
+	db := taskCtx.GetDal()
+	logger := taskCtx.GetLogger()
+	params := plugin.MarshalScopeParams(JiraApiParams{
+		ConnectionId: data.Options.ConnectionId,
+		BoardId:      data.Options.BoardId,
+	})
+	for _, table := range boardRawTables {
+		rawTable := "_raw_" + table
+		stagingTable := api.StagingRawTable(rawTable)
+		if !db.HasTable(stagingTable) {
+			continue
+		}
+		// entity types whose collection did not run are left out
+		count, err := db.Count(dal.From(stagingTable), dal.Where("params = ?", params))
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			continue
+		}
+		diff, err := api.DiffRawData(db, rawTable, params)
+		if err != nil {
+			return err
+		}
+		logger.Info("%s: %d added, %d changed, %d removed, %d unchanged",
+			table, diff.Added, diff.Changed, diff.Removed, diff.Unchanged)
+		err = db.Delete(&api.RawData{}, dal.From(stagingTable), dal.Where("params = ?", params))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
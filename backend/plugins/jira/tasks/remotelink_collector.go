@@ -37,6 +37,7 @@ var CollectRemotelinksMeta = plugin.SubTaskMeta{
 	Name:             "collectRemotelinks",
 	EntryPoint:       CollectRemotelinks,
 	EnabledByDefault: true,
+	RunsWhenStaged:   true,
 	Description:      "collect Jira remote links, supports both timeFilter and diffSync.",
 	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
 }
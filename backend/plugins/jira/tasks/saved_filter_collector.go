@@ -0,0 +1,111 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+const RAW_SAVED_FILTER_TABLE = "jira_api_saved_filters"
+
+var _ plugin.SubTaskEntryPoint = CollectSavedFilters
+
+var CollectSavedFiltersMeta = plugin.SubTaskMeta{
+	Name:             "collectSavedFilters",
+	EntryPoint:       CollectSavedFilters,
+	EnabledByDefault: true,
+	RunsWhenStaged:   true,
+	Description:      "collect the Jira saved filters accessible to the connection user, does not support either timeFilter or diffSync.",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+// CollectSavedFilters collects the filters the user of the connection can access, their own filters along with the
+// ones shared with them. Jira Server cannot list the shared filters, only the favourite ones are collected there
+func CollectSavedFilters(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*JiraTaskData)
+	logger := taskCtx.GetLogger()
+	logger.Info("collect saved filters")
+	args := api.ApiCollectorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: JiraApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				BoardId:      data.Options.BoardId,
+			},
+			Table: RAW_SAVED_FILTER_TABLE,
+		},
+		ApiClient:      data.ApiClient,
+		UrlTemplate:    "api/2/filter/favourite",
+		ResponseParser: parseFavouriteFilters,
+	}
+	if searchesSavedFilters(data) {
+		args.UrlTemplate = "api/2/filter/search"
+		args.PageSize = 50
+		args.Query = savedFilterSearchQuery
+		args.GetTotalPages = GetTotalPagesFromResponse
+		args.ResponseParser = parseSavedFilterPage
+	}
+	collector, err := api.NewApiCollector(args)
+	if err != nil {
+		return err
+	}
+
+	return collector.Execute()
+}
+
+// searchesSavedFilters tells whether the filters can be searched through, the search being unavailable on Jira Server
+func searchesSavedFilters(data *JiraTaskData) bool {
+	return data.JiraServerInfo.DeploymentType != models.DeploymentServer
+}
+
+// savedFilterSearchQuery asks for the filters of all owners along with the fields the search leaves out by default
+func savedFilterSearchQuery(reqData *api.RequestData) (url.Values, errors.Error) {
+	query := url.Values{}
+	query.Set("expand", "description,owner,jql,viewUrl")
+	query.Set("startAt", fmt.Sprintf("%v", reqData.Pager.Skip))
+	query.Set("maxResults", fmt.Sprintf("%v", reqData.Pager.Size))
+	return query, nil
+}
+
+func parseSavedFilterPage(res *http.Response) ([]json.RawMessage, errors.Error) {
+	var page struct {
+		Values []json.RawMessage `json:"values"`
+	}
+	err := api.UnmarshalResponse(res, &page)
+	if err != nil {
+		return nil, err
+	}
+	return page.Values, nil
+}
+
+func parseFavouriteFilters(res *http.Response) ([]json.RawMessage, errors.Error) {
+	var filters []json.RawMessage
+	err := api.UnmarshalResponse(res, &filters)
+	if err != nil {
+		return nil, err
+	}
+	return filters, nil
+}
@@ -0,0 +1,135 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+const RAW_SAVED_FILTER_ISSUE_TABLE = "jira_api_saved_filter_issues"
+
+var _ plugin.SubTaskEntryPoint = CollectSavedFilterIssues
+
+var CollectSavedFilterIssuesMeta = plugin.SubTaskMeta{
+	Name:             "collectSavedFilterIssues",
+	EntryPoint:       CollectSavedFilterIssues,
+	EnabledByDefault: true,
+	RunsWhenStaged:   true,
+	Description:      "collect the issues of the board matching Jira saved filters, clears the ones of the previous runs when materializeSavedFilters is disabled",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+type savedFilterInput struct {
+	FilterId uint64 `json:"filter_id"`
+	Jql      string `json:"jql"`
+}
+
+func CollectSavedFilterIssues(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*JiraTaskData)
+	db := taskCtx.GetDal()
+	if data.Options.ScopeConfig == nil || !data.Options.ScopeConfig.MaterializeSavedFilters {
+		if data.IsRawDataStaged() {
+			return nil
+		}
+		return clearSavedFilterIssues(db, data.Options.ConnectionId, data.Options.BoardId)
+	}
+	logger := taskCtx.GetLogger()
+	logger.Info("collect saved filter issues")
+
+	cursor, err := db.Cursor(
+		dal.Select("filter_id, jql"),
+		dal.From(&models.JiraSavedFilter{}),
+		dal.Where("connection_id = ? AND board_id = ? AND jql != ''", data.Options.ConnectionId, data.Options.BoardId),
+	)
+	if err != nil {
+		return err
+	}
+	iterator, err := api.NewDalCursorIterator(db, cursor, reflect.TypeOf(savedFilterInput{}))
+	if err != nil {
+		return err
+	}
+
+	collector, err := api.NewApiCollector(api.ApiCollectorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: JiraApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				BoardId:      data.Options.BoardId,
+			},
+			Table: RAW_SAVED_FILTER_ISSUE_TABLE,
+		},
+		ApiClient:   data.ApiClient,
+		Input:       iterator,
+		PageSize:    data.Options.PageSize,
+		UrlTemplate: "agile/1.0/board/{{ .Params.BoardId }}/issue",
+		Query: func(reqData *api.RequestData) (url.Values, errors.Error) {
+			input := reqData.Input.(*savedFilterInput)
+			query := url.Values{}
+			query.Set("jql", input.Jql)
+			query.Set("fields", "id")
+			query.Set("startAt", fmt.Sprintf("%v", reqData.Pager.Skip))
+			query.Set("maxResults", fmt.Sprintf("%v", reqData.Pager.Size))
+			return query, nil
+		},
+		GetTotalPages: GetTotalPagesFromResponse,
+		ResponseParser: func(res *http.Response) ([]json.RawMessage, errors.Error) {
+			var data struct {
+				Issues []json.RawMessage `json:"issues"`
+			}
+			err := api.UnmarshalResponse(res, &data)
+			if err != nil {
+				return nil, err
+			}
+			return data.Issues, nil
+		},
+		// Jira rejects the JQL referencing fields it doesn't know with 400, such filters are kept but not materialized
+		AfterResponse: ignoreHTTPStatus400,
+	})
+	if err != nil {
+		return err
+	}
+
+	return collector.Execute()
+}
+
+// clearSavedFilterIssues drops the memberships materialized by the previous runs of the board, along with their raw
+// data so that the extractor does not bring them back
+func clearSavedFilterIssues(db dal.Dal, connectionId, boardId uint64) errors.Error {
+	rawTable := "_raw_" + RAW_SAVED_FILTER_ISSUE_TABLE
+	if db.HasTable(rawTable) {
+		params := plugin.MarshalScopeParams(JiraApiParams{
+			ConnectionId: connectionId,
+			BoardId:      boardId,
+		})
+		err := db.Delete(&api.RawData{}, dal.From(rawTable), dal.Where("params = ?", params))
+		if err != nil {
+			return err
+		}
+	}
+	return db.Delete(&models.JiraSavedFilterIssue{}, dal.Where("connection_id = ? AND board_id = ?", connectionId, boardId))
+}
@@ -0,0 +1,76 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ExtractSavedFilterIssues
+
+var ExtractSavedFilterIssuesMeta = plugin.SubTaskMeta{
+	Name:             "extractSavedFilterIssues",
+	EntryPoint:       ExtractSavedFilterIssues,
+	EnabledByDefault: true,
+	Description:      "extract the issues of the board matching Jira saved filters",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+func ExtractSavedFilterIssues(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*JiraTaskData)
+	extractor, err := api.NewApiExtractor(api.ApiExtractorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx: taskCtx,
+			Params: JiraApiParams{
+				ConnectionId: data.Options.ConnectionId,
+				BoardId:      data.Options.BoardId,
+			},
+			Table: RAW_SAVED_FILTER_ISSUE_TABLE,
+		},
+		Extract: func(row *api.RawData) ([]interface{}, errors.Error) {
+			var input savedFilterInput
+			err := errors.Convert(json.Unmarshal(row.Input, &input))
+			if err != nil {
+				return nil, err
+			}
+			var issue struct {
+				ID uint64 `json:"id,string"`
+			}
+			err = errors.Convert(json.Unmarshal(row.Data, &issue))
+			if err != nil {
+				return nil, err
+			}
+			return []interface{}{&models.JiraSavedFilterIssue{
+				ConnectionId: data.Options.ConnectionId,
+				BoardId:      data.Options.BoardId,
+				FilterId:     input.FilterId,
+				IssueId:      issue.ID,
+			}}, nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return extractor.Execute()
+}
@@ -0,0 +1,142 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/apache/incubator-devlake/plugins/jira/tasks/apiv2models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchesSavedFilters(t *testing.T) {
+	cloud := &JiraTaskData{JiraServerInfo: models.JiraServerInfo{DeploymentType: models.DeploymentCloud}}
+	server := &JiraTaskData{JiraServerInfo: models.JiraServerInfo{DeploymentType: models.DeploymentServer}}
+	assert.True(t, searchesSavedFilters(cloud))
+	assert.False(t, searchesSavedFilters(server))
+}
+
+func TestSavedFilterSearchQuery(t *testing.T) {
+	query, err := savedFilterSearchQuery(&api.RequestData{Pager: &api.Pager{Skip: 100, Size: 50}})
+	require.Nil(t, err)
+	assert.Equal(t, "100", query.Get("startAt"))
+	assert.Equal(t, "50", query.Get("maxResults"))
+	// the search leaves the jql and the owner out unless expanded
+	assert.Equal(t, "description,owner,jql,viewUrl", query.Get("expand"))
+	// filters shared by the other users are listed as well
+	assert.False(t, query.Has("accountId"))
+	assert.False(t, query.Has("owner"))
+}
+
+func TestParseSavedFilters(t *testing.T) {
+	response := func(body string) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Request:    httptest.NewRequest(http.MethodGet, "/api/2/filter/search", nil),
+		}
+	}
+	filters, err := parseSavedFilterPage(response(`{"startAt":0,"maxResults":50,"total":2,"isLast":true,
+		"values":[{"id":"10000","name":"Mine"},{"id":"10001","name":"Shared with me"}]}`))
+	require.Nil(t, err)
+	assert.Equal(t, []json.RawMessage{
+		json.RawMessage(`{"id":"10000","name":"Mine"}`),
+		json.RawMessage(`{"id":"10001","name":"Shared with me"}`),
+	}, filters)
+
+	filters, err = parseFavouriteFilters(response(`[{"id":"10002","name":"Starred"}]`))
+	require.Nil(t, err)
+	assert.Equal(t, []json.RawMessage{json.RawMessage(`{"id":"10002","name":"Starred"}`)}, filters)
+
+	_, err = parseSavedFilterPage(response(`not json`))
+	assert.NotNil(t, err)
+}
+
+func TestSavedFilterToToolLayer(t *testing.T) {
+	var filter apiv2models.SavedFilter
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"id": "10000",
+		"name": "Open bugs",
+		"jql": "project = DL AND issuetype = Bug AND resolution IS EMPTY",
+		"description": "bugs still open",
+		"owner": {"accountId": "5b10a2844c20165700ede21g", "displayName": "Alice"},
+		"viewUrl": "https://example.atlassian.net/issues/?filter=10000"
+	}`), &filter))
+	saved := filter.ToToolLayer(1, 8)
+	assert.Equal(t, &models.JiraSavedFilter{
+		ConnectionId:   1,
+		BoardId:        8,
+		FilterId:       10000,
+		Name:           "Open bugs",
+		Jql:            "project = DL AND issuetype = Bug AND resolution IS EMPTY",
+		Description:    "bugs still open",
+		OwnerAccountId: "5b10a2844c20165700ede21g",
+		Url:            "https://example.atlassian.net/issues/?filter=10000",
+	}, saved)
+
+	// filters whose owner is hidden are kept all the same
+	var anonymous apiv2models.SavedFilter
+	require.NoError(t, json.Unmarshal([]byte(`{"id": "10001", "name": "Anonymous"}`), &anonymous))
+	assert.Equal(t, "", anonymous.ToToolLayer(1, 8).OwnerAccountId)
+}
+
+type savedFilterTestDal struct {
+	dal.Dal
+	hasRawTable bool
+	deleted     []interface{}
+	clauses     [][]dal.Clause
+}
+
+func (d *savedFilterTestDal) HasTable(interface{}) bool {
+	return d.hasRawTable
+}
+
+func (d *savedFilterTestDal) Delete(entity interface{}, clauses ...dal.Clause) errors.Error {
+	d.deleted = append(d.deleted, entity)
+	d.clauses = append(d.clauses, clauses)
+	return nil
+}
+
+func TestClearSavedFilterIssues(t *testing.T) {
+	db := &savedFilterTestDal{hasRawTable: true}
+	require.Nil(t, clearSavedFilterIssues(db, 1, 8))
+	require.Len(t, db.deleted, 2)
+	assert.IsType(t, &api.RawData{}, db.deleted[0])
+	assert.Equal(t, []dal.Clause{
+		dal.From("_raw_jira_api_saved_filter_issues"),
+		dal.Where("params = ?", `{"ConnectionId":1,"BoardId":8}`),
+	}, db.clauses[0])
+	assert.IsType(t, &models.JiraSavedFilterIssue{}, db.deleted[1])
+	assert.Equal(t, []dal.Clause{dal.Where("connection_id = ? AND board_id = ?", uint64(1), uint64(8))}, db.clauses[1])
+
+	// the raw table is missing until the memberships were collected once
+	db = &savedFilterTestDal{}
+	require.Nil(t, clearSavedFilterIssues(db, 1, 8))
+	require.Len(t, db.deleted, 1)
+	assert.IsType(t, &models.JiraSavedFilterIssue{}, db.deleted[0])
+}
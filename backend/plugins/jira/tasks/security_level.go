@@ -0,0 +1,33 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"fmt"
+
+	"github.com/apache/incubator-devlake/core/dal"
+)
+
+// securityLevelFilter leaves out the rows belonging to issues excluded by their security level, the columns being
+// the ones referring to the issue of the rows
+func securityLevelFilter(connectionIdColumn, issueIdColumn string) dal.Clause {
+	return dal.Where(fmt.Sprintf(`NOT EXISTS (
+		SELECT 1 FROM _tool_jira_issues sji
+		WHERE sji.connection_id = %s AND sji.issue_id = %s AND sji.security_excluded = ?
+	)`, connectionIdColumn, issueIdColumn), true)
+}
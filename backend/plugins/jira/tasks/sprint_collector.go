@@ -22,7 +22,9 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"reflect"
 
+	"github.com/apache/incubator-devlake/core/dal"
 	"github.com/apache/incubator-devlake/core/errors"
 	"github.com/apache/incubator-devlake/core/plugin"
 	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
@@ -36,35 +38,41 @@ var CollectSprintsMeta = plugin.SubTaskMeta{
 	Name:             "collectSprints",
 	EntryPoint:       CollectSprints,
 	EnabledByDefault: true,
-	Description:      "collect Jira sprints, does not support either timeFilter or diffSync.",
+	RunsWhenStaged:   true,
+	Description:      "collect Jira sprints, only re-collects the sprints not closed by the previous run, does not support timeFilter.",
 	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
 }
 
+// SprintInput is a sprint known to be active or future by the previous run
+type SprintInput struct {
+	SprintId uint64
+}
+
 func CollectSprints(taskCtx plugin.SubTaskContext) errors.Error {
 	data := taskCtx.GetData().(*JiraTaskData)
+	db := taskCtx.GetDal()
 	logger := taskCtx.GetLogger()
 	logger.Info("collect sprints")
-	jql := "ORDER BY created ASC"
-	collector, err := api.NewApiCollector(api.ApiCollectorArgs{
-		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
-			Ctx: taskCtx,
-			Params: JiraApiParams{
-				ConnectionId: data.Options.ConnectionId,
-				BoardId:      data.Options.BoardId,
-			},
-			Table: RAW_SPRINT_TABLE,
+
+	collectorWithState, err := api.NewStatefulApiCollector(api.RawDataSubTaskArgs{
+		Ctx: taskCtx,
+		Params: JiraApiParams{
+			ConnectionId: data.Options.ConnectionId,
+			BoardId:      data.Options.BoardId,
 		},
+		Table: RAW_SPRINT_TABLE,
+	}, nil)
+	if err != nil {
+		return err
+	}
+	// closed sprints never change, so once fully synced only active and future ones need to be collected again
+	incremental := collectorWithState.IsIncremental()
+	err = collectorWithState.InitCollector(api.ApiCollectorArgs{
 		ApiClient:   data.ApiClient,
 		PageSize:    50,
+		Incremental: incremental,
 		UrlTemplate: "agile/1.0/board/{{ .Params.BoardId }}/sprint",
-		Query: func(reqData *api.RequestData) (url.Values, errors.Error) {
-			query := url.Values{}
-			query.Set("jql", jql)
-			query.Set("startAt", fmt.Sprintf("%v", reqData.Pager.Skip))
-			query.Set("maxResults", fmt.Sprintf("%v", reqData.Pager.Size))
-			return query, nil
-		},
-
+		Query:       sprintQuery(incremental),
 		ResponseParser: func(res *http.Response) ([]json.RawMessage, errors.Error) {
 			var data struct {
 				Values []json.RawMessage `json:"values"`
@@ -77,10 +85,72 @@ func CollectSprints(taskCtx plugin.SubTaskContext) errors.Error {
 		},
 		AfterResponse: ignoreHTTPStatus400,
 	})
+	if err != nil {
+		return err
+	}
+	if !incremental {
+		return collectorWithState.Execute()
+	}
 
+	// sprints open by the previous run may have been closed since, collect their final snapshot
+	cursor, err := db.Cursor(
+		dal.Select("s.sprint_id"),
+		dal.From("_tool_jira_board_sprints bs"),
+		dal.Join("LEFT JOIN _tool_jira_sprints s ON (bs.connection_id = s.connection_id AND bs.sprint_id = s.sprint_id)"),
+		dal.Where("bs.connection_id = ? AND bs.board_id = ? AND s.state IN ?", data.Options.ConnectionId, data.Options.BoardId, []string{"active", "future"}),
+	)
+	if err != nil {
+		return err
+	}
+	iterator, err := api.NewDalCursorIterator(db, cursor, reflect.TypeOf(SprintInput{}))
+	if err != nil {
+		return err
+	}
+	err = collectorWithState.InitCollector(api.ApiCollectorArgs{
+		ApiClient:      data.ApiClient,
+		Incremental:    true,
+		Input:          iterator,
+		UrlTemplate:    "agile/1.0/sprint/{{ .Input.SprintId }}",
+		ResponseParser: parseClosedSprint,
+		AfterResponse:  ignoreHTTPStatus404,
+	})
 	if err != nil {
 		return err
 	}
 
-	return collector.Execute()
+	return collectorWithState.Execute()
+}
+
+// sprintQuery lists every sprint of the board, or only the active and future ones once fully synced
+func sprintQuery(incremental bool) func(reqData *api.RequestData) (url.Values, errors.Error) {
+	return func(reqData *api.RequestData) (url.Values, errors.Error) {
+		query := url.Values{}
+		query.Set("jql", "ORDER BY created ASC")
+		query.Set("startAt", fmt.Sprintf("%v", reqData.Pager.Skip))
+		query.Set("maxResults", fmt.Sprintf("%v", reqData.Pager.Size))
+		if incremental {
+			query.Set("state", "active,future")
+		}
+		return query, nil
+	}
+}
+
+// parseClosedSprint keeps the sprint only if it got closed, the ones still open get collected along with the board
+func parseClosedSprint(res *http.Response) ([]json.RawMessage, errors.Error) {
+	var sprint json.RawMessage
+	err := api.UnmarshalResponse(res, &sprint)
+	if err != nil {
+		return nil, err
+	}
+	var state struct {
+		State string `json:"state"`
+	}
+	err = errors.Convert(json.Unmarshal(sprint, &state))
+	if err != nil {
+		return nil, err
+	}
+	if state.State != "closed" {
+		return nil, nil
+	}
+	return []json.RawMessage{sprint}, nil
 }
@@ -0,0 +1,68 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSprintQuery(t *testing.T) {
+	reqData := &api.RequestData{Pager: &api.Pager{Skip: 50, Size: 50}}
+	query, err := sprintQuery(false)(reqData)
+	assert.Nil(t, err)
+	assert.Equal(t, "50", query.Get("startAt"))
+	assert.Equal(t, "50", query.Get("maxResults"))
+	assert.False(t, query.Has("state"))
+
+	// once fully synced only the sprints still open get listed
+	query, err = sprintQuery(true)(reqData)
+	assert.Nil(t, err)
+	assert.Equal(t, "active,future", query.Get("state"))
+}
+
+func TestParseClosedSprint(t *testing.T) {
+	response := func(body string) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Request:    httptest.NewRequest(http.MethodGet, "/agile/1.0/sprint/7", nil),
+		}
+	}
+	// open by the previous run, closed since
+	sprints, err := parseClosedSprint(response(`{"id":7,"state":"closed","name":"Sprint 7"}`))
+	assert.Nil(t, err)
+	assert.Equal(t, []json.RawMessage{json.RawMessage(`{"id":7,"state":"closed","name":"Sprint 7"}`)}, sprints)
+
+	// still open, collected along with the board
+	for _, state := range []string{"active", "future"} {
+		sprints, err = parseClosedSprint(response(`{"id":8,"state":"` + state + `"}`))
+		assert.Nil(t, err)
+		assert.Empty(t, sprints)
+	}
+
+	_, err = parseClosedSprint(response(`not json`))
+	assert.NotNil(t, err)
+}
@@ -46,6 +46,7 @@ func ConvertSprintIssues(taskCtx plugin.SubTaskContext) errors.Error {
 		dal.Select("*"),
 		dal.From(jiraSprintIssue),
 		dal.Where("_tool_jira_sprint_issues.connection_id = ? ", data.Options.ConnectionId),
+		securityLevelFilter("_tool_jira_sprint_issues.connection_id", "_tool_jira_sprint_issues.issue_id"),
 	}
 	cursor, err := db.Cursor(clauses...)
 	if err != nil {
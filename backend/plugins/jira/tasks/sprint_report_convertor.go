@@ -0,0 +1,122 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"time"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ConvertSprintReport
+
+var ConvertSprintReportMeta = plugin.SubTaskMeta{
+	Name:             "convertSprintReport",
+	EntryPoint:       ConvertSprintReport,
+	EnabledByDefault: true,
+	Description:      "snapshot the total and completed work of Jira sprints as of their completion",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+// sprintReportIssue is an issue of a sprint along with what is needed to tell whether it was completed in time
+type sprintReportIssue struct {
+	SprintId       uint64
+	StdStatus      string
+	ResolutionDate *time.Time
+	StoryPoint     float64
+}
+
+type sprintReport struct {
+	TotalIssues          int
+	CompletedIssues      int
+	TotalStoryPoints     float64
+	CompletedStoryPoints float64
+}
+
+// ConvertSprintReport counts the work of the sprints of the board and how much of it was done by the time the
+// sprint got completed, which may differ from its planned end date. Active sprints are counted as of now
+func ConvertSprintReport(taskCtx plugin.SubTaskContext) errors.Error {
+	db := taskCtx.GetDal()
+	data := taskCtx.GetData().(*JiraTaskData)
+	connectionId := data.Options.ConnectionId
+
+	var sprints []*models.JiraSprint
+	err := db.All(&sprints,
+		dal.Select("s.sprint_id, s.complete_date"),
+		dal.From("_tool_jira_sprints s"),
+		dal.Join(`JOIN _tool_jira_board_sprints bs ON (bs.connection_id = s.connection_id AND bs.sprint_id = s.sprint_id)`),
+		dal.Where("s.connection_id = ? AND bs.board_id = ?", connectionId, data.Options.BoardId),
+	)
+	if err != nil {
+		return err
+	}
+	var issues []*sprintReportIssue
+	err = db.All(&issues,
+		dal.Select("si.sprint_id, i.std_status, i.resolution_date, i.story_point"),
+		dal.From("_tool_jira_sprint_issues si"),
+		dal.Join(`JOIN _tool_jira_board_sprints bs ON (bs.connection_id = si.connection_id AND bs.sprint_id = si.sprint_id)`),
+		dal.Join(`JOIN _tool_jira_issues i ON (i.connection_id = si.connection_id AND i.issue_id = si.issue_id)`),
+		dal.Where("si.connection_id = ? AND bs.board_id = ? AND i.security_excluded = ?", connectionId, data.Options.BoardId, false),
+	)
+	if err != nil {
+		return err
+	}
+	issuesBySprint := make(map[uint64][]*sprintReportIssue)
+	for _, issue := range issues {
+		issuesBySprint[issue.SprintId] = append(issuesBySprint[issue.SprintId], issue)
+	}
+
+	sprintIdGen := didgen.NewDomainIdGenerator(&models.JiraSprint{})
+	now := time.Now()
+	for _, sprint := range sprints {
+		end := now
+		if sprint.CompleteDate != nil {
+			end = *sprint.CompleteDate
+		}
+		report := getSprintReport(issuesBySprint[sprint.SprintId], end)
+		err = db.UpdateColumns(&ticket.Sprint{}, []dal.DalSet{
+			{ColumnName: "total_issues", Value: report.TotalIssues},
+			{ColumnName: "completed_issues", Value: report.CompletedIssues},
+			{ColumnName: "total_story_points", Value: report.TotalStoryPoints},
+			{ColumnName: "completed_story_points", Value: report.CompletedStoryPoints},
+		}, dal.Where("id = ?", sprintIdGen.Generate(connectionId, sprint.SprintId)))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getSprintReport counts the issues of a sprint, those done and resolved by the end of the sprint are completed
+func getSprintReport(issues []*sprintReportIssue, end time.Time) *sprintReport {
+	report := &sprintReport{}
+	for _, issue := range issues {
+		report.TotalIssues++
+		report.TotalStoryPoints += issue.StoryPoint
+		if issue.StdStatus == ticket.DONE && issue.ResolutionDate != nil && !issue.ResolutionDate.After(end) {
+			report.CompletedIssues++
+			report.CompletedStoryPoints += issue.StoryPoint
+		}
+	}
+	return report
+}
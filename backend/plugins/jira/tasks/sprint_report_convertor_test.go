@@ -0,0 +1,46 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSprintReport(t *testing.T) {
+	completed := time.Date(2023, 7, 14, 0, 0, 0, 0, time.UTC)
+	before := completed.Add(-time.Hour)
+	after := completed.Add(time.Hour)
+	issues := []*sprintReportIssue{
+		{StdStatus: ticket.DONE, ResolutionDate: &before, StoryPoint: 3},
+		{StdStatus: ticket.DONE, ResolutionDate: &completed, StoryPoint: 2},
+		// resolved after the sprint was completed
+		{StdStatus: ticket.DONE, ResolutionDate: &after, StoryPoint: 5},
+		{StdStatus: ticket.IN_PROGRESS, StoryPoint: 1},
+	}
+	assert.Equal(t, &sprintReport{
+		TotalIssues:          4,
+		CompletedIssues:      2,
+		TotalStoryPoints:     11,
+		CompletedStoryPoints: 5,
+	}, getSprintReport(issues, completed))
+	assert.Equal(t, &sprintReport{}, getSprintReport(nil, completed))
+}
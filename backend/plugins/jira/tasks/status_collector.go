@@ -32,6 +32,7 @@ var CollectStatusMeta = plugin.SubTaskMeta{
 	Name:             "collectStatus",
 	EntryPoint:       CollectStatus,
 	EnabledByDefault: true,
+	RunsWhenStaged:   true,
 	Description:      "collect Jira status, does not support either timeFilter or diffSync.",
 	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
 }
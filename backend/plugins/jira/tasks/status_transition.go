@@ -0,0 +1,165 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"strings"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+// statusTransitionMapper derives the compact status transitions from changelog items, along with whether the full
+// changelog items should still be kept
+type statusTransitionMapper struct {
+	keepItems      bool
+	statuses       map[string]string
+	statusMappings map[string]models.StatusMappings
+	// projectStatusMappings overrides statusMappings by project key
+	projectStatusMappings map[string]map[string]models.StatusMappings
+}
+
+// readsStatusTransitions tells whether the changelog mode extracts the compact status transitions, status based
+// metrics read them instead of the changelog items then
+func readsStatusTransitions(data *JiraTaskData) bool {
+	if data.Options.ScopeConfig == nil {
+		return false
+	}
+	mode := data.Options.ScopeConfig.ChangelogMode
+	return mode == models.ChangelogModeTransitions || mode == models.ChangelogModeBoth
+}
+
+// keepsChangelogItems tells whether the changelog mode extracts the full changelog items, everything built on
+// fields other than the status is skipped otherwise
+func keepsChangelogItems(data *JiraTaskData) bool {
+	return data.Options.ScopeConfig == nil || data.Options.ScopeConfig.ChangelogMode != models.ChangelogModeTransitions
+}
+
+// newStatusTransitionMapper returns nil when the changelog mode doesn't ask for transitions
+func newStatusTransitionMapper(
+	db dal.Dal,
+	data *JiraTaskData,
+	statusMappings map[string]models.StatusMappings,
+	projectStatusMappings map[string]map[string]models.StatusMappings,
+) (*statusTransitionMapper, errors.Error) {
+	if !readsStatusTransitions(data) {
+		return nil, nil
+	}
+	mapper, err := newStdStatusMapper(db, data.Options.ConnectionId, statusMappings, projectStatusMappings)
+	if err != nil {
+		return nil, err
+	}
+	mapper.keepItems = keepsChangelogItems(data)
+	return mapper, nil
+}
+
+// newStdStatusMapper returns a mapper of the statuses of the connection to standard statuses, whatever the
+// changelog mode
+func newStdStatusMapper(
+	db dal.Dal,
+	connectionId uint64,
+	statusMappings map[string]models.StatusMappings,
+	projectStatusMappings map[string]map[string]models.StatusMappings,
+) (*statusTransitionMapper, errors.Error) {
+	var statuses []models.JiraStatus
+	err := db.All(&statuses, dal.Where("connection_id = ?", connectionId))
+	if err != nil {
+		return nil, err
+	}
+	statusCategories := make(map[string]string, len(statuses))
+	for _, status := range statuses {
+		statusCategories[status.ID] = status.StatusCategory
+	}
+	return &statusTransitionMapper{
+		statuses:              statusCategories,
+		statusMappings:        statusMappings,
+		projectStatusMappings: projectStatusMappings,
+	}, nil
+}
+
+// getStatusMappings returns the standard status mappings of the scope config by issue type, along with their
+// overrides by project key
+func getStatusMappings(data *JiraTaskData) (map[string]models.StatusMappings, map[string]map[string]models.StatusMappings) {
+	statusMappings := make(map[string]models.StatusMappings)
+	projectStatusMappings := make(map[string]map[string]models.StatusMappings)
+	if data.Options.ScopeConfig != nil {
+		for userType, stdType := range data.Options.ScopeConfig.TypeMappings {
+			statusMappings[userType] = stdType.StatusMappings
+		}
+		for projectKey, projectMappings := range data.Options.ScopeConfig.ProjectTypeMappings {
+			projectStatusMappings[projectKey] = make(map[string]models.StatusMappings, len(projectMappings))
+			for userType, stdType := range projectMappings {
+				projectStatusMappings[projectKey][userType] = stdType.StatusMappings
+			}
+		}
+	}
+	return statusMappings, projectStatusMappings
+}
+
+// lookupStatusMappings returns the status mappings of an issue type within a project, falling back to the default
+// ones when the project doesn't map the type
+func lookupStatusMappings(
+	statusMappings map[string]models.StatusMappings,
+	projectStatusMappings map[string]map[string]models.StatusMappings,
+	projectKey, issueType string,
+) models.StatusMappings {
+	if mappings, ok := projectStatusMappings[projectKey][issueType]; ok {
+		return mappings
+	}
+	return statusMappings[issueType]
+}
+
+// getProjectKey returns the project key an issue key starts with, e.g. `DL` for `DL-123`
+func getProjectKey(issueKey string) string {
+	if i := strings.LastIndex(issueKey, "-"); i > 0 {
+		return issueKey[:i]
+	}
+	return ""
+}
+
+// stdStatus maps a status the same way the status of issues is, unknown statuses map to nothing
+func (m *statusTransitionMapper) stdStatus(projectKey, issueType, statusId string) string {
+	statusKey, ok := m.statuses[statusId]
+	if !ok {
+		return ""
+	}
+	if value, ok := lookupStatusMappings(m.statusMappings, m.projectStatusMappings, projectKey, issueType)[statusKey]; ok {
+		return value.StandardStatus
+	}
+	return getStdStatus(statusKey)
+}
+
+// toTransition returns the transition of a status changelog item, nil for other fields
+func (m *statusTransitionMapper) toTransition(projectKey, issueType string, changelog *models.JiraIssueChangelogs, item *models.JiraIssueChangelogItems) *models.JiraIssueStatusTransition {
+	if item.Field != "status" {
+		return nil
+	}
+	return &models.JiraIssueStatusTransition{
+		ConnectionId:      changelog.ConnectionId,
+		ChangelogId:       changelog.ChangelogId,
+		IssueId:           changelog.IssueId,
+		FromStatus:        item.FromString,
+		ToStatus:          item.ToString,
+		FromStdStatus:     m.stdStatus(projectKey, issueType, item.FromValue),
+		ToStdStatus:       m.stdStatus(projectKey, issueType, item.ToValue),
+		Created:           changelog.Created,
+		AuthorAccountId:   changelog.AuthorAccountId,
+		AuthorDisplayName: changelog.AuthorDisplayName,
+	}
+}
@@ -0,0 +1,122 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusTransitionMapper(t *testing.T) {
+	mapper := &statusTransitionMapper{
+		statuses: map[string]string{"1": "new", "3": "indeterminate", "10001": "done"},
+		statusMappings: map[string]models.StatusMappings{
+			"Bug": {"done": {StandardStatus: ticket.IN_PROGRESS}},
+		},
+	}
+	created := time.Date(2023, 8, 9, 10, 0, 0, 0, time.UTC)
+	changelog := &models.JiraIssueChangelogs{
+		ConnectionId:      1,
+		ChangelogId:       100,
+		IssueId:           10,
+		Created:           created,
+		AuthorAccountId:   "abc",
+		AuthorDisplayName: "Alice",
+	}
+
+	assert.Nil(t, mapper.toTransition("DL", "Story", changelog, &models.JiraIssueChangelogItems{Field: "assignee"}))
+
+	item := &models.JiraIssueChangelogItems{Field: "status", FromValue: "3", FromString: "In Progress", ToValue: "10001", ToString: "Done"}
+	assert.Equal(t, &models.JiraIssueStatusTransition{
+		ConnectionId:      1,
+		ChangelogId:       100,
+		IssueId:           10,
+		FromStatus:        "In Progress",
+		ToStatus:          "Done",
+		FromStdStatus:     ticket.IN_PROGRESS,
+		ToStdStatus:       ticket.DONE,
+		Created:           created,
+		AuthorAccountId:   "abc",
+		AuthorDisplayName: "Alice",
+	}, mapper.toTransition("DL", "Story", changelog, item))
+
+	// the mappings of the scope config apply to both ends
+	transition := mapper.toTransition("DL", "Bug", changelog, item)
+	assert.Equal(t, ticket.IN_PROGRESS, transition.ToStdStatus)
+
+	// statuses unknown to the connection are left unmapped
+	transition = mapper.toTransition("DL", "Story", changelog, &models.JiraIssueChangelogItems{Field: "status", FromValue: "1", ToValue: "404"})
+	assert.Equal(t, ticket.TODO, transition.FromStdStatus)
+	assert.Equal(t, "", transition.ToStdStatus)
+}
+
+func TestStatusTransitionMapperProjectMappings(t *testing.T) {
+	mapper := &statusTransitionMapper{
+		statuses: map[string]string{"3": "indeterminate", "10001": "done"},
+		statusMappings: map[string]models.StatusMappings{
+			"Bug": {"done": {StandardStatus: ticket.IN_PROGRESS}},
+		},
+		projectStatusMappings: map[string]map[string]models.StatusMappings{
+			"OPS": {"Bug": {"indeterminate": {StandardStatus: ticket.TODO}}},
+		},
+	}
+	changelog := &models.JiraIssueChangelogs{ConnectionId: 1, ChangelogId: 100, IssueId: 10}
+	item := &models.JiraIssueChangelogItems{Field: "status", FromValue: "3", ToValue: "10001"}
+
+	// the mapping of the project replaces the default one for the type
+	transition := mapper.toTransition("OPS", "Bug", changelog, item)
+	assert.Equal(t, ticket.TODO, transition.FromStdStatus)
+	assert.Equal(t, ticket.DONE, transition.ToStdStatus)
+
+	// other projects and types fall back to the default mappings
+	transition = mapper.toTransition("DL", "Bug", changelog, item)
+	assert.Equal(t, ticket.IN_PROGRESS, transition.FromStdStatus)
+	assert.Equal(t, ticket.IN_PROGRESS, transition.ToStdStatus)
+	transition = mapper.toTransition("OPS", "Story", changelog, item)
+	assert.Equal(t, ticket.IN_PROGRESS, transition.FromStdStatus)
+	assert.Equal(t, ticket.DONE, transition.ToStdStatus)
+}
+
+func TestGetProjectKey(t *testing.T) {
+	assert.Equal(t, "DL", getProjectKey("DL-123"))
+	assert.Equal(t, "MY-TEAM", getProjectKey("MY-TEAM-7"))
+	assert.Equal(t, "", getProjectKey("123"))
+	assert.Equal(t, "", getProjectKey(""))
+}
+
+func TestChangelogModeSources(t *testing.T) {
+	for _, tt := range []struct {
+		scopeConfig     *models.JiraScopeConfig
+		readTransitions bool
+		keepItems       bool
+	}{
+		{nil, false, true},
+		{&models.JiraScopeConfig{}, false, true},
+		{&models.JiraScopeConfig{ChangelogMode: models.ChangelogModeFull}, false, true},
+		{&models.JiraScopeConfig{ChangelogMode: models.ChangelogModeBoth}, true, true},
+		{&models.JiraScopeConfig{ChangelogMode: models.ChangelogModeTransitions}, true, false},
+	} {
+		data := &JiraTaskData{Options: &JiraOptions{ScopeConfig: tt.scopeConfig}}
+		assert.Equal(t, tt.readTransitions, readsStatusTransitions(data))
+		assert.Equal(t, tt.keepItems, keepsChangelogItems(data))
+	}
+}
@@ -0,0 +1,121 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ConvertSubtaskCounts
+
+var ConvertSubtaskCountsMeta = plugin.SubTaskMeta{
+	Name:             "convertSubtaskCounts",
+	EntryPoint:       ConvertSubtaskCounts,
+	EnabledByDefault: true,
+	Description:      "count the children of Jira issues by standard status",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+type subtask struct {
+	ParentId  uint64
+	StdStatus string
+}
+
+type subtaskCounts struct {
+	Todo       int
+	InProgress int
+	Done       int
+}
+
+// ConvertSubtaskCounts writes the number of children by standard status onto the issues of the board. Only the
+// children of the issues of the board are loaded, they are found by their parent id rather than their board so that
+// children collected by other boards count as well without requesting them again
+func ConvertSubtaskCounts(taskCtx plugin.SubTaskContext) errors.Error {
+	db := taskCtx.GetDal()
+	data := taskCtx.GetData().(*JiraTaskData)
+	connectionId := data.Options.ConnectionId
+
+	var subtasks []*subtask
+	err := db.All(&subtasks,
+		dal.Select("c.parent_id, c.std_status"),
+		dal.From("_tool_jira_issues c"),
+		dal.Join(`JOIN _tool_jira_board_issues bi ON (bi.connection_id = c.connection_id AND bi.issue_id = c.parent_id)`),
+		dal.Where("c.connection_id = ? AND bi.board_id = ?", connectionId, data.Options.BoardId),
+		securityLevelFilter("c.connection_id", "c.issue_id"),
+	)
+	if err != nil {
+		return err
+	}
+	counts := countSubtasks(subtasks)
+
+	var boardIssues []*models.JiraIssue
+	err = db.All(&boardIssues,
+		dal.Select("ji.issue_id"),
+		dal.From("_tool_jira_issues ji"),
+		dal.Join(`JOIN _tool_jira_board_issues bi ON (bi.connection_id = ji.connection_id AND bi.issue_id = ji.issue_id)`),
+		dal.Where("ji.connection_id = ? AND bi.board_id = ?", connectionId, data.Options.BoardId),
+	)
+	if err != nil {
+		return err
+	}
+	issueIdGen := didgen.NewDomainIdGenerator(&models.JiraIssue{})
+	updater := api.NewBatchUpdater(db, &ticket.Issue{}, "id")
+	for _, issue := range boardIssues {
+		var todo, inProgress, done *int
+		if c, ok := counts[issue.IssueId]; ok {
+			todo, inProgress, done = &c.Todo, &c.InProgress, &c.Done
+		}
+		err = updater.Add(issueIdGen.Generate(connectionId, issue.IssueId),
+			dal.DalSet{ColumnName: "subtask_todo_count", Value: todo},
+			dal.DalSet{ColumnName: "subtask_in_progress_count", Value: inProgress},
+			dal.DalSet{ColumnName: "subtask_done_count", Value: done},
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return updater.Flush()
+}
+
+// countSubtasks counts the children of each parent by standard status, statuses other than done and to-do count
+// as in progress
+func countSubtasks(subtasks []*subtask) map[uint64]*subtaskCounts {
+	result := make(map[uint64]*subtaskCounts)
+	for _, s := range subtasks {
+		c, ok := result[s.ParentId]
+		if !ok {
+			c = &subtaskCounts{}
+			result[s.ParentId] = c
+		}
+		switch s.StdStatus {
+		case ticket.DONE:
+			c.Done++
+		case ticket.TODO:
+			c.Todo++
+		default:
+			c.InProgress++
+		}
+	}
+	return result
+}
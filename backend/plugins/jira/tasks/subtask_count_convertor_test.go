@@ -0,0 +1,39 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountSubtasks(t *testing.T) {
+	counts := countSubtasks([]*subtask{
+		{ParentId: 1, StdStatus: ticket.TODO},
+		{ParentId: 1, StdStatus: ticket.IN_PROGRESS},
+		{ParentId: 1, StdStatus: ticket.DONE},
+		{ParentId: 1, StdStatus: ticket.DONE},
+		{ParentId: 2, StdStatus: ticket.OTHER},
+	})
+	assert.Equal(t, map[uint64]*subtaskCounts{
+		1: {Todo: 1, InProgress: 1, Done: 2},
+		2: {InProgress: 1},
+	}, counts)
+}
@@ -34,13 +34,40 @@ type JiraOptions struct {
 	ScopeId       string
 	ScopeConfigId uint64
 	PageSize      int
+	// CollectAndDiff collects fresh raw data into staging raw tables and reports how it drifted from the current
+	// raw data, without extracting or converting anything
+	CollectAndDiff bool `json:"collectAndDiff"`
+	// AuditAccountId makes the run collect the issues of the board changed by the account between AuditSince and
+	// AuditUntil, both RFC3339 and optional, for audit. The changes are kept and the issues flagged
+	AuditAccountId string `json:"auditAccountId"`
+	AuditSince     string `json:"auditSince"`
+	AuditUntil     string `json:"auditUntil"`
 }
 
 type JiraTaskData struct {
-	Options        *JiraOptions
-	ApiClient      *api.ApiAsyncClient
-	TimeAfter      *time.Time
+	Options   *JiraOptions
+	ApiClient *api.ApiAsyncClient
+	TimeAfter *time.Time
+	// AuditSince and AuditUntil bound the audit window, nil when open
+	AuditSince     *time.Time
+	AuditUntil     *time.Time
 	JiraServerInfo models.JiraServerInfo
+	// FreshnessSlaMinutes and FreshnessWebhookUrl come from the connection, see CheckBoardFreshness
+	FreshnessSlaMinutes int
+	FreshnessWebhookUrl string
+}
+
+// IsRawDataStaged implements api.RawDataStager, collect and diff runs collect into staging raw tables
+func (data *JiraTaskData) IsRawDataStaged() bool {
+	return data.Options != nil && data.Options.CollectAndDiff
+}
+
+// GetRequestBudget returns the request budget of the connection for the task, nil when there is no cap
+func (data *JiraTaskData) GetRequestBudget() *api.RequestBudget {
+	if data.ApiClient == nil {
+		return nil
+	}
+	return data.ApiClient.GetRequestBudget()
 }
 
 type JiraApiParams models.JiraApiParams
@@ -0,0 +1,115 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"fmt"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ReportUnmappedStatuses
+
+var ReportUnmappedStatusesMeta = plugin.SubTaskMeta{
+	Name:             "reportUnmappedStatuses",
+	EntryPoint:       ReportUnmappedStatuses,
+	EnabledByDefault: true,
+	Description:      "report the workflow statuses of Jira projects missing from the status mappings",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+type projectKeyById struct {
+	ProjectId uint64
+	IssueKey  string
+}
+
+// ReportUnmappedStatuses logs the workflow statuses that issues may reach without a status mapping applying to
+// them, only issue types with status mappings are checked since the others rely on the default mapping on purpose
+func ReportUnmappedStatuses(taskCtx plugin.SubTaskContext) errors.Error {
+	db := taskCtx.GetDal()
+	logger := taskCtx.GetLogger()
+	data := taskCtx.GetData().(*JiraTaskData)
+	statusMappings, projectStatusMappings := getStatusMappings(data)
+	if len(statusMappings) == 0 && len(projectStatusMappings) == 0 {
+		return nil
+	}
+
+	var projects []*projectKeyById
+	err := db.All(&projects,
+		dal.Select("i.project_id, MIN(i.issue_key) AS issue_key"),
+		dal.From("_tool_jira_board_issues bi"),
+		dal.Join("JOIN _tool_jira_issues i ON (bi.connection_id = i.connection_id AND bi.issue_id = i.issue_id)"),
+		dal.Where("bi.connection_id = ? AND bi.board_id = ? AND i.project_id != 0", data.Options.ConnectionId, data.Options.BoardId),
+		dal.Groupby("i.project_id"),
+	)
+	if err != nil {
+		return err
+	}
+	projectKeys := make(map[uint64]string, len(projects))
+	projectIds := make([]uint64, 0, len(projects))
+	for _, p := range projects {
+		projectKeys[p.ProjectId] = getProjectKey(p.IssueKey)
+		projectIds = append(projectIds, p.ProjectId)
+	}
+	if len(projectIds) == 0 {
+		return nil
+	}
+
+	var statuses []*models.JiraProjectStatus
+	err = db.All(&statuses,
+		dal.From(&models.JiraProjectStatus{}),
+		dal.Where("connection_id = ? AND project_id IN ?", data.Options.ConnectionId, projectIds),
+		dal.Orderby("project_id, issue_type_name, status_name"),
+	)
+	if err != nil {
+		return err
+	}
+	unmapped := getUnmappedStatuses(statuses, projectKeys, statusMappings, projectStatusMappings)
+	for _, s := range unmapped {
+		logger.Warn(nil, fmt.Sprintf(
+			"status %s (%s) of %s issues in project %s has no status mapping",
+			s.StatusName, s.StatusCategory, s.IssueTypeName, projectKeys[s.ProjectId],
+		))
+	}
+	logger.Info("%d workflow statuses out of %d have no status mapping", len(unmapped), len(statuses))
+	return nil
+}
+
+// getUnmappedStatuses returns the workflow statuses of the issue types with status mappings whose status category,
+// which status mappings are keyed by, is not mapped
+func getUnmappedStatuses(
+	statuses []*models.JiraProjectStatus,
+	projectKeys map[uint64]string,
+	statusMappings map[string]models.StatusMappings,
+	projectStatusMappings map[string]map[string]models.StatusMappings,
+) []*models.JiraProjectStatus {
+	var unmapped []*models.JiraProjectStatus
+	for _, s := range statuses {
+		mappings := lookupStatusMappings(statusMappings, projectStatusMappings, projectKeys[s.ProjectId], s.IssueTypeName)
+		if len(mappings) == 0 {
+			continue
+		}
+		if _, ok := mappings[s.StatusCategory]; !ok {
+			unmapped = append(unmapped, s)
+		}
+	}
+	return unmapped
+}
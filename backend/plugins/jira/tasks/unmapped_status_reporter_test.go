@@ -0,0 +1,47 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetUnmappedStatuses(t *testing.T) {
+	statuses := []*models.JiraProjectStatus{
+		{ProjectId: 1, IssueTypeName: "Bug", StatusName: "Open", StatusCategory: "new"},
+		{ProjectId: 1, IssueTypeName: "Bug", StatusName: "Review", StatusCategory: "indeterminate"},
+		{ProjectId: 1, IssueTypeName: "Story", StatusName: "Review", StatusCategory: "indeterminate"},
+		{ProjectId: 2, IssueTypeName: "Bug", StatusName: "Open", StatusCategory: "new"},
+		{ProjectId: 2, IssueTypeName: "Bug", StatusName: "Closed", StatusCategory: "done"},
+	}
+	unmapped := getUnmappedStatuses(
+		statuses,
+		map[uint64]string{1: "DL", 2: "OPS"},
+		map[string]models.StatusMappings{
+			"Bug": {"new": {StandardStatus: ticket.TODO}},
+		},
+		map[string]map[string]models.StatusMappings{
+			"OPS": {"Bug": {"new": {StandardStatus: ticket.TODO}, "done": {StandardStatus: ticket.DONE}}},
+		},
+	)
+	assert.Equal(t, []*models.JiraProjectStatus{statuses[1]}, unmapped)
+}
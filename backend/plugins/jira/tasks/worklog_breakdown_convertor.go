@@ -0,0 +1,114 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"sort"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+var ConvertWorklogBreakdownMeta = plugin.SubTaskMeta{
+	Name:             "convertWorklogBreakdown",
+	EntryPoint:       ConvertWorklogBreakdown,
+	EnabledByDefault: true,
+	Description:      "aggregate the time logged on Jira issues by author into _tool_jira_issue_worklog_breakdowns",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+type worklogBreakdownKey struct {
+	IssueId  uint64
+	AuthorId string
+}
+
+// ConvertWorklogBreakdown sums up the worklogs of the board issues per issue and author, worklogs without an author
+// are accounted to models.WorklogAuthorUnknown. The breakdown of the board issues is rebuilt on every run.
+func ConvertWorklogBreakdown(taskCtx plugin.SubTaskContext) errors.Error {
+	db := taskCtx.GetDal()
+	logger := taskCtx.GetLogger()
+	data := taskCtx.GetData().(*JiraTaskData)
+	connectionId := data.Options.ConnectionId
+	boardId := data.Options.BoardId
+
+	var worklogs []*models.JiraWorklog
+	err := db.All(&worklogs,
+		dal.Select("w.issue_id, w.author_id, w.time_spent_seconds"),
+		dal.From("_tool_jira_worklogs w"),
+		dal.Join(`JOIN _tool_jira_board_issues bi ON (bi.connection_id = w.connection_id AND bi.issue_id = w.issue_id)`),
+		dal.Where("w.connection_id = ? AND bi.board_id = ?", connectionId, boardId),
+		securityLevelFilter("w.connection_id", "w.issue_id"),
+	)
+	if err != nil {
+		return err
+	}
+	breakdowns := aggregateWorklogs(connectionId, worklogs)
+
+	err = db.Delete(&models.JiraIssueWorklogBreakdown{}, dal.Where(
+		`connection_id = ? AND issue_id IN (
+			SELECT issue_id FROM _tool_jira_board_issues WHERE connection_id = ? AND board_id = ?
+		)`,
+		connectionId, connectionId, boardId,
+	))
+	if err != nil {
+		return err
+	}
+	if len(breakdowns) > 0 {
+		err = db.Create(breakdowns)
+		if err != nil {
+			return err
+		}
+	}
+	logger.Info("board %d worklog breakdown updated with %d rows", boardId, len(breakdowns))
+	return nil
+}
+
+// aggregateWorklogs sums up the time spent and the number of worklogs per issue and author, ordered by issue and author
+func aggregateWorklogs(connectionId uint64, worklogs []*models.JiraWorklog) []*models.JiraIssueWorklogBreakdown {
+	aggregated := make(map[worklogBreakdownKey]*models.JiraIssueWorklogBreakdown)
+	for _, worklog := range worklogs {
+		key := worklogBreakdownKey{worklog.IssueId, worklog.AuthorId}
+		if key.AuthorId == "" {
+			key.AuthorId = models.WorklogAuthorUnknown
+		}
+		breakdown, ok := aggregated[key]
+		if !ok {
+			breakdown = &models.JiraIssueWorklogBreakdown{
+				ConnectionId: connectionId,
+				IssueId:      key.IssueId,
+				AuthorId:     key.AuthorId,
+			}
+			aggregated[key] = breakdown
+		}
+		breakdown.TimeSpentSeconds += int64(worklog.TimeSpentSeconds)
+		breakdown.WorklogCount++
+	}
+	breakdowns := make([]*models.JiraIssueWorklogBreakdown, 0, len(aggregated))
+	for _, breakdown := range aggregated {
+		breakdowns = append(breakdowns, breakdown)
+	}
+	sort.Slice(breakdowns, func(i, j int) bool {
+		if breakdowns[i].IssueId != breakdowns[j].IssueId {
+			return breakdowns[i].IssueId < breakdowns[j].IssueId
+		}
+		return breakdowns[i].AuthorId < breakdowns[j].AuthorId
+	})
+	return breakdowns
+}
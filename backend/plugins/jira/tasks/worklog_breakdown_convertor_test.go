@@ -0,0 +1,41 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregateWorklogs(t *testing.T) {
+	worklogs := []*models.JiraWorklog{
+		{IssueId: 2, AuthorId: "bob", TimeSpentSeconds: 600},
+		{IssueId: 1, AuthorId: "alice", TimeSpentSeconds: 3600},
+		{IssueId: 1, AuthorId: "", TimeSpentSeconds: 60},
+		{IssueId: 1, AuthorId: "alice", TimeSpentSeconds: 1800},
+		{IssueId: 1, AuthorId: "", TimeSpentSeconds: 120},
+	}
+	assert.Equal(t, []*models.JiraIssueWorklogBreakdown{
+		{ConnectionId: 1, IssueId: 1, AuthorId: "alice", TimeSpentSeconds: 5400, WorklogCount: 2},
+		{ConnectionId: 1, IssueId: 1, AuthorId: models.WorklogAuthorUnknown, TimeSpentSeconds: 180, WorklogCount: 2},
+		{ConnectionId: 1, IssueId: 2, AuthorId: "bob", TimeSpentSeconds: 600, WorklogCount: 1},
+	}, aggregateWorklogs(1, worklogs))
+	assert.Empty(t, aggregateWorklogs(1, nil))
+}
@@ -35,6 +35,7 @@ var CollectWorklogsMeta = plugin.SubTaskMeta{
 	Name:             "collectWorklogs",
 	EntryPoint:       CollectWorklogs,
 	EnabledByDefault: true,
+	RunsWhenStaged:   true,
 	Description:      "collect Jira work logs, supports both timeFilter and diffSync.",
 	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
 }
@@ -57,6 +58,14 @@ func CollectWorklogs(taskCtx plugin.SubTaskContext) errors.Error {
 		return err
 	}
 
+	if collectorWithState.IsIncremental() && data.Options.ScopeConfig != nil && data.Options.ScopeConfig.CollectWorklogsUpdatedSince {
+		err = initUpdatedWorklogsCollector(taskCtx, collectorWithState)
+		if err != nil {
+			return err
+		}
+		return collectorWithState.Execute()
+	}
+
 	// filter out issue_ids that needed collection
 	clauses := []dal.Clause{
 		dal.Select("i.issue_id, i.updated AS update_time"),
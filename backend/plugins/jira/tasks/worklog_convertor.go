@@ -52,6 +52,7 @@ func ConvertWorklogs(taskCtx plugin.SubTaskContext) errors.Error {
               ON _tool_jira_board_issues.connection_id = _tool_jira_worklogs.connection_id
                    AND _tool_jira_board_issues.issue_id = _tool_jira_worklogs.issue_id`),
 		dal.Where("_tool_jira_board_issues.connection_id = ? AND _tool_jira_board_issues.board_id = ?", connectionId, boardId),
+		securityLevelFilter("_tool_jira_worklogs.connection_id", "_tool_jira_worklogs.issue_id"),
 	}
 	cursor, err := db.Cursor(clauses...)
 	if err != nil {
@@ -81,7 +82,11 @@ func ConvertWorklogs(taskCtx plugin.SubTaskContext) errors.Error {
 				IssueId:          issueIdGen.Generate(jiraWorklog.ConnectionId, jiraWorklog.IssueId),
 				TimeSpentMinutes: jiraWorklog.TimeSpentSeconds / 60,
 				StartedDate:      &jiraWorklog.Started,
-				LoggedDate:       &jiraWorklog.Updated,
+				LoggedDate:       jiraWorklog.Created,
+			}
+			// worklogs extracted before the creation date was kept
+			if worklog.LoggedDate == nil {
+				worklog.LoggedDate = &jiraWorklog.Updated
 			}
 			if jiraWorklog.AuthorId != "" {
 				worklog.AuthorId = accountIdGen.Generate(connectionId, jiraWorklog.AuthorId)
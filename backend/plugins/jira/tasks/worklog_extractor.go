@@ -19,9 +19,12 @@ package tasks
 
 import (
 	"encoding/json"
+
+	"github.com/apache/incubator-devlake/core/dal"
 	"github.com/apache/incubator-devlake/core/errors"
 	"github.com/apache/incubator-devlake/core/plugin"
 	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
 	"github.com/apache/incubator-devlake/plugins/jira/tasks/apiv2models"
 )
 
@@ -37,6 +40,17 @@ var ExtractWorklogsMeta = plugin.SubTaskMeta{
 
 func ExtractWorklogs(taskCtx plugin.SubTaskContext) errors.Error {
 	data := taskCtx.GetData().(*JiraTaskData)
+	// worklogs collected along with their issue may have been deleted since
+	var deletedIds []string
+	err := taskCtx.GetDal().Pluck("worklog_id", &deletedIds, dal.From(&models.JiraWorklogChange{}),
+		dal.Where("connection_id = ? AND deleted = ?", data.Options.ConnectionId, true))
+	if err != nil {
+		return err
+	}
+	deleted := make(map[string]bool, len(deletedIds))
+	for _, id := range deletedIds {
+		deleted[id] = true
+	}
 	extractor, err := api.NewApiExtractor(api.ApiExtractorArgs{
 		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
 			Ctx: taskCtx,
@@ -57,6 +71,13 @@ func ExtractWorklogs(taskCtx plugin.SubTaskContext) errors.Error {
 			if err != nil {
 				return nil, err
 			}
+			if deleted[worklog.ID] {
+				return nil, nil
+			}
+			// worklogs listed by id were not collected along with their issue
+			if input.IssueId == 0 {
+				return []interface{}{worklog.ToToolLayer(data.Options.ConnectionId, nil)}, nil
+			}
 			return []interface{}{worklog.ToToolLayer(data.Options.ConnectionId, &input.UpdateTime)}, nil
 		},
 	})
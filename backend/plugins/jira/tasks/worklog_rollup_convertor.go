@@ -0,0 +1,107 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ConvertWorklogRollups
+
+var ConvertWorklogRollupsMeta = plugin.SubTaskMeta{
+	Name:             "convertWorklogRollups",
+	EntryPoint:       ConvertWorklogRollups,
+	EnabledByDefault: true,
+	Description:      "roll up the time logged on Jira issues to their parents",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+// ConvertWorklogRollups writes the time logged on the issues of the board along with all their descendants. The
+// descendants are looked up across the whole connection, like ConvertIssueHierarchy, and each issue only counts its
+// own time spent, which leaves out the subtasks, so that no time is counted twice
+func ConvertWorklogRollups(taskCtx plugin.SubTaskContext) errors.Error {
+	db := taskCtx.GetDal()
+	data := taskCtx.GetData().(*JiraTaskData)
+	connectionId := data.Options.ConnectionId
+	maxDepth := models.DefaultMaxHierarchyDepth
+	if data.Options.ScopeConfig != nil && data.Options.ScopeConfig.MaxHierarchyDepth > 0 {
+		maxDepth = data.Options.ScopeConfig.MaxHierarchyDepth
+	}
+
+	var issues []*hierarchyIssue
+	err := db.All(&issues,
+		dal.Select("issue_id, issue_key, parent_id, epic_key, spent_minutes"),
+		dal.From(&models.JiraIssue{}),
+		dal.Where("connection_id = ? AND security_excluded = ?", connectionId, false),
+	)
+	if err != nil {
+		return err
+	}
+	rollups := rollupSpentMinutes(issues, getIssueParents(issues), maxDepth)
+
+	var boardIssues []*models.JiraIssue
+	err = db.All(&boardIssues,
+		dal.Select("ji.issue_id"),
+		dal.From("_tool_jira_issues ji"),
+		dal.Join(`JOIN _tool_jira_board_issues bi ON (bi.connection_id = ji.connection_id AND bi.issue_id = ji.issue_id)`),
+		dal.Where("ji.connection_id = ? AND bi.board_id = ?", connectionId, data.Options.BoardId),
+	)
+	if err != nil {
+		return err
+	}
+	issueIdGen := didgen.NewDomainIdGenerator(&models.JiraIssue{})
+	for _, issue := range boardIssues {
+		// issues without any time logged on them or their descendants show zero
+		rollup := rollups[issue.IssueId]
+		err = db.UpdateColumn(&ticket.Issue{}, "rolled_up_time_spent_minutes", &rollup,
+			dal.Where("id = ?", issueIdGen.Generate(connectionId, issue.IssueId)))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rollupSpentMinutes adds the time spent on each issue to the issue itself and to each of its ancestors, at most
+// maxDepth of them and once each even when the parents loop
+func rollupSpentMinutes(issues []*hierarchyIssue, parents map[uint64]uint64, maxDepth int) map[uint64]int64 {
+	result := make(map[uint64]int64, len(issues))
+	for _, issue := range issues {
+		if issue.SpentMinutes == 0 {
+			continue
+		}
+		result[issue.IssueId] += issue.SpentMinutes
+		visited := map[uint64]bool{issue.IssueId: true}
+		current := issue.IssueId
+		for depth := 0; depth < maxDepth; depth++ {
+			parentId, ok := parents[current]
+			if !ok || parentId == 0 || visited[parentId] {
+				break
+			}
+			visited[parentId] = true
+			result[parentId] += issue.SpentMinutes
+			current = parentId
+		}
+	}
+	return result
+}
@@ -0,0 +1,38 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRollupSpentMinutes(t *testing.T) {
+	issues := []*hierarchyIssue{
+		{IssueId: 1, SpentMinutes: 10},
+		{IssueId: 2, ParentId: 1, SpentMinutes: 20},
+		{IssueId: 3, ParentId: 2, SpentMinutes: 30},
+		{IssueId: 4, ParentId: 1},
+		{IssueId: 10, ParentId: 11, SpentMinutes: 5},
+		{IssueId: 11, ParentId: 10, SpentMinutes: 7},
+	}
+	parents := map[uint64]uint64{2: 1, 3: 2, 4: 1, 10: 11, 11: 10}
+	assert.Equal(t, map[uint64]int64{1: 60, 2: 50, 3: 30, 10: 12, 11: 12}, rollupSpentMinutes(issues, parents, 10))
+	assert.Equal(t, map[uint64]int64{1: 30, 2: 50, 3: 30, 10: 12, 11: 12}, rollupSpentMinutes(issues, parents, 1))
+}
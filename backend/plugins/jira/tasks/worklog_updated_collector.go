@@ -0,0 +1,338 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	coreModels "github.com/apache/incubator-devlake/core/models"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/apache/incubator-devlake/plugins/jira/tasks/apiv2models"
+)
+
+const (
+	RAW_WORKLOG_UPDATES_TABLE   = "jira_api_worklog_updates"
+	RAW_WORKLOG_DELETIONS_TABLE = "jira_api_worklog_deletions"
+)
+
+// worklogChangeBatchSize is the number of worklog changes saved at once
+const worklogChangeBatchSize = 500
+
+// worklogListBatchSize is the number of worklogs fetched by request, Jira accepts up to 1000 but every raw row
+// records the ids of its batch
+const worklogListBatchSize = 100
+
+type worklogIdsInput struct {
+	WorklogIds []uint64 `json:"worklog_ids"`
+}
+
+// initUpdatedWorklogsCollector lists the worklogs updated and deleted across the connection since its previous
+// listing into _tool_jira_worklog_changes, then sets up the collection of the updated ones belonging to the board.
+// Deleted worklogs are dropped from the raw data of the board so that the extraction leaves them out
+func initUpdatedWorklogsCollector(taskCtx plugin.SubTaskContext, collectorWithState *api.ApiCollectorStateManager) errors.Error {
+	db := taskCtx.GetDal()
+	data := taskCtx.GetData().(*JiraTaskData)
+	since := *collectorWithState.LatestState.LatestSuccessStart
+
+	err := collectConnectionWorklogChanges(taskCtx, since)
+	if err != nil {
+		return err
+	}
+	err = dropDeletedWorklogs(db, data.Options.ConnectionId, data.Options.BoardId, since)
+	if err != nil {
+		return err
+	}
+	err = pruneWorklogChanges(db, data.Options.ConnectionId)
+	if err != nil {
+		return err
+	}
+	var boardIssueIds []uint64
+	err = db.Pluck("issue_id", &boardIssueIds, dal.From(&models.JiraBoardIssue{}),
+		dal.Where("connection_id = ? AND board_id = ?", data.Options.ConnectionId, data.Options.BoardId))
+	if err != nil {
+		return err
+	}
+	boardIssues := make(map[uint64]bool, len(boardIssueIds))
+	for _, issueId := range boardIssueIds {
+		boardIssues[issueId] = true
+	}
+	// the issue of a new worklog is only known once it was fetched
+	var changedIds []string
+	err = db.Pluck("DISTINCT c.worklog_id", &changedIds,
+		dal.From("_tool_jira_worklog_changes c"),
+		dal.Join(`LEFT JOIN _tool_jira_worklogs w ON (w.connection_id = c.connection_id AND w.worklog_id = c.worklog_id)`),
+		dal.Where(`c.connection_id = ? AND c.deleted = ? AND c.updated >= ? AND (w.issue_id IS NULL OR w.issue_id IN (
+			SELECT issue_id FROM _tool_jira_board_issues WHERE connection_id = ? AND board_id = ?))`,
+			data.Options.ConnectionId, false, since, data.Options.ConnectionId, data.Options.BoardId),
+	)
+	if err != nil {
+		return err
+	}
+	worklogIds := make([]uint64, 0, len(changedIds))
+	for _, id := range changedIds {
+		worklogId, err := errors.Convert01(strconv.ParseUint(id, 10, 64))
+		if err != nil {
+			return err
+		}
+		worklogIds = append(worklogIds, worklogId)
+	}
+
+	iterator := api.NewQueueIterator()
+	for start := 0; start < len(worklogIds); start += worklogListBatchSize {
+		end := start + worklogListBatchSize
+		if end > len(worklogIds) {
+			end = len(worklogIds)
+		}
+		iterator.Push(&worklogIdsInput{WorklogIds: worklogIds[start:end]})
+	}
+	return collectorWithState.InitCollector(api.ApiCollectorArgs{
+		Input:       iterator,
+		ApiClient:   data.ApiClient,
+		UrlTemplate: "api/2/worklog/list",
+		Method:      http.MethodPost,
+		Incremental: true,
+		RequestBody: func(reqData *api.RequestData) map[string]interface{} {
+			return map[string]interface{}{"ids": reqData.Input.(*worklogIdsInput).WorklogIds}
+		},
+		ResponseParser: func(res *http.Response) ([]json.RawMessage, errors.Error) {
+			var worklogs []json.RawMessage
+			err := api.UnmarshalResponse(res, &worklogs)
+			if err != nil {
+				return nil, err
+			}
+			// new worklogs may belong to other boards
+			var result []json.RawMessage
+			for _, raw := range worklogs {
+				var worklog struct {
+					IssueId uint64 `json:"issueId,string"`
+				}
+				if err := errors.Convert(json.Unmarshal(raw, &worklog)); err != nil {
+					return nil, err
+				}
+				if boardIssues[worklog.IssueId] {
+					result = append(result, raw)
+				}
+			}
+			return result, nil
+		},
+		AfterResponse: ignoreHTTPStatus404,
+	})
+}
+
+// dropDeletedWorklogs removes the raw rows of the board holding worklogs deleted since the board was last collected
+func dropDeletedWorklogs(db dal.Dal, connectionId, boardId uint64, since time.Time) errors.Error {
+	rawTable := "_raw_" + RAW_WORKLOGS_TABLE
+	if !db.HasTable(rawTable) {
+		return nil
+	}
+	var rawIds []uint64
+	err := db.Pluck("_raw_data_id", &rawIds,
+		dal.From(&models.JiraWorklog{}),
+		dal.Where(`connection_id = ? AND _raw_data_table = ? AND _raw_data_params = ? AND worklog_id IN (
+			SELECT worklog_id FROM _tool_jira_worklog_changes WHERE connection_id = ? AND deleted = ? AND updated >= ?)`,
+			connectionId, rawTable, plugin.MarshalScopeParams(JiraApiParams{ConnectionId: connectionId, BoardId: boardId}),
+			connectionId, true, since),
+	)
+	if err != nil || len(rawIds) == 0 {
+		return err
+	}
+	return db.Delete(&api.RawData{}, dal.From(rawTable), dal.Where("id IN ?", rawIds))
+}
+
+// pruneWorklogChanges drops the changes older than the earliest collection of the boards of the connection, a
+// board collected before the latest listing lists its changes again
+func pruneWorklogChanges(db dal.Dal, connectionId uint64) errors.Error {
+	var boardIds []uint64
+	err := db.Pluck("board_id", &boardIds, dal.From(&models.JiraBoard{}), dal.Where("connection_id = ?", connectionId))
+	if err != nil {
+		return err
+	}
+	params := make([]string, len(boardIds))
+	for i, boardId := range boardIds {
+		params[i] = plugin.MarshalScopeParams(JiraApiParams{ConnectionId: connectionId, BoardId: boardId})
+	}
+	var states []coreModels.CollectorLatestState
+	err = db.All(&states, dal.Where("raw_data_table = ? AND raw_data_params IN ?", "_raw_"+RAW_WORKLOGS_TABLE, params))
+	if err != nil {
+		return err
+	}
+	before := earliestCollection(states)
+	if before == nil {
+		return nil
+	}
+	return db.Delete(&models.JiraWorklogChange{}, dal.Where("connection_id = ? AND updated < ?", connectionId, *before))
+}
+
+// earliestCollection returns the earliest start of the last successful collections, nil when a board was never
+// collected successfully
+func earliestCollection(states []coreModels.CollectorLatestState) *time.Time {
+	var earliest *time.Time
+	for _, state := range states {
+		if state.LatestSuccessStart == nil {
+			return nil
+		}
+		if earliest == nil || state.LatestSuccessStart.Before(*earliest) {
+			earliest = state.LatestSuccessStart
+		}
+	}
+	return earliest
+}
+
+// collectConnectionWorklogChanges lists the worklog changes of the connection since its previous listing, or since
+// the board was last collected when that is earlier
+func collectConnectionWorklogChanges(taskCtx plugin.SubTaskContext, since time.Time) errors.Error {
+	for _, table := range []string{RAW_WORKLOG_UPDATES_TABLE, RAW_WORKLOG_DELETIONS_TABLE} {
+		err := collectWorklogChanges(taskCtx, table, since)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// collectWorklogChanges pages through a list of worklog changes of the connection, each page starting where the
+// previous one ended, each run listing the changes since the previous one
+func collectWorklogChanges(taskCtx plugin.SubTaskContext, table string, boardSince time.Time) errors.Error {
+	data := taskCtx.GetData().(*JiraTaskData)
+	collectorWithState, err := api.NewStatefulApiCollector(api.RawDataSubTaskArgs{
+		Ctx: taskCtx,
+		Params: JiraApiParams{
+			ConnectionId: data.Options.ConnectionId,
+		},
+		Table: table,
+	}, nil)
+	if err != nil {
+		return err
+	}
+	since := boardSince
+	if latest := collectorWithState.LatestState.LatestSuccessStart; latest != nil && latest.Before(since) {
+		since = *latest
+	}
+	urlTemplate := "api/2/worklog/updated"
+	if table == RAW_WORKLOG_DELETIONS_TABLE {
+		urlTemplate = "api/2/worklog/deleted"
+	}
+	err = collectorWithState.InitCollector(api.ApiCollectorArgs{
+		ApiClient:   data.ApiClient,
+		UrlTemplate: urlTemplate,
+		PageSize:    1000,
+		Incremental: true,
+		GetNextPageCustomData: func(prevReqData *api.RequestData, prevPageResponse *http.Response) (interface{}, errors.Error) {
+			var changes apiv2models.WorklogChanges
+			err := api.UnmarshalResponse(prevPageResponse, &changes)
+			if err != nil {
+				return nil, err
+			}
+			if changes.LastPage {
+				return nil, api.ErrFinishCollect
+			}
+			return changes.Until, nil
+		},
+		Query: func(reqData *api.RequestData) (url.Values, errors.Error) {
+			query := url.Values{}
+			if until, ok := reqData.CustomData.(int64); ok {
+				query.Set("since", strconv.FormatInt(until, 10))
+			} else {
+				query.Set("since", strconv.FormatInt(since.UnixMilli(), 10))
+			}
+			return query, nil
+		},
+		ResponseParser: func(res *http.Response) ([]json.RawMessage, errors.Error) {
+			var changes apiv2models.WorklogChanges
+			err := api.UnmarshalResponse(res, &changes)
+			if err != nil {
+				return nil, err
+			}
+			return changes.Values, nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+	err = collectorWithState.Execute()
+	if err != nil {
+		return err
+	}
+	return storeWorklogChanges(taskCtx.GetDal(), data.Options.ConnectionId, table)
+}
+
+// storeWorklogChanges moves the worklog changes listed into the given raw table into _tool_jira_worklog_changes,
+// keeping the latest change of each worklog
+func storeWorklogChanges(db dal.Dal, connectionId uint64, table string) errors.Error {
+	rawTable := "_raw_" + table
+	if !db.HasTable(rawTable) {
+		return nil
+	}
+	params := plugin.MarshalScopeParams(JiraApiParams{ConnectionId: connectionId})
+	cursor, err := db.Cursor(dal.From(rawTable), dal.Where("params = ?", params), dal.Orderby("id ASC"))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+	batch := make([]*models.JiraWorklogChange, 0, worklogChangeBatchSize)
+	flush := func() errors.Error {
+		if len(batch) == 0 {
+			return nil
+		}
+		err := db.CreateOrUpdate(batch)
+		batch = batch[:0]
+		return err
+	}
+	for cursor.Next() {
+		row := &api.RawData{}
+		err = db.Fetch(cursor, row)
+		if err != nil {
+			return err
+		}
+		change, err := toWorklogChange(connectionId, row.Data, table == RAW_WORKLOG_DELETIONS_TABLE)
+		if err != nil {
+			return err
+		}
+		batch = append(batch, change)
+		if len(batch) == worklogChangeBatchSize {
+			if err = flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err = flush(); err != nil {
+		return err
+	}
+	return db.Delete(&api.RawData{}, dal.From(rawTable), dal.Where("params = ?", params))
+}
+
+func toWorklogChange(connectionId uint64, data json.RawMessage, deleted bool) (*models.JiraWorklogChange, errors.Error) {
+	var change apiv2models.WorklogChange
+	if err := errors.Convert(json.Unmarshal(data, &change)); err != nil {
+		return nil, err
+	}
+	return &models.JiraWorklogChange{
+		ConnectionId: connectionId,
+		WorklogId:    strconv.FormatUint(change.WorklogId, 10),
+		Updated:      time.UnixMilli(change.UpdatedTime),
+		Deleted:      deleted,
+	}, nil
+}
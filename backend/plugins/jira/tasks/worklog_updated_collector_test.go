@@ -0,0 +1,57 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	coreModels "github.com/apache/incubator-devlake/core/models"
+	"github.com/apache/incubator-devlake/plugins/jira/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToWorklogChange(t *testing.T) {
+	updated := time.Date(2023, 9, 1, 10, 0, 0, 0, time.UTC)
+	change, err := toWorklogChange(1, []byte(`{"worklogId":10001,"updatedTime":1693562400000}`), false)
+	assert.Nil(t, err)
+	assert.Equal(t, &models.JiraWorklogChange{ConnectionId: 1, WorklogId: "10001", Updated: change.Updated}, change)
+	assert.True(t, change.Updated.Equal(updated))
+
+	change, err = toWorklogChange(1, []byte(`{"worklogId":10002,"updatedTime":1693562400000}`), true)
+	assert.Nil(t, err)
+	assert.True(t, change.Deleted)
+
+	_, err = toWorklogChange(1, []byte(`{"worklogId":"x"}`), false)
+	assert.NotNil(t, err)
+}
+
+func TestEarliestCollection(t *testing.T) {
+	first := time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC)
+	second := first.Add(time.Hour)
+	assert.Nil(t, earliestCollection(nil))
+	assert.Equal(t, &first, earliestCollection([]coreModels.CollectorLatestState{
+		{LatestSuccessStart: &second},
+		{LatestSuccessStart: &first},
+	}))
+	// a board never collected successfully keeps every change
+	assert.Nil(t, earliestCollection([]coreModels.CollectorLatestState{
+		{LatestSuccessStart: &first},
+		{},
+	}))
+}
@@ -72,6 +72,8 @@ func (p Teambition) GetTablesInfo() []dal.Tabler {
 		&models.TeambitionProject{},
 		&models.TeambitionTaskFlowStatus{},
 		&models.TeambitionTaskScenario{},
+		&models.TeambitionProjectMember{},
+		&models.TeambitionProjectRole{},
 	}
 }
 
@@ -107,6 +109,11 @@ func (p Teambition) SubTaskMetas() []plugin.SubTaskMeta {
 		tasks.CollectProjectsMeta,
 		tasks.ExtractProjectsMeta,
 		tasks.ConvertProjectsMeta,
+		tasks.CollectProjectRolesMeta,
+		tasks.ExtractProjectRolesMeta,
+		tasks.CollectProjectMembersMeta,
+		tasks.ExtractProjectMembersMeta,
+		tasks.ConvertProjectMembersMeta,
 		tasks.CollectSprintsMeta,
 		tasks.ExtractSprintsMeta,
 		tasks.ConvertSprintsMeta,
@@ -142,6 +149,10 @@ func (p Teambition) PrepareTaskData(taskCtx plugin.TaskContext, options map[stri
 	if err != nil {
 		return nil, errors.Default.Wrap(err, "unable to get Teambition API client instance")
 	}
+	op.CstZone, err = tasks.GetCstZone(connection.TimeZone)
+	if err != nil {
+		return nil, err
+	}
 	taskData := &tasks.TeambitionTaskData{
 		Options:   op,
 		ApiClient: apiClient,
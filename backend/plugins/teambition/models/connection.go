@@ -32,6 +32,8 @@ type TeambitionConn struct {
 	helper.AppKey         `mapstructure:",squash"`
 	TenantId              string `mapstructure:"tenantId" validate:"required" json:"tenantId"`
 	TenantType            string `mapstructure:"tenantType" validate:"required" json:"tenantType"`
+	// TimeZone is the IANA time zone the date-only dues of tasks are in, China Standard Time by default
+	TimeZone string `mapstructure:"timeZone" json:"timeZone" gorm:"type:varchar(100)"`
 }
 
 // TeambitionConnection holds TeambitionConn plus ID/Name for database storage
@@ -23,5 +23,9 @@ import "github.com/apache/incubator-devlake/core/plugin"
 func All() []plugin.MigrationScript {
 	return []plugin.MigrationScript{
 		new(addInitTables),
+		new(addProjectMembers),
+		new(addTimeZoneToConnections),
+		new(addTeamIdToTasks),
+		new(addProjectRoles),
 	}
 }
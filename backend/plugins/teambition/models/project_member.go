@@ -0,0 +1,43 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/apache/incubator-devlake/core/models/common"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+)
+
+type TeambitionProjectMember struct {
+	ConnectionId uint64           `gorm:"primaryKey;type:BIGINT"`
+	ProjectId    string           `gorm:"primaryKey;type:varchar(100)" json:"projectId"`
+	UserId       string           `gorm:"primaryKey;type:varchar(100)" json:"userId"`
+	MemberId     string           `gorm:"type:varchar(100)" json:"memberId"`
+	Name         string           `gorm:"type:varchar(255)" json:"name"`
+	Role         int              `json:"role"`
+	RoleIds      []string         `gorm:"serializer:json;type:text" json:"roleIds"`
+	Joined       *api.Iso8601Time `json:"joined"`
+	IsQuited     bool             `json:"isQuited"`
+	// Removed flags members no longer listed by the project, they are kept for historical attribution
+	Removed bool
+
+	common.NoPKModel
+}
+
+func (TeambitionProjectMember) TableName() string {
+	return "_tool_teambition_project_members"
+}
@@ -55,6 +55,8 @@ type TeambitionTask struct {
 
 	StdType   string `gorm:"type:varchar(100)" json:"stdType"`
 	StdStatus string `gorm:"type:varchar(100)" json:"stdStatus"`
+	// TeamId is read out of the field given by the fieldMappings of the scope config, if any
+	TeamId string `gorm:"type:varchar(255)" json:"teamId"`
 
 	common.NoPKModel
 }
@@ -0,0 +1,105 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/core/plugin"
+	helper "github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/teambition/models"
+	"reflect"
+)
+
+var ConvertProjectMembersMeta = plugin.SubTaskMeta{
+	Name:             "convertProjectMembers",
+	EntryPoint:       ConvertProjectMembers,
+	EnabledByDefault: true,
+	Description:      "convert teambition project members",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET, plugin.DOMAIN_TYPE_CROSS},
+}
+
+func ConvertProjectMembers(taskCtx plugin.SubTaskContext) errors.Error {
+	rawDataSubTaskArgs, data := CreateRawDataSubTaskArgs(taskCtx, RAW_PROJECT_MEMBER_TABLE)
+	db := taskCtx.GetDal()
+	clauses := []dal.Clause{
+		dal.From(&models.TeambitionProjectMember{}),
+		dal.Where("connection_id = ? AND project_id = ?", data.Options.ConnectionId, data.Options.ProjectId),
+	}
+
+	var roles []*models.TeambitionProjectRole
+	err := db.All(&roles, dal.Where("connection_id = ? AND project_id = ?", data.Options.ConnectionId, data.Options.ProjectId))
+	if err != nil {
+		return err
+	}
+	roleNames := make(map[string]string, len(roles))
+	for _, role := range roles {
+		roleNames[role.Id] = role.Name
+	}
+
+	cursor, err := db.Cursor(clauses...)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+	converter, err := helper.NewDataConverter(helper.DataConverterArgs{
+		RawDataSubTaskArgs: *rawDataSubTaskArgs,
+		InputRowType:       reflect.TypeOf(models.TeambitionProjectMember{}),
+		Input:              cursor,
+		Convert: func(inputRow interface{}) ([]interface{}, errors.Error) {
+			member := inputRow.(*models.TeambitionProjectMember)
+			boardMember := &ticket.BoardMember{
+				BoardId:    getProjectIdGen().Generate(data.Options.ConnectionId, member.ProjectId),
+				AccountId:  getAccountIdGen().Generate(data.Options.ConnectionId, member.UserId),
+				Role:       getProjectMemberRole(member, roleNames),
+				IsActive:   !member.Removed && !member.IsQuited,
+				JoinedDate: member.Joined.ToNullableTime(),
+			}
+			return []interface{}{
+				boardMember,
+			}, nil
+		},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return converter.Execute()
+}
+
+// getProjectMemberRole returns the name of the first project role of the member, the members whose roles are unknown
+// are named after their role level instead
+func getProjectMemberRole(member *models.TeambitionProjectMember, roleNames map[string]string) string {
+	for _, roleId := range member.RoleIds {
+		if name := roleNames[roleId]; name != "" {
+			return name
+		}
+	}
+	switch role := member.Role; {
+	case role >= 2:
+		return "OWNER"
+	case role == 1:
+		return "ADMIN"
+	case role < 0:
+		return "GUEST"
+	default:
+		return "MEMBER"
+	}
+}
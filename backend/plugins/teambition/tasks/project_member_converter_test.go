@@ -0,0 +1,51 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+
+	"github.com/apache/incubator-devlake/plugins/teambition/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetProjectMemberRole(t *testing.T) {
+	roleNames := map[string]string{"r-dev": "Developer", "r-qa": "Tester", "r-empty": ""}
+	tests := []struct {
+		name    string
+		role    int
+		roleIds []string
+		want    string
+	}{
+		{name: "named role", role: 0, roleIds: []string{"r-dev"}, want: "Developer"},
+		{name: "first named role", role: 1, roleIds: []string{"r-qa", "r-dev"}, want: "Tester"},
+		{name: "unknown role skipped", role: 0, roleIds: []string{"r-gone", "r-dev"}, want: "Developer"},
+		{name: "role without name skipped", role: 0, roleIds: []string{"r-empty", "r-qa"}, want: "Tester"},
+		{name: "owner level", role: 2, want: "OWNER"},
+		{name: "above owner level", role: 4, want: "OWNER"},
+		{name: "admin level", role: 1, roleIds: []string{"r-gone"}, want: "ADMIN"},
+		{name: "member level", role: 0, want: "MEMBER"},
+		{name: "guest level", role: -1, want: "GUEST"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			member := &models.TeambitionProjectMember{Role: tt.role, RoleIds: tt.roleIds}
+			assert.Equal(t, tt.want, getProjectMemberRole(member, roleNames))
+		})
+	}
+}
@@ -0,0 +1,93 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/teambition/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ExtractProjectMembers
+
+var ExtractProjectMembersMeta = plugin.SubTaskMeta{
+	Name:             "extractProjectMembers",
+	EntryPoint:       ExtractProjectMembers,
+	EnabledByDefault: true,
+	Description:      "Extract raw project members into tool layer table _tool_teambition_project_members",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET, plugin.DOMAIN_TYPE_CROSS},
+}
+
+func ExtractProjectMembers(taskCtx plugin.SubTaskContext) errors.Error {
+	rawDataSubTaskArgs, data := CreateRawDataSubTaskArgs(taskCtx, RAW_PROJECT_MEMBER_TABLE)
+	db := taskCtx.GetDal()
+	clauses := []dal.Clause{
+		dal.Where("connection_id = ? AND project_id = ?", data.Options.ConnectionId, data.Options.ProjectId),
+	}
+	// the extractor replaces the members of the project, the ones it drops were removed from the project since the
+	// previous run and get flagged instead so that their work remains attributed
+	var previousMembers []*models.TeambitionProjectMember
+	err := db.All(&previousMembers, clauses...)
+	if err != nil {
+		return err
+	}
+	extractor, err := api.NewApiExtractor(api.ApiExtractorArgs{
+		RawDataSubTaskArgs: *rawDataSubTaskArgs,
+		Extract: func(row *api.RawData) ([]interface{}, errors.Error) {
+			member := &models.TeambitionProjectMember{}
+			err := errors.Convert(json.Unmarshal(row.Data, member))
+			if err != nil {
+				return nil, err
+			}
+			member.ConnectionId = data.Options.ConnectionId
+			member.ProjectId = data.Options.ProjectId
+			return []interface{}{member}, nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+	err = extractor.Execute()
+	if err != nil {
+		return err
+	}
+
+	var currentUserIds []string
+	err = db.Pluck("user_id", &currentUserIds, append(clauses, dal.From(&models.TeambitionProjectMember{}))...)
+	if err != nil {
+		return err
+	}
+	current := make(map[string]struct{}, len(currentUserIds))
+	for _, userId := range currentUserIds {
+		current[userId] = struct{}{}
+	}
+	for _, member := range previousMembers {
+		if _, ok := current[member.UserId]; ok {
+			continue
+		}
+		member.Removed = true
+		err = db.CreateOrUpdate(member)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
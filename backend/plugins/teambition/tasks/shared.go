@@ -24,6 +24,7 @@ import (
 	"github.com/apache/incubator-devlake/core/plugin"
 	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
 	"github.com/apache/incubator-devlake/plugins/teambition/models"
+	"strconv"
 	"strings"
 )
 
@@ -116,6 +117,15 @@ func getStdTypeMappings(data *TeambitionTaskData) map[string]string {
 	return stdTypeMappings
 }
 
+// getPriority returns the issue priority a task priority is mapped to
+func getPriority(data *TeambitionTaskData, priority int) string {
+	original := strconv.Itoa(priority)
+	if mapped, ok := data.Options.TransformationRules.PriorityMappings[original]; ok {
+		return mapped
+	}
+	return original
+}
+
 func getStatusMapping(data *TeambitionTaskData) map[string]string {
 	statusMapping := make(map[string]string)
 	mapping := data.Options.TransformationRules.StatusMappings
@@ -0,0 +1,50 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPriority(t *testing.T) {
+	mappings := map[string]string{"0": "Normal", "1": "Urgent", "2": "Very Urgent"}
+	tests := []struct {
+		name     string
+		mappings map[string]string
+		priority int
+		want     string
+	}{
+		{name: "mapped", mappings: mappings, priority: 1, want: "Urgent"},
+		{name: "mapped default priority", mappings: mappings, priority: 0, want: "Normal"},
+		{name: "unknown priority", mappings: mappings, priority: 5, want: "5"},
+		{name: "negative priority", mappings: mappings, priority: -1, want: "-1"},
+		{name: "without mappings", mappings: nil, priority: 2, want: "2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := &TeambitionTaskData{
+				Options: &TeambitionOptions{
+					TransformationRules: TransformationRules{PriorityMappings: tt.mappings},
+				},
+			}
+			assert.Equal(t, tt.want, getPriority(data, tt.priority))
+		})
+	}
+}
@@ -68,7 +68,7 @@ func ConvertTasks(taskCtx plugin.SubTaskContext) errors.Error {
 				IssueKey:                userTool.Id,
 				Title:                   userTool.Content,
 				Description:             userTool.Note,
-				Priority:                strconv.Itoa(userTool.Priority),
+				Priority:                getPriority(data, userTool.Priority),
 				ParentIssueId:           userTool.ParentTaskId,
 				CreatorId:               userTool.CreatorId,
 				OriginalProject:         getProjectIdGen().Generate(data.Options.ConnectionId, data.Options.ProjectId),
@@ -81,6 +81,11 @@ func ConvertTasks(taskCtx plugin.SubTaskContext) errors.Error {
 				ResolutionDate:          userTool.AccomplishTime.ToNullableTime(),
 				CreatedDate:             userTool.Created.ToNullableTime(),
 				UpdatedDate:             userTool.Updated.ToNullableTime(),
+				DueDate:                 userTool.DueDate.ToNullableTime(),
+				StartDate:               userTool.StartDate.ToNullableTime(),
+			}
+			if userTool.TeamId != "" {
+				issue.TeamId = &userTool.TeamId
 			}
 			if storyPoint, ok := strconv.ParseFloat(userTool.StoryPoint, 64); ok == nil {
 				issue.StoryPoint = storyPoint
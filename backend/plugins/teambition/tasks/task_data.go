@@ -18,6 +18,7 @@ limitations under the License.
 package tasks
 
 import (
+	"fmt"
 	"github.com/apache/incubator-devlake/core/errors"
 	helper "github.com/apache/incubator-devlake/helpers/pluginhelper/api"
 	"time"
@@ -32,6 +33,21 @@ type TeambitionOptions struct {
 	TransformationRules TransformationRules `json:"transformationRules"`
 }
 
+// defaultCstZone is the time zone of the connections without one, Teambition serving China Standard Time
+var defaultCstZone = time.FixedZone("CST", 8*60*60)
+
+// GetCstZone loads the time zone of the connection, defaultCstZone if it has none
+func GetCstZone(timeZone string) (*time.Location, errors.Error) {
+	if timeZone == "" {
+		return defaultCstZone, nil
+	}
+	loc, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, errors.BadInput.Wrap(err, fmt.Sprintf("invalid timeZone %s", timeZone))
+	}
+	return loc, nil
+}
+
 type TeambitionTaskData struct {
 	Options   *TeambitionOptions
 	ApiClient *helper.ApiAsyncClient
@@ -47,6 +63,9 @@ func DecodeAndValidateTaskOptions(options map[string]interface{}) (*TeambitionOp
 	if op.ConnectionId == 0 {
 		return nil, errors.Default.New("connectionId is invalid")
 	}
+	if err := op.TransformationRules.FieldMappings.Validate(); err != nil {
+		return nil, err
+	}
 	return &op, nil
 }
 
@@ -63,4 +82,9 @@ type TypeMappings map[string]TypeMapping
 type TransformationRules struct {
 	TypeMappings   TypeMappings   `json:"typeMappings"`
 	StatusMappings StatusMappings `json:"statusMappings"`
+	// FieldMappings reads `storyPoint`, `startDate` and `teamId` out of the given task fields, custom fields are
+	// referred to by their cfId
+	FieldMappings helper.FieldMappings `json:"fieldMappings"`
+	// PriorityMappings maps the priorities of tasks to the priorities of issues, the unmapped ones being kept as is
+	PriorityMappings map[string]string `json:"priorityMappings"`
 }
@@ -19,6 +19,11 @@ package tasks
 
 import (
 	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
 	"github.com/apache/incubator-devlake/core/errors"
 	"github.com/apache/incubator-devlake/core/plugin"
 	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
@@ -40,14 +45,23 @@ func ExtractTasks(taskCtx plugin.SubTaskContext) errors.Error {
 	extractor, err := api.NewApiExtractor(api.ApiExtractorArgs{
 		RawDataSubTaskArgs: *rawDataSubTaskArgs,
 		Extract: func(row *api.RawData) ([]interface{}, errors.Error) {
-			userRes := models.TeambitionTask{}
-			err := errors.Convert(json.Unmarshal(row.Data, &userRes))
+			res := teambitionTaskRes{}
+			err := errors.Convert(json.Unmarshal(row.Data, &res))
+			if err != nil {
+				return nil, err
+			}
+			userRes := res.TeambitionTask
+			userRes.DueDate, err = parseDueDate(res.DueDate, data.Options.CstZone)
 			if err != nil {
 				return nil, err
 			}
 			results := make([]interface{}, 0)
 			toolL := userRes
 			toolL.ConnectionId = data.Options.ConnectionId
+			err = applyFieldMappings(&toolL, data.Options.TransformationRules.FieldMappings, row.Data)
+			if err != nil {
+				return nil, err
+			}
 			results = append(results, &toolL)
 			for _, tagId := range userRes.TagIds {
 				taskTag := &models.TeambitionTaskTagTask{
@@ -67,3 +81,66 @@ func ExtractTasks(taskCtx plugin.SubTaskContext) errors.Error {
 
 	return extractor.Execute()
 }
+
+// teambitionTaskRes reads the due of tasks apart from the task, it may be a date only
+type teambitionTaskRes struct {
+	models.TeambitionTask
+	DueDate *string `json:"dueDate"`
+}
+
+var dateOnlyPattern = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+// parseDueDate parses the due of a task, a date-only due lasting until the end of its day in the time zone
+func parseDueDate(dueDate *string, loc *time.Location) (*api.Iso8601Time, errors.Error) {
+	if dueDate == nil || *dueDate == "" {
+		return nil, nil
+	}
+	due := &api.Iso8601Time{}
+	if !dateOnlyPattern.MatchString(*dueDate) {
+		return due, errors.Convert(due.UnmarshalJSON([]byte(strconv.Quote(*dueDate))))
+	}
+	if loc == nil {
+		loc = defaultCstZone
+	}
+	day, err := time.ParseInLocation("2006-01-02", *dueDate, loc)
+	if err != nil {
+		return nil, errors.BadInput.Wrap(err, fmt.Sprintf("invalid dueDate %s", *dueDate))
+	}
+	return due, errors.Convert(due.Scan(day.AddDate(0, 0, 1).Add(-time.Second)))
+}
+
+// applyFieldMappings overrides the fields of the task with the ones mapped from its source fields
+func applyFieldMappings(task *models.TeambitionTask, mappings api.FieldMappings, data []byte) errors.Error {
+	if len(mappings) == 0 {
+		return nil
+	}
+	var fields map[string]interface{}
+	err := errors.Convert(json.Unmarshal(data, &fields))
+	if err != nil {
+		return err
+	}
+	// custom fields are listed along with their ids, expose their values under their ids
+	customfields, _ := fields["customfields"].([]interface{})
+	for _, customfield := range customfields {
+		if cf, ok := customfield.(map[string]interface{}); ok {
+			if cfId, ok := cf["cfId"].(string); ok {
+				fields[cfId] = cf["value"]
+			}
+		}
+	}
+	resolver := api.NewFieldResolver(mappings, fields)
+	if storyPoint, ok := resolver.Float(api.FieldMappingStoryPoint); ok {
+		task.StoryPoint = strconv.FormatFloat(storyPoint, 'f', -1, 64)
+	}
+	if startDate, ok := resolver.Time(api.FieldMappingStartDate); ok {
+		task.StartDate = &api.Iso8601Time{}
+		err = errors.Convert(task.StartDate.Scan(*startDate))
+		if err != nil {
+			return err
+		}
+	}
+	if teamId, ok := resolver.String(api.FieldMappingTeamId); ok {
+		task.TeamId = teamId
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDueDate(t *testing.T) {
+	utc := time.UTC
+	newYork := time.FixedZone("EDT", -4*60*60)
+	tests := []struct {
+		name    string
+		dueDate *string
+		loc     *time.Location
+		want    *time.Time
+		wantErr bool
+	}{
+		{name: "missing", dueDate: nil},
+		{name: "empty", dueDate: strPtr("")},
+		{
+			name:    "timestamp",
+			dueDate: strPtr("2023-08-01T10:00:00.000Z"),
+			loc:     newYork,
+			want:    timePtr(time.Date(2023, 8, 1, 10, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:    "timestamp with offset",
+			dueDate: strPtr("2023-08-01T18:00:00+08:00"),
+			want:    timePtr(time.Date(2023, 8, 1, 10, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:    "date in the default zone",
+			dueDate: strPtr("2023-08-01"),
+			want:    timePtr(time.Date(2023, 8, 1, 15, 59, 59, 0, time.UTC)),
+		},
+		{
+			name:    "date in utc",
+			dueDate: strPtr("2023-08-01"),
+			loc:     utc,
+			want:    timePtr(time.Date(2023, 8, 1, 23, 59, 59, 0, time.UTC)),
+		},
+		{
+			name:    "date west of utc",
+			dueDate: strPtr("2023-08-01"),
+			loc:     newYork,
+			want:    timePtr(time.Date(2023, 8, 2, 3, 59, 59, 0, time.UTC)),
+		},
+		{name: "invalid date", dueDate: strPtr("2023-13-45"), wantErr: true},
+		{name: "garbage", dueDate: strPtr("next friday"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseDueDate(tt.dueDate, tt.loc)
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			if tt.want == nil {
+				assert.Nil(t, got)
+				return
+			}
+			require.NotNil(t, got)
+			assert.True(t, tt.want.Equal(got.ToTime()), "want %v, got %v", tt.want, got.ToTime())
+		})
+	}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
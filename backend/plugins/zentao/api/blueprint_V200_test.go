@@ -125,6 +125,74 @@ func TestMakeDataSourcePipelinePlanV200(t *testing.T) {
 	assert.Equal(t, expectScopes, scopes)
 }
 
+func TestMakeDataSourcePipelinePlanV200BoardGrouping(t *testing.T) {
+	connection := &models.ZentaoConnection{
+		BaseConnection: helper.BaseConnection{
+			Name:  "zentao-test",
+			Model: common.Model{ID: 1},
+		},
+	}
+	mockMeta := mockplugin.NewPluginMeta(t)
+	mockMeta.On("RootPkgPath").Return("github.com/apache/incubator-devlake/plugins/zentao")
+	mockMeta.On("Name").Return("zentao").Maybe()
+	assert.Nil(t, plugin.RegisterPlugin("zentao", mockMeta))
+
+	for _, tc := range []struct {
+		boardGrouping string
+		boards        []plugin.Scope
+	}{
+		{
+			boardGrouping: "product",
+			boards: []plugin.Scope{
+				&ticket.Board{DomainEntity: domainlayer.DomainEntity{Id: "zentao:ZentaoProduct:1:3"}, Name: "Shop", Type: "product"},
+			},
+		},
+		{
+			boardGrouping: "execution",
+			boards: []plugin.Scope{
+				&ticket.Board{DomainEntity: domainlayer.DomainEntity{Id: "zentao:ZentaoExecution:1:30"}, Name: "Sprint 1", Type: "sprint"},
+			},
+		},
+	} {
+		mockBoardGroupingBasicRes(t, tc.boardGrouping)
+		plan := make(plugin.PipelinePlan, 1)
+		plan, scopes, err := makePipelinePlanV200(nil, plan, []*plugin.BlueprintScopeV200{{Id: "1"}}, connection, &plugin.BlueprintSyncPolicy{})
+		assert.Nil(t, err)
+		assert.Equal(t, tc.boardGrouping, plan[0][0].Options["boardGrouping"])
+		expectScopes := []plugin.Scope{
+			&ticket.Board{DomainEntity: domainlayer.DomainEntity{Id: "zentao:ZentaoProject:1:1"}, Name: "test/testRepo", Type: "project"},
+		}
+		assert.Equal(t, append(expectScopes, tc.boards...), scopes)
+	}
+}
+
+// mockBoardGroupingBasicRes serves a project whose scope config groups issues by the given entity
+func mockBoardGroupingBasicRes(t *testing.T, boardGrouping string) {
+	mockRes := unithelper.DummyBasicRes(func(mockDal *mockdal.Dal) {
+		mockDal.On("First", mock.AnythingOfType("*models.ZentaoProject"), mock.Anything).Run(func(args mock.Arguments) {
+			*args.Get(0).(*models.ZentaoProject) = models.ZentaoProject{
+				ConnectionId:  1,
+				Id:            1,
+				Name:          "test/testRepo",
+				Type:          "project",
+				ScopeConfigId: 1,
+			}
+		}).Return(nil)
+		mockDal.On("First", mock.AnythingOfType("*models.ZentaoScopeConfig"), mock.Anything).Run(func(args mock.Arguments) {
+			*args.Get(0).(*models.ZentaoScopeConfig) = models.ZentaoScopeConfig{BoardGrouping: boardGrouping}
+		}).Return(nil)
+		mockDal.On("All", mock.AnythingOfType("*[]*models.ZentaoProductSummary"), mock.Anything).Run(func(args mock.Arguments) {
+			*args.Get(0).(*[]*models.ZentaoProductSummary) = []*models.ZentaoProductSummary{{ConnectionId: 1, ProjectId: 1, Id: 3, Name: "Shop"}}
+		}).Return(nil).Maybe()
+		mockDal.On("All", mock.AnythingOfType("*[]*models.ZentaoExecution"), mock.Anything).Run(func(args mock.Arguments) {
+			*args.Get(0).(*[]*models.ZentaoExecution) = []*models.ZentaoExecution{{ConnectionId: 1, Id: 30, Name: "Sprint 1", Type: "sprint"}}
+		}).Return(nil).Maybe()
+	})
+	p := mockplugin.NewPluginMeta(t)
+	p.On("Name").Return("dummy").Maybe()
+	Init(mockRes, p)
+}
+
 // mockBasicRes FIXME ...
 func mockBasicRes(t *testing.T) {
 	/*testZentaoProduct := &models.ZentaoProduct{
@@ -20,6 +20,7 @@ package api
 import (
 	"time"
 
+	"github.com/apache/incubator-devlake/core/dal"
 	"github.com/apache/incubator-devlake/core/errors"
 	"github.com/apache/incubator-devlake/core/models/domainlayer"
 	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
@@ -85,25 +86,14 @@ func makePipelinePlanV200(
 			}
 			domainScopes = append(domainScopes, scopeTicket)
 		}
-		/*} else {
-			product, scopeConfig, err := productScopeHelper.DbHelper().GetScopeAndConfig(connection.ID, scopeId)
+		op.BoardGrouping = scopeConfig.BoardGrouping
+		if utils.StringsContains(entities, plugin.DOMAIN_TYPE_TICKET) {
+			boards, err := makeGroupedBoardsV200(connection.ID, project.Id, scopeConfig.BoardGrouping)
 			if err != nil {
 				return nil, nil, err
 			}
-			op.ProductId = product.Id
-			entities = scopeConfig.Entities
-
-			if utils.StringsContains(entities, plugin.DOMAIN_TYPE_TICKET) {
-				scopeTicket := &ticket.Board{
-					DomainEntity: domainlayer.DomainEntity{
-						Id: didgen.NewDomainIdGenerator(&models.ZentaoProduct{}).Generate(connection.ID, product.Id),
-					},
-					Name: product.Name,
-					Type: product.Type,
-				}
-				domainScopes = append(domainScopes, scopeTicket)
-			}
-		}*/
+			domainScopes = append(domainScopes, boards...)
+		}
 
 		if syncPolicy.TimeAfter != nil {
 			op.TimeAfter = syncPolicy.TimeAfter.Format(time.RFC3339)
@@ -130,3 +120,42 @@ func makePipelinePlanV200(
 	}
 	return plan, domainScopes, nil
 }
+
+// makeGroupedBoardsV200 returns the boards issues get grouped in on top of the project board, see
+// tasks.BoardGroupingProduct and tasks.BoardGroupingExecution. Only the products and executions collected by the
+// previous runs are known, the ones showing up later get mapped to the project the next time the plan is made
+func makeGroupedBoardsV200(connectionId uint64, projectId int64, boardGrouping string) ([]plugin.Scope, errors.Error) {
+	db := basicRes.GetDal()
+	var boards []plugin.Scope
+	switch boardGrouping {
+	case tasks.BoardGroupingProduct:
+		var products []*models.ZentaoProductSummary
+		err := db.All(&products, dal.Where("connection_id = ? AND project_id = ?", connectionId, projectId))
+		if err != nil {
+			return nil, err
+		}
+		productIdGen := didgen.NewDomainIdGenerator(&models.ZentaoProduct{})
+		for _, product := range products {
+			boards = append(boards, &ticket.Board{
+				DomainEntity: domainlayer.DomainEntity{Id: productIdGen.Generate(connectionId, product.Id)},
+				Name:         product.Name,
+				Type:         "product",
+			})
+		}
+	case tasks.BoardGroupingExecution:
+		var executions []*models.ZentaoExecution
+		err := db.All(&executions, dal.Where("connection_id = ? AND project_id = ?", connectionId, projectId))
+		if err != nil {
+			return nil, err
+		}
+		executionIdGen := didgen.NewDomainIdGenerator(&models.ZentaoExecution{})
+		for _, execution := range executions {
+			boards = append(boards, &ticket.Board{
+				DomainEntity: domainlayer.DomainEntity{Id: executionIdGen.Generate(connectionId, execution.Id)},
+				Name:         execution.Name,
+				Type:         execution.Type,
+			})
+		}
+	}
+	return boards, nil
+}
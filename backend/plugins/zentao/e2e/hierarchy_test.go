@@ -0,0 +1,89 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"testing"
+
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/helpers/e2ehelper"
+	"github.com/apache/incubator-devlake/plugins/zentao/impl"
+	"github.com/apache/incubator-devlake/plugins/zentao/models"
+	"github.com/apache/incubator-devlake/plugins/zentao/tasks"
+)
+
+func TestZentaoHierarchyDataFlow(t *testing.T) {
+
+	var zentao impl.Zentao
+	dataflowTester := e2ehelper.NewDataFlowTester(t, "zentao", zentao)
+
+	taskData := &tasks.ZentaoTaskData{
+		Options: &tasks.ZentaoOptions{
+			ConnectionId:  1,
+			ProjectId:     1,
+			BoardGrouping: tasks.BoardGroupingProject,
+		},
+	}
+
+	dataflowTester.ImportCsvIntoTabler("./snapshot_tables/_tool_zentao_projects_for_hierarchy.csv", &models.ZentaoProject{})
+	dataflowTester.ImportCsvIntoTabler("./snapshot_tables/_tool_zentao_products_for_hierarchy.csv", &models.ZentaoProduct{})
+	dataflowTester.ImportCsvIntoTabler("./snapshot_tables/_tool_zentao_product_summary_for_hierarchy.csv", &models.ZentaoProductSummary{})
+
+	ticketProjectFields := []string{"id", "name", "project_key", "url", "description", "lead_id", "category", "type"}
+	boardFields := []string{"id", "name", "description", "url", "created_date", "type", "project_id"}
+
+	// the project becomes both a board and a ticket project of type PROJECT
+	dataflowTester.FlushTabler(&ticket.Board{})
+	dataflowTester.FlushTabler(&ticket.TicketProject{})
+	dataflowTester.Subtask(tasks.ConvertProjectMeta, taskData)
+	dataflowTester.VerifyTableWithOptions(&ticket.Board{}, e2ehelper.TableOptions{
+		CSVRelPath:   "./snapshot_tables/boards_project.csv",
+		TargetFields: boardFields,
+	})
+	dataflowTester.VerifyTableWithOptions(&ticket.TicketProject{}, e2ehelper.TableOptions{
+		CSVRelPath:   "./snapshot_tables/ticket_projects_project.csv",
+		TargetFields: ticketProjectFields,
+	})
+
+	// the products of the project become ticket projects of type PRODUCT, without boards unless grouped by product
+	dataflowTester.FlushTabler(&ticket.Board{})
+	dataflowTester.FlushTabler(&ticket.TicketProject{})
+	dataflowTester.Subtask(tasks.ConvertProductMeta, taskData)
+	dataflowTester.VerifyTableWithOptions(&ticket.TicketProject{}, e2ehelper.TableOptions{
+		CSVRelPath:   "./snapshot_tables/ticket_projects_product.csv",
+		TargetFields: ticketProjectFields,
+	})
+	dataflowTester.VerifyTableWithOptions(&ticket.Board{}, e2ehelper.TableOptions{
+		CSVRelPath:   "./snapshot_tables/boards_empty.csv",
+		TargetFields: boardFields,
+	})
+
+	// grouped by product, every product of the project also becomes a board
+	taskData.Options.BoardGrouping = tasks.BoardGroupingProduct
+	dataflowTester.FlushTabler(&ticket.Board{})
+	dataflowTester.FlushTabler(&ticket.TicketProject{})
+	dataflowTester.Subtask(tasks.ConvertProductMeta, taskData)
+	dataflowTester.VerifyTableWithOptions(&ticket.TicketProject{}, e2ehelper.TableOptions{
+		CSVRelPath:   "./snapshot_tables/ticket_projects_product.csv",
+		TargetFields: ticketProjectFields,
+	})
+	dataflowTester.VerifyTableWithOptions(&ticket.Board{}, e2ehelper.TableOptions{
+		CSVRelPath:   "./snapshot_tables/boards_product_grouping.csv",
+		TargetFields: boardFields,
+	})
+}
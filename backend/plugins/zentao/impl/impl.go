@@ -19,6 +19,7 @@ package impl
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/apache/incubator-devlake/core/context"
 	"github.com/apache/incubator-devlake/core/dal"
@@ -125,12 +126,20 @@ func (p Zentao) SubTaskMetas() []plugin.SubTaskMeta {
 		tasks.ExtractExecutionMeta,
 		tasks.ConvertExecutionMeta,
 
+		tasks.CollectProductMeta,
+		tasks.ExtractProductMeta,
+		tasks.ConvertProductMeta,
+
 		tasks.CollectTaskMeta,
 		tasks.ExtractTaskMeta,
+		tasks.ConvertTaskOverdueMeta,
 		tasks.ConvertTaskMeta,
+		tasks.ConvertTaskCycleTimeMeta,
+		tasks.ConvertTaskAgeMeta,
 
 		tasks.CollectTaskCommitsMeta,
 		tasks.ExtractTaskCommitsMeta,
+		tasks.ExtractTaskActionsMeta,
 		tasks.CollectTaskRepoCommitsMeta,
 		tasks.ExtractTaskRepoCommitsMeta,
 		tasks.ConvertTaskRepoCommitsMeta,
@@ -202,6 +211,13 @@ func (p Zentao) PrepareTaskData(taskCtx plugin.TaskContext, options map[string]i
 		Tasks:        map[int64]struct{}{},
 		Bugs:         map[int64]struct{}{},
 		AccountCache: tasks.NewAccountCache(taskCtx.GetDal(), op.ConnectionId),
+		Location:     time.UTC,
+	}
+	if connection.Timezone != "" {
+		data.Location, err = errors.Convert01(time.LoadLocation(connection.Timezone))
+		if err != nil {
+			return nil, errors.BadInput.Wrap(err, "invalid timezone of the Zentao connection")
+		}
 	}
 
 	if connection.DbUrl != "" {
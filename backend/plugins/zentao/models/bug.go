@@ -195,6 +195,7 @@ type ZentaoBug struct {
 	Url            string              `json:"url"`
 	StdStatus      string              `json:"stdStatus" gorm:"type:varchar(20)"`
 	StdType        string              `json:"stdType" gorm:"type:varchar(20)"`
+	ZentaoMappedFields
 }
 
 func (ZentaoBug) TableName() string {
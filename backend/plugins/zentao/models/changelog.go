@@ -65,3 +65,26 @@ type ZentaoChangelogCom struct {
 	Changelog       *ZentaoChangelog
 	ChangelogDetail *ZentaoChangelogDetail
 }
+
+// ZentaoActionRes is an action as listed along with the details of an entity by the API, its history being the
+// changelog details
+type ZentaoActionRes struct {
+	ID         int64  `json:"id"`
+	ObjectType string `json:"objectType"`
+	ObjectID   int64  `json:"objectID"`
+	Execution  int64  `json:"execution"`
+	Actor      string `json:"actor"`
+	Action     string `json:"action"`
+	Date       string `json:"date"`
+	Comment    string `json:"comment"`
+	Extra      string `json:"extra"`
+	Read       string `json:"read"`
+	Vision     string `json:"vision"`
+	History    []struct {
+		ID    int64  `json:"id"`
+		Field string `json:"field"`
+		Old   string `json:"old"`
+		New   string `json:"new"`
+		Diff  string `json:"diff"`
+	} `json:"history"`
+}
@@ -28,10 +28,15 @@ import (
 
 // PrepareApiClient fetches token from Zentao API for future requests
 func (connection ZentaoConn) PrepareApiClient(apiClient apihelperabstract.ApiClientAbstract) errors.Error {
+	// the password may reference a secret kept in Vault
+	password, err := helper.ResolveSecret(connection.Password)
+	if err != nil {
+		return err
+	}
 	// request for access token
 	tokenReqBody := &ApiAccessTokenRequest{
 		Account:  connection.Username,
-		Password: connection.Password,
+		Password: password,
 	}
 	tokenRes, err := apiClient.Post("/tokens", nil, tokenReqBody, nil)
 	if err != nil {
@@ -65,6 +70,8 @@ type ZentaoConn struct {
 	DbIdleConns    int    `json:"dbIdleConns" mapstructure:"dbIdleConns"`
 	DbLoggingLevel string `json:"dbLoggingLevel" mapstructure:"dbLoggingLevel"`
 	DbMaxConns     int    `json:"dbMaxConns" mapstructure:"dbMaxConns"`
+	// Timezone of the Zentao server, used to read date-only fields such as task deadlines, defaults to UTC
+	Timezone string `json:"timezone" mapstructure:"timezone" gorm:"type:varchar(100)"`
 }
 
 // ZentaoConnection holds ZentaoConn plus ID/Name for database storage
@@ -0,0 +1,59 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrationscripts
+
+import (
+	"github.com/apache/incubator-devlake/core/context"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/helpers/migrationhelper"
+)
+
+type addTaskOverdue struct{}
+
+type ZentaoTask20230724 struct {
+	Overdue     bool
+	DaysOverdue int
+}
+
+func (ZentaoTask20230724) TableName() string {
+	return "_tool_zentao_tasks"
+}
+
+type ZentaoConnection20230724 struct {
+	Timezone string `gorm:"type:varchar(100)"`
+}
+
+func (ZentaoConnection20230724) TableName() string {
+	return "_tool_zentao_connections"
+}
+
+func (*addTaskOverdue) Up(basicRes context.BasicRes) errors.Error {
+	return migrationhelper.AutoMigrateTables(
+		basicRes,
+		&ZentaoTask20230724{},
+		&ZentaoConnection20230724{},
+	)
+}
+
+func (*addTaskOverdue) Version() uint64 {
+	return 20230724100000
+}
+
+func (*addTaskOverdue) Name() string {
+	return "add overdue to _tool_zentao_tasks and timezone to _tool_zentao_connections"
+}
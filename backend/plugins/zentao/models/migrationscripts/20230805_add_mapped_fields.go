@@ -0,0 +1,74 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrationscripts
+
+import (
+	"time"
+
+	"github.com/apache/incubator-devlake/core/context"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/helpers/migrationhelper"
+)
+
+type addMappedFields struct{}
+
+type ZentaoStory20230805 struct {
+	StartDate *time.Time
+	TeamId    string `gorm:"type:varchar(255)"`
+}
+
+func (ZentaoStory20230805) TableName() string {
+	return "_tool_zentao_stories"
+}
+
+type ZentaoBug20230805 struct {
+	StoryPoint float64
+	StartDate  *time.Time
+	TeamId     string `gorm:"type:varchar(255)"`
+}
+
+func (ZentaoBug20230805) TableName() string {
+	return "_tool_zentao_bugs"
+}
+
+type ZentaoTask20230805 struct {
+	StoryPoint float64
+	StartDate  *time.Time
+	TeamId     string `gorm:"type:varchar(255)"`
+}
+
+func (ZentaoTask20230805) TableName() string {
+	return "_tool_zentao_tasks"
+}
+
+func (*addMappedFields) Up(basicRes context.BasicRes) errors.Error {
+	return migrationhelper.AutoMigrateTables(
+		basicRes,
+		&ZentaoStory20230805{},
+		&ZentaoBug20230805{},
+		&ZentaoTask20230805{},
+	)
+}
+
+func (*addMappedFields) Version() uint64 {
+	return 20230805100000
+}
+
+func (*addMappedFields) Name() string {
+	return "add the field mapping targets to _tool_zentao_stories, _tool_zentao_bugs and _tool_zentao_tasks"
+}
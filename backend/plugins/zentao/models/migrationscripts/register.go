@@ -31,5 +31,12 @@ func All() []plugin.MigrationScript {
 		new(addTaskLeft),
 		new(addExecutionStoryAndExecutionSummary),
 		new(addRawParamTableForScope),
+		new(addTaskOverdue),
+		new(addTaskFinishedByName),
+		new(addTaskDelay),
+		new(addFieldMappings),
+		new(addTaskKeyTemplate),
+		new(addBoardGrouping),
+		new(addMappedFields),
 	}
 }
@@ -19,6 +19,7 @@ package models
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/apache/incubator-devlake/core/models/common"
 )
@@ -31,8 +32,21 @@ type ZentaoScopeConfig struct {
 	BugStatusMappings   json.RawMessage `mapstructure:"bugStatusMappings,omitempty" json:"bugStatusMappings"`
 	StoryStatusMappings json.RawMessage `mapstructure:"storyStatusMappings,omitempty" json:"storyStatusMappings"`
 	TaskStatusMappings  json.RawMessage `mapstructure:"taskStatusMappings,omitempty" json:"taskStatusMappings"`
+	// FieldMappings reads `storyPoint`, `startDate` and `teamId` of stories, bugs and tasks out of the given fields
+	FieldMappings json.RawMessage `mapstructure:"fieldMappings,omitempty" json:"fieldMappings"`
+	// TaskKeyTemplate renders the key of tasks as domain issues, e.g. `PROJ-{project}-T{id}`, see task_key.go
+	TaskKeyTemplate string `gorm:"type:varchar(255)" mapstructure:"taskKeyTemplate,omitempty" json:"taskKeyTemplate"`
+	// BoardGrouping is the Zentao entity becoming the domain board of the scope, see tasks.ZentaoOptions
+	BoardGrouping string `gorm:"type:varchar(255)" mapstructure:"boardGrouping,omitempty" json:"boardGrouping"`
 }
 
 func (t ZentaoScopeConfig) TableName() string {
 	return "_tool_zentao_scope_configs"
 }
+
+// ZentaoMappedFields are the fields of stories, bugs and tasks read out of the fieldMappings of the scope config
+type ZentaoMappedFields struct {
+	StoryPoint float64    `json:"storyPoint"`
+	StartDate  *time.Time `json:"startDate"`
+	TeamId     string     `json:"teamId" gorm:"type:varchar(255)"`
+}
@@ -140,6 +140,7 @@ type ZentaoStory struct {
 	Url              string              `json:"url"`
 	StdStatus        string              `json:"stdStatus" gorm:"type:varchar(20)"`
 	StdType          string              `json:"stdType" gorm:"type:varchar(20)"`
+	ZentaoMappedFields
 }
 
 func (ZentaoStory) TableName() string {
@@ -128,6 +128,7 @@ type ZentaoTask struct {
 	EstStarted         string              `json:"estStarted"`
 	RealStarted        *helper.Iso8601Time `json:"realStarted"`
 	FinishedId         int64
+	FinishedByName     string
 	FinishedDate       *helper.Iso8601Time `json:"finishedDate"`
 	FinishedList       string              `json:"finishedList"`
 	CanceledId         int64
@@ -161,6 +162,11 @@ type ZentaoTask struct {
 	Url                string              `json:"url"`
 	StdStatus          string              `json:"stdStatus" gorm:"type:varchar(20)"`
 	StdType            string              `json:"stdType" gorm:"type:varchar(20)"`
+	Overdue            bool                `json:"overdue"`
+	DaysOverdue        int                 `json:"daysOverdue"`
+	// Delay is the number of days the task is behind its deadline as reported by Zentao, 0 when on time
+	Delay int `json:"delay"`
+	ZentaoMappedFields
 }
 
 func (ZentaoTask) TableName() string {
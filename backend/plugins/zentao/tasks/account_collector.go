@@ -50,6 +50,8 @@ func CollectAccount(taskCtx plugin.SubTaskContext) errors.Error {
 			return query, nil
 		},
 		GetTotalPages: GetTotalPagesFromResponse,
+		GetTotalCount: GetTotalCountFromResponse,
+		RetryOnEmpty:  data.Options.RetryOnEmptyPage,
 		ResponseParser: func(res *http.Response) ([]json.RawMessage, errors.Error) {
 			var data struct {
 				Users []json.RawMessage `json:"users"`
@@ -0,0 +1,68 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
+	"github.com/apache/incubator-devlake/plugins/zentao/models"
+)
+
+// The board grouping decides which Zentao entity becomes the domain board issues are put on, and thus what board
+// level metrics such as throughput, lead time or bug counts are aggregated by:
+//   - project, the default, puts everything of the project on a single board, metrics cover the whole project
+//   - product puts bugs and stories on the board of their product, so that the products shared across projects
+//     are measured as a whole. Tasks have no product and stay on the project board
+//   - execution puts issues on the board of their execution, metrics become per iteration just like Jira sprint
+//     boards. Issues not planned in any execution stay on the project board, stories planned in several executions
+//     are counted on each of them
+const (
+	BoardGroupingProject   = "project"
+	BoardGroupingProduct   = "product"
+	BoardGroupingExecution = "execution"
+)
+
+var boardGroupings = []string{BoardGroupingProject, BoardGroupingProduct, BoardGroupingExecution}
+
+// boardIdGenerator generates the id of the domain board an issue is grouped in
+type boardIdGenerator struct {
+	options        *ZentaoOptions
+	projectIdGen   *didgen.DomainIdGenerator
+	productIdGen   *didgen.DomainIdGenerator
+	executionIdGen *didgen.DomainIdGenerator
+}
+
+func newBoardIdGenerator(options *ZentaoOptions) *boardIdGenerator {
+	return &boardIdGenerator{
+		options:        options,
+		projectIdGen:   didgen.NewDomainIdGenerator(&models.ZentaoProject{}),
+		productIdGen:   didgen.NewDomainIdGenerator(&models.ZentaoProduct{}),
+		executionIdGen: didgen.NewDomainIdGenerator(&models.ZentaoExecution{}),
+	}
+}
+
+// generate returns the board of an issue given its product and execution, 0 when it has none, falling back to the
+// project board
+func (g *boardIdGenerator) generate(product, execution int64) string {
+	switch {
+	case g.options.BoardGrouping == BoardGroupingProduct && product != 0:
+		return g.productIdGen.Generate(g.options.ConnectionId, product)
+	case g.options.BoardGrouping == BoardGroupingExecution && execution != 0:
+		return g.executionIdGen.Generate(g.options.ConnectionId, execution)
+	}
+	return g.projectIdGen.Generate(g.options.ConnectionId, g.options.ProjectId)
+}
@@ -0,0 +1,70 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/stretchr/testify/assert"
+)
+
+type zentaoTestPlugin struct {
+	plugin.PluginMeta
+}
+
+func (zentaoTestPlugin) Name() string        { return "zentao" }
+func (zentaoTestPlugin) Description() string { return "zentao" }
+func (zentaoTestPlugin) RootPkgPath() string {
+	return "github.com/apache/incubator-devlake/plugins/zentao"
+}
+
+func TestBoardIdGenerator(t *testing.T) {
+	// the domain ids are prefixed by the name of the plugin holding the tool entities
+	assert.Nil(t, plugin.RegisterPlugin("zentao", zentaoTestPlugin{}))
+	const (
+		projectBoard   = "zentao:ZentaoProject:1:10"
+		productBoard   = "zentao:ZentaoProduct:1:20"
+		executionBoard = "zentao:ZentaoExecution:1:30"
+	)
+	tests := []struct {
+		name      string
+		grouping  string
+		product   int64
+		execution int64
+		want      string
+	}{
+		{"project, bug", BoardGroupingProject, 20, 30, projectBoard},
+		{"unset, bug", "", 20, 30, projectBoard},
+		{"product, bug", BoardGroupingProduct, 20, 30, productBoard},
+		{"product, story", BoardGroupingProduct, 20, 0, productBoard},
+		{"product, task", BoardGroupingProduct, 0, 30, projectBoard},
+		{"execution, bug", BoardGroupingExecution, 20, 30, executionBoard},
+		{"execution, bug not planned", BoardGroupingExecution, 20, 0, projectBoard},
+		{"execution, task", BoardGroupingExecution, 0, 30, executionBoard},
+		// stories land on the project board, execution_story_convertor adds them to their executions
+		{"execution, story", BoardGroupingExecution, 20, 0, projectBoard},
+		{"execution, planned story", BoardGroupingExecution, 0, 30, executionBoard},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := newBoardIdGenerator(&ZentaoOptions{ConnectionId: 1, ProjectId: 10, BoardGrouping: tt.grouping})
+			assert.Equal(t, tt.want, g.generate(tt.product, tt.execution))
+		})
+	}
+}
@@ -56,6 +56,8 @@ func CollectBug(taskCtx plugin.SubTaskContext) errors.Error {
 			return query, nil
 		},
 		GetTotalPages: GetTotalPagesFromResponse,
+		GetTotalCount: GetTotalCountFromResponse,
+		RetryOnEmpty:  data.Options.RetryOnEmptyPage,
 		ResponseParser: func(res *http.Response) ([]json.RawMessage, errors.Error) {
 			var data struct {
 				Bugs []json.RawMessage `json:"bugs"`
@@ -47,12 +47,10 @@ func ConvertBug(taskCtx plugin.SubTaskContext) errors.Error {
 	bugIdGen := didgen.NewDomainIdGenerator(&models.ZentaoBug{})
 	accountIdGen := didgen.NewDomainIdGenerator(&models.ZentaoAccount{})
 	executionIdGen := didgen.NewDomainIdGenerator(&models.ZentaoExecution{})
-	boardIdGen := didgen.NewDomainIdGenerator(&models.ZentaoProduct{})
-	if data.Options.ProjectId != 0 {
-		boardIdGen = didgen.NewDomainIdGenerator(&models.ZentaoProject{})
-	}
+	boardIdGen := newBoardIdGenerator(data.Options)
 
 	storyIdGen := didgen.NewDomainIdGenerator(&models.ZentaoStory{})
+	productIdGen := didgen.NewDomainIdGenerator(&models.ZentaoProduct{})
 	cursor, err := db.Cursor(
 		dal.From(&models.ZentaoBug{}),
 		dal.Where(`project = ? and
@@ -85,12 +83,23 @@ func ConvertBug(taskCtx plugin.SubTaskContext) errors.Error {
 				CreatedDate:     toolEntity.OpenedDate.ToNullableTime(),
 				UpdatedDate:     toolEntity.LastEditedDate.ToNullableTime(),
 				Priority:        getPriority(toolEntity.Pri),
+				PriorityColor:   getPriorityColor(toolEntity.Color, toolEntity.Pri),
+				PriorityOrder:   getPriorityOrder(toolEntity.PriOrder, toolEntity.Pri),
 				CreatorName:     toolEntity.OpenedByName,
 				AssigneeName:    toolEntity.AssignedToName,
 				Severity:        string(rune(toolEntity.Severity)),
 				Url:             toolEntity.Url,
 				OriginalProject: getOriginalProject(data),
 				Status:          toolEntity.StdStatus,
+				StoryPoint:      toolEntity.StoryPoint,
+				StartDate:       toolEntity.StartDate,
+			}
+			if toolEntity.TeamId != "" {
+				domainEntity.TeamId = &toolEntity.TeamId
+			}
+			// bugs are filed against products rather than projects
+			if toolEntity.Product != 0 {
+				domainEntity.ProjectId = productIdGen.Generate(data.Options.ConnectionId, toolEntity.Product)
 			}
 			if toolEntity.Story != 0 {
 				domainEntity.ParentIssueId = storyIdGen.Generate(data.Options.ConnectionId, toolEntity.Story)
@@ -115,7 +124,7 @@ func ConvertBug(taskCtx plugin.SubTaskContext) errors.Error {
 			}
 
 			domainBoardIssue := &ticket.BoardIssue{
-				BoardId: boardIdGen.Generate(data.Options.ConnectionId, data.Options.ProjectId),
+				BoardId: boardIdGen.generate(toolEntity.Product, toolEntity.Execution),
 				IssueId: domainEntity.Id,
 			}
 			if toolEntity.Execution != 0 {
@@ -41,7 +41,9 @@ func ExtractBug(taskCtx plugin.SubTaskContext) errors.Error {
 	data := taskCtx.GetData().(*ZentaoTaskData)
 
 	statusMappings := getBugStatusMapping(data)
+	normalizer := newStatusNormalizer(data.Options.StatusAliases, taskCtx.GetLogger())
 	stdTypeMappings := getStdTypeMappings(data)
+	fieldMappings := getFieldMappings(data)
 	extractor, err := api.NewApiExtractor(api.ApiExtractorArgs{
 		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
 			Ctx:     taskCtx,
@@ -126,6 +128,12 @@ func ExtractBug(taskCtx plugin.SubTaskContext) errors.Error {
 				ProductStatus:  res.ProductStatus,
 				Url:            row.Url,
 			}
+			mapped, mappingErr := parseMappedFields(fieldMappings, row.Data)
+			if mappingErr != nil {
+				return nil, mappingErr
+			}
+			bug.ZentaoMappedFields = mapped
+			bug.Status = normalizer.normalize(bug.Status)
 			switch bug.Status {
 			case "active", "closed", "resolved":
 			default:
@@ -136,14 +144,7 @@ func ExtractBug(taskCtx plugin.SubTaskContext) errors.Error {
 				bug.StdType = ticket.BUG
 			}
 
-			if len(statusMappings) != 0 {
-				bug.StdStatus = statusMappings[bug.Status]
-			} else {
-				bug.StdStatus = ticket.GetStatus(&ticket.StatusRule{
-					Done:    []string{"resolved"},
-					Default: ticket.IN_PROGRESS,
-				}, bug.Status)
-			}
+			bug.StdStatus = getBugStdStatus(statusMappings, bug.Status)
 
 			results := make([]interface{}, 0)
 			results = append(results, bug)
@@ -157,3 +158,14 @@ func ExtractBug(taskCtx plugin.SubTaskContext) errors.Error {
 
 	return extractor.Execute()
 }
+
+// getBugStdStatus maps the status of a bug following the status mappings, or the built-in rule without any
+func getBugStdStatus(statusMappings map[string]string, status string) string {
+	if len(statusMappings) != 0 {
+		return statusMappings[status]
+	}
+	return ticket.GetStatus(&ticket.StatusRule{
+		Done:    []string{"resolved"},
+		Default: ticket.IN_PROGRESS,
+	}, status)
+}
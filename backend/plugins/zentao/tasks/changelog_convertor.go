@@ -75,6 +75,17 @@ func ConvertChangelog(taskCtx plugin.SubTaskContext) errors.Error {
 	storyIdGen := didgen.NewDomainIdGenerator(&models.ZentaoStory{})
 	taskIdGen := didgen.NewDomainIdGenerator(&models.ZentaoTask{})
 	bugIdGen := didgen.NewDomainIdGenerator(&models.ZentaoBug{})
+	statusMappings := map[string]map[string]string{
+		"story": getStoryStatusMapping(data),
+		"task":  getTaskStatusMapping(data),
+		"bug":   getBugStatusMapping(data),
+	}
+	stdStatusGetters := map[string]func(map[string]string, string) string{
+		"story": getStoryStdStatus,
+		"task":  getTaskStdStatus,
+		"bug":   getBugStdStatus,
+	}
+	normalizer := newStatusNormalizer(data.Options.StatusAliases, taskCtx.GetLogger())
 	cn := models.ZentaoChangelog{}.TableName()
 	cdn := models.ZentaoChangelogDetail{}.TableName()
 	an := models.ZentaoAccount{}.TableName()
@@ -134,6 +145,7 @@ func ConvertChangelog(taskCtx plugin.SubTaskContext) errors.Error {
 			}
 			if domainCl.FieldName == "assignedTo" {
 				domainCl.FieldName = "assignee"
+				// accounts deleted from Zentao are missing from the cache, their account name is kept as is
 				if cl.Old != "" {
 					if id := data.AccountCache.getAccountID(cl.Old); id != 0 {
 						domainCl.OriginalFromValue = accountIdGen.Generate(data.Options.ConnectionId, id)
@@ -147,6 +159,15 @@ func ConvertChangelog(taskCtx plugin.SubTaskContext) errors.Error {
 					}
 				}
 			}
+			// status changes carry standard statuses like the issues do, the Zentao ones being kept as original
+			if getStdStatus, ok := stdStatusGetters[cl.ObjectType]; ok && domainCl.FieldName == "status" {
+				if cl.Old != "" {
+					domainCl.FromValue = getStdStatus(statusMappings[cl.ObjectType], normalizer.normalize(cl.Old))
+				}
+				if cl.New != "" {
+					domainCl.ToValue = getStdStatus(statusMappings[cl.ObjectType], normalizer.normalize(cl.New))
+				}
+			}
 			if domainCl.FieldName == "execution" {
 				domainCl.FieldName = "Sprint"
 				if cl.Old != "" {
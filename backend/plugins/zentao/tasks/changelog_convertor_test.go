@@ -0,0 +1,52 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangelogStdStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		getStatus  func(map[string]string, string) string
+		mappings   map[string]string
+		status     string
+		wantStatus string
+	}{
+		{name: "closed story", getStatus: getStoryStdStatus, status: "closed", wantStatus: ticket.DONE},
+		{name: "draft story", getStatus: getStoryStdStatus, status: "draft", wantStatus: ticket.TODO},
+		{name: "active story", getStatus: getStoryStdStatus, status: "active", wantStatus: ticket.IN_PROGRESS},
+		{name: "mapped story", getStatus: getStoryStdStatus, mappings: map[string]string{"reviewing": ticket.TODO}, status: "reviewing", wantStatus: ticket.TODO},
+		{name: "unmapped story", getStatus: getStoryStdStatus, mappings: map[string]string{"reviewing": ticket.TODO}, status: "closed", wantStatus: ""},
+		{name: "resolved bug", getStatus: getBugStdStatus, status: "resolved", wantStatus: ticket.DONE},
+		{name: "active bug", getStatus: getBugStdStatus, status: "active", wantStatus: ticket.IN_PROGRESS},
+		{name: "mapped bug", getStatus: getBugStdStatus, mappings: map[string]string{"closed": ticket.DONE}, status: "closed", wantStatus: ticket.DONE},
+		{name: "done task", getStatus: getTaskStdStatus, status: "done", wantStatus: ticket.DONE},
+		{name: "waiting task", getStatus: getTaskStdStatus, status: "wait", wantStatus: ticket.TODO},
+		{name: "mapped task", getStatus: getTaskStdStatus, mappings: map[string]string{"pause": ticket.TODO}, status: "pause", wantStatus: ticket.TODO},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantStatus, tt.getStatus(tt.mappings, tt.status))
+		})
+	}
+}
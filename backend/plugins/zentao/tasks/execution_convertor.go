@@ -18,6 +18,7 @@ limitations under the License.
 package tasks
 
 import (
+	"fmt"
 	"reflect"
 
 	"github.com/apache/incubator-devlake/core/dal"
@@ -95,6 +96,23 @@ func ConvertExecutions(taskCtx plugin.SubTaskContext) errors.Error {
 			}
 			results := make([]interface{}, 0)
 			results = append(results, sprint, boardSprint)
+			if data.Options.BoardGrouping == BoardGroupingExecution {
+				// the execution board shares the id of its sprint
+				executionBoard := &ticket.Board{
+					DomainEntity: domainlayer.DomainEntity{
+						Id: sprint.Id,
+					},
+					Name:        toolExecution.Name,
+					CreatedDate: toolExecution.OpenedDate.ToNullableTime(),
+					Type:        "scrum",
+					Url:         fmt.Sprintf("/execution-task-%d.html", toolExecution.Id),
+					ProjectId:   sprint.OriginalBoardID,
+				}
+				results = append(results, executionBoard, &ticket.BoardSprint{
+					BoardId:  executionBoard.Id,
+					SprintId: sprint.Id,
+				})
+			}
 			return results, nil
 		},
 	})
@@ -54,6 +54,8 @@ func CollectExecutionStory(taskCtx plugin.SubTaskContext) errors.Error {
 			return query, nil
 		},
 		GetTotalPages: GetTotalPagesFromResponse,
+		GetTotalCount: GetTotalCountFromResponse,
+		RetryOnEmpty:  data.Options.RetryOnEmptyPage,
 		ResponseParser: func(res *http.Response) ([]json.RawMessage, errors.Error) {
 			var data struct {
 				Story []json.RawMessage `json:"stories"`
@@ -44,6 +44,7 @@ func ConvertExecutionStory(taskCtx plugin.SubTaskContext) errors.Error {
 	db := taskCtx.GetDal()
 	executionIdGen := didgen.NewDomainIdGenerator(&models.ZentaoExecution{})
 	storyIdGen := didgen.NewDomainIdGenerator(&models.ZentaoStory{})
+	boardIdGen := newBoardIdGenerator(data.Options)
 	cursor, err := db.Cursor(
 		dal.From(&models.ZentaoExecutionStory{}),
 		dal.Where(`project_id = ? and connection_id = ?`, data.Options.ProjectId, data.Options.ConnectionId),
@@ -66,6 +67,13 @@ func ConvertExecutionStory(taskCtx plugin.SubTaskContext) errors.Error {
 				SprintId: executionIdGen.Generate(data.Options.ConnectionId, executionStory.ExecutionId),
 				IssueId:  storyIdGen.Generate(data.Options.ConnectionId, executionStory.StoryId),
 			}
+			if data.Options.BoardGrouping == BoardGroupingExecution {
+				boardIssue := &ticket.BoardIssue{
+					BoardId: boardIdGen.generate(0, executionStory.ExecutionId),
+					IssueId: sprintIssue.IssueId,
+				}
+				return []interface{}{sprintIssue, boardIssue}, nil
+			}
 			return []interface{}{sprintIssue}, nil
 		},
 	})
@@ -0,0 +1,75 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+)
+
+const RAW_PRODUCT_TABLE = "zentao_api_products"
+
+var _ plugin.SubTaskEntryPoint = CollectProducts
+
+func CollectProducts(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*ZentaoTaskData)
+	cursor, iterator, err := getProductIterator(taskCtx)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+	collector, err := api.NewApiCollector(api.ApiCollectorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx:     taskCtx,
+			Options: data.Options,
+			Table:   RAW_PRODUCT_TABLE,
+		},
+		Input:       iterator,
+		ApiClient:   data.ApiClient,
+		UrlTemplate: "/products/{{ .Input.Id }}",
+		ResponseParser: func(res *http.Response) ([]json.RawMessage, errors.Error) {
+			var data json.RawMessage
+			err := api.UnmarshalResponse(res, &data)
+			if errors.Is(err, api.ErrEmptyResponse) {
+				return nil, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			return []json.RawMessage{data}, nil
+		},
+		AfterResponse: ignoreHTTPStatus404,
+	})
+	if err != nil {
+		return err
+	}
+
+	return collector.Execute()
+}
+
+var CollectProductMeta = plugin.SubTaskMeta{
+	Name:             "collectProducts",
+	EntryPoint:       CollectProducts,
+	EnabledByDefault: true,
+	Description:      "Collect the products of the project from Zentao api",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
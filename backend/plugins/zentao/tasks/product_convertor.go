@@ -0,0 +1,113 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/zentao/models"
+)
+
+const (
+	// Zentao keeps requirements in products and plans the work in projects, both end up in ticket_projects
+	// and are told apart by their type
+	TicketProjectTypeProduct = "PRODUCT"
+	TicketProjectTypeProject = "PROJECT"
+)
+
+var _ plugin.SubTaskEntryPoint = ConvertProducts
+
+var ConvertProductMeta = plugin.SubTaskMeta{
+	Name:             "convertProducts",
+	EntryPoint:       ConvertProducts,
+	EnabledByDefault: true,
+	Description:      "convert Zentao products",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+func ConvertProducts(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*ZentaoTaskData)
+	db := taskCtx.GetDal()
+	productIdGen := didgen.NewDomainIdGenerator(&models.ZentaoProduct{})
+	accountIdGen := didgen.NewDomainIdGenerator(&models.ZentaoAccount{})
+	cursor, err := db.Cursor(
+		dal.Select("_tool_zentao_products.*"),
+		dal.From(&models.ZentaoProduct{}),
+		dal.Join(`join _tool_zentao_product_summary
+			on _tool_zentao_product_summary.id = _tool_zentao_products.id
+			and _tool_zentao_product_summary.connection_id = _tool_zentao_products.connection_id`),
+		dal.Where(`_tool_zentao_product_summary.project_id = ? and _tool_zentao_products.connection_id = ?`,
+			data.Options.ProjectId, data.Options.ConnectionId),
+	)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+	convertor, err := api.NewDataConverter(api.DataConverterArgs{
+		InputRowType: reflect.TypeOf(models.ZentaoProduct{}),
+		Input:        cursor,
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx:     taskCtx,
+			Options: data.Options,
+			Table:   RAW_PRODUCT_TABLE,
+		},
+		Convert: func(inputRow interface{}) ([]interface{}, errors.Error) {
+			toolProduct := inputRow.(*models.ZentaoProduct)
+			domainProject := &ticket.TicketProject{
+				DomainEntity: domainlayer.DomainEntity{
+					Id: productIdGen.Generate(toolProduct.ConnectionId, toolProduct.Id),
+				},
+				Name:        toolProduct.Name,
+				ProjectKey:  toolProduct.Code,
+				Url:         fmt.Sprintf("/product-browse-%d.html", toolProduct.Id),
+				Description: toolProduct.Description,
+				Category:    toolProduct.Type,
+				Type:        TicketProjectTypeProduct,
+			}
+			if toolProduct.POId != 0 {
+				domainProject.LeadId = accountIdGen.Generate(toolProduct.ConnectionId, toolProduct.POId)
+			}
+			if data.Options.BoardGrouping == BoardGroupingProduct {
+				productBoard := &ticket.Board{
+					DomainEntity: domainProject.DomainEntity,
+					Name:         toolProduct.Name,
+					Description:  toolProduct.Description,
+					CreatedDate:  toolProduct.CreatedDate.ToNullableTime(),
+					Type:         "scrum",
+					Url:          domainProject.Url,
+					ProjectId:    domainProject.Id,
+				}
+				return []interface{}{domainProject, productBoard}, nil
+			}
+			return []interface{}{domainProject}, nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return convertor.Execute()
+}
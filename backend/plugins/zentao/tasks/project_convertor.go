@@ -47,6 +47,7 @@ func ConvertProjects(taskCtx plugin.SubTaskContext) errors.Error {
 	data := taskCtx.GetData().(*ZentaoTaskData)
 	db := taskCtx.GetDal()
 	boardIdGen := didgen.NewDomainIdGenerator(&models.ZentaoProject{})
+	accountIdGen := didgen.NewDomainIdGenerator(&models.ZentaoAccount{})
 	cursor, err := db.Cursor(
 		dal.From(&models.ZentaoProject{}),
 		dal.Where(`id = ? and connection_id = ?`, data.Options.ProjectId, data.Options.ConnectionId),
@@ -68,18 +69,34 @@ func ConvertProjects(taskCtx plugin.SubTaskContext) errors.Error {
 
 			data.ProjectName = toolProject.Name
 
+			projectId := boardIdGen.Generate(toolProject.ConnectionId, toolProject.Id)
 			domainBoard := &ticket.Board{
 				DomainEntity: domainlayer.DomainEntity{
-					Id: boardIdGen.Generate(toolProject.ConnectionId, toolProject.Id),
+					Id: projectId,
 				},
 				Name:        toolProject.Name,
 				Description: toolProject.Description,
 				CreatedDate: toolProject.OpenedDate.ToNullableTime(),
 				Type:        "scrum",
 				Url:         fmt.Sprintf("/project-index-%d.html", data.Options.ProjectId),
+				ProjectId:   projectId,
+			}
+			domainProject := &ticket.TicketProject{
+				DomainEntity: domainlayer.DomainEntity{
+					Id: projectId,
+				},
+				Name:        toolProject.Name,
+				ProjectKey:  toolProject.Code,
+				Url:         domainBoard.Url,
+				Description: toolProject.Description,
+				Category:    toolProject.Model,
+				Type:        TicketProjectTypeProject,
+			}
+			if toolProject.PmId != 0 {
+				domainProject.LeadId = accountIdGen.Generate(toolProject.ConnectionId, toolProject.PmId)
 			}
 			results := make([]interface{}, 0)
-			results = append(results, domainBoard)
+			results = append(results, domainBoard, domainProject)
 			return results, nil
 		},
 	})
@@ -18,12 +18,15 @@ limitations under the License.
 package tasks
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/apache/incubator-devlake/core/dal"
 	"github.com/apache/incubator-devlake/core/plugin"
 	"net/http"
 	"net/url"
 	"reflect"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/apache/incubator-devlake/core/errors"
@@ -48,6 +51,16 @@ func GetTotalPagesFromResponse(res *http.Response, args *api.ApiCollectorArgs) (
 	return pages, nil
 }
 
+// GetTotalCountFromResponse returns the total number of records reported by a paginated response
+func GetTotalCountFromResponse(res *http.Response) (int, errors.Error) {
+	body := &ZentaoPagination{}
+	err := api.UnmarshalResponse(res, body)
+	if err != nil {
+		return 0, err
+	}
+	return body.Total, nil
+}
+
 func getAccountId(account *models.ZentaoAccount) int64 {
 	if account != nil {
 		return account.ID
@@ -78,6 +91,63 @@ func getPriority(pri int) string {
 	*/
 }
 
+// getRevision returns nil for the zero versions of the entities that are not versioned
+func getRevision(version int) *int {
+	if version == 0 {
+		return nil
+	}
+	return &version
+}
+
+// priorityColors are the colors Zentao shows the priorities in, for the entities without color of their own
+var priorityColors = map[int]string{1: "#d50000", 2: "#ff9800", 3: "#2098ee", 4: "#009688"}
+
+const (
+	defaultPriorityColor = "#838a9d"
+	// defaultPriorityOrder ranks the entities without priority last, as Zentao does
+	defaultPriorityOrder = 999
+)
+
+var hexColorPattern = regexp.MustCompile(`^#?([0-9a-f]{3}|[0-9a-f]{6})$`)
+
+// getPriorityColor normalizes the color of an entity to `#rrggbb`, falling back to the color of its priority
+func getPriorityColor(color string, pri int) *string {
+	match := hexColorPattern.FindStringSubmatch(strings.ToLower(strings.TrimSpace(color)))
+	if match == nil {
+		if priorityColor, ok := priorityColors[pri]; ok {
+			return &priorityColor
+		}
+		result := defaultPriorityColor
+		return &result
+	}
+	hex := match[1]
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	result := "#" + hex
+	return &result
+}
+
+// getPriorityOrder reads the rank Zentao sorts the entities by, falling back to their priority
+func getPriorityOrder(priOrder string, pri int) *int {
+	order, err := strconv.Atoi(strings.TrimSpace(priOrder))
+	if err != nil || order <= 0 {
+		order = pri
+	}
+	if order <= 0 {
+		order = defaultPriorityOrder
+	}
+	return &order
+}
+
+// getDiscriminator returns nil for the empty modes and visions, so that they stay null
+func getDiscriminator(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
 func getOriginalProject(data *ZentaoTaskData) string {
 	if data.Options.ProjectId != 0 {
 		return data.ProjectName
@@ -145,6 +215,40 @@ func getStdTypeMappings(data *ZentaoTaskData) map[string]string {
 	return stdTypeMappings
 }
 
+// getFieldMappings returns the fieldMappings of the scope config, nil without any
+func getFieldMappings(data *ZentaoTaskData) api.FieldMappings {
+	if data.Options.ScopeConfigs == nil {
+		return nil
+	}
+	return data.Options.ScopeConfigs.FieldMappings
+}
+
+// resolveMappedFields reads the fields mapped by the scope config out of the fields of a raw story, bug or task
+func resolveMappedFields(mappings api.FieldMappings, fields map[string]interface{}) models.ZentaoMappedFields {
+	var mapped models.ZentaoMappedFields
+	if len(mappings) == 0 {
+		return mapped
+	}
+	resolver := api.NewFieldResolver(mappings, fields)
+	mapped.StoryPoint, _ = resolver.Float(api.FieldMappingStoryPoint)
+	mapped.StartDate, _ = resolver.Time(api.FieldMappingStartDate)
+	mapped.TeamId, _ = resolver.String(api.FieldMappingTeamId)
+	return mapped
+}
+
+// parseMappedFields reads the fields mapped by the scope config out of a raw story or bug, the record is only parsed
+// when there are mappings
+func parseMappedFields(mappings api.FieldMappings, data json.RawMessage) (models.ZentaoMappedFields, errors.Error) {
+	if len(mappings) == 0 {
+		return models.ZentaoMappedFields{}, nil
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return models.ZentaoMappedFields{}, errors.Default.WrapRaw(err)
+	}
+	return resolveMappedFields(mappings, fields), nil
+}
+
 // parseRepoUrl parses a repository URL and returns the host, namespace, and repository name.
 func parseRepoUrl(repoUrl string) (string, string, string, error) {
 	parsedUrl, err := url.Parse(repoUrl)
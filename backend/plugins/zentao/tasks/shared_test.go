@@ -0,0 +1,104 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPriorityColor(t *testing.T) {
+	tests := []struct {
+		name  string
+		color string
+		pri   int
+		want  string
+	}{
+		{name: "full color", color: "#2098EE", pri: 1, want: "#2098ee"},
+		{name: "short color", color: "#f80", pri: 1, want: "#ff8800"},
+		{name: "color without hash", color: "009688", pri: 1, want: "#009688"},
+		{name: "color with spaces", color: " #D50000 ", pri: 3, want: "#d50000"},
+		{name: "no color, highest priority", color: "", pri: 1, want: "#d50000"},
+		{name: "no color, high priority", color: "", pri: 2, want: "#ff9800"},
+		{name: "no color, middle priority", color: "", pri: 3, want: "#2098ee"},
+		{name: "no color, low priority", color: "", pri: 4, want: "#009688"},
+		{name: "no color nor priority", color: "", pri: 0, want: "#838a9d"},
+		{name: "no color, unknown priority", color: "", pri: 7, want: "#838a9d"},
+		{name: "invalid color", color: "red", pri: 2, want: "#ff9800"},
+		{name: "invalid hex color", color: "#12345", pri: 0, want: "#838a9d"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := getPriorityColor(tt.color, tt.pri)
+			if assert.NotNil(t, got) {
+				assert.Equal(t, tt.want, *got)
+			}
+		})
+	}
+}
+
+func TestGetPriorityOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		priOrder string
+		pri      int
+		want     int
+	}{
+		{name: "rank", priOrder: "12", pri: 3, want: 12},
+		{name: "rank with spaces", priOrder: " 5 ", pri: 3, want: 5},
+		{name: "no rank", priOrder: "", pri: 3, want: 3},
+		{name: "invalid rank", priOrder: "high", pri: 2, want: 2},
+		{name: "zero rank", priOrder: "0", pri: 4, want: 4},
+		{name: "negative rank", priOrder: "-3", pri: 1, want: 1},
+		{name: "no rank nor priority", priOrder: "", pri: 0, want: 999},
+		{name: "zero rank nor priority", priOrder: "0", pri: 0, want: 999},
+		{name: "negative priority", priOrder: "", pri: -1, want: 999},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := getPriorityOrder(tt.priOrder, tt.pri)
+			if assert.NotNil(t, got) {
+				assert.Equal(t, tt.want, *got)
+			}
+		})
+	}
+}
+
+func TestGetDiscriminator(t *testing.T) {
+	assert.Nil(t, getDiscriminator(""))
+	for _, value := range []string{"multi", "linear", "rnd", "lite", " "} {
+		got := getDiscriminator(value)
+		if assert.NotNil(t, got, value) {
+			assert.Equal(t, value, *got)
+		}
+	}
+}
+
+func TestGetRevision(t *testing.T) {
+	assert.Nil(t, getRevision(0))
+	for _, version := range []int{1, 2, 17} {
+		got := getRevision(version)
+		if assert.NotNil(t, got) {
+			assert.Equal(t, version, *got)
+		}
+	}
+	// each call returns its own value
+	first, second := getRevision(3), getRevision(3)
+	assert.NotSame(t, first, second)
+}
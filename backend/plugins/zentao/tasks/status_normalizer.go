@@ -0,0 +1,105 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/apache/incubator-devlake/core/log"
+)
+
+// zentaoStatuses are the canonical statuses of bugs, stories and tasks, which status mappings are written against
+var zentaoStatuses = []string{
+	// bug
+	"active", "resolved", "closed",
+	// story
+	"draft", "changing", "reviewing",
+	// task
+	"wait", "doing", "done", "pause", "cancel",
+}
+
+// defaultStatusAliases are the status variants returned by some Zentao versions and locales
+var defaultStatusAliases = map[string]string{
+	"激活":  "active",
+	"已解决": "resolved",
+	"已关闭": "closed",
+	"关闭":  "closed",
+	"草稿":  "draft",
+	"变更中": "changing",
+	"评审中": "reviewing",
+	"未开始": "wait",
+	"进行中": "doing",
+	"已完成": "done",
+	"完成":  "done",
+	"已暂停": "pause",
+	"暂停":  "pause",
+	"已取消": "cancel",
+	"取消":  "cancel",
+
+	"finished":  "done",
+	"canceled":  "cancel",
+	"cancelled": "cancel",
+	"paused":    "pause",
+}
+
+// statusNormalizer maps the status variants to canonical Zentao statuses, variants neither canonical nor known are
+// passed through and logged once
+type statusNormalizer struct {
+	aliases  map[string]string
+	logger   log.Logger
+	mutex    sync.Mutex
+	unmapped map[string]struct{}
+}
+
+// newStatusNormalizer creates a statusNormalizer for the built-in aliases overridden by the configured ones
+func newStatusNormalizer(aliases map[string]string, logger log.Logger) *statusNormalizer {
+	n := &statusNormalizer{
+		aliases:  make(map[string]string, len(zentaoStatuses)+len(defaultStatusAliases)+len(aliases)),
+		logger:   logger,
+		unmapped: make(map[string]struct{}),
+	}
+	for _, status := range zentaoStatuses {
+		n.aliases[status] = status
+	}
+	for variant, status := range defaultStatusAliases {
+		n.aliases[variant] = status
+	}
+	for variant, status := range aliases {
+		n.aliases[strings.ToLower(strings.TrimSpace(variant))] = status
+	}
+	return n
+}
+
+func (n *statusNormalizer) normalize(status string) string {
+	if status == "" {
+		return status
+	}
+	if canonical, ok := n.aliases[strings.ToLower(strings.TrimSpace(status))]; ok {
+		return canonical
+	}
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	if _, ok := n.unmapped[status]; !ok {
+		n.unmapped[status] = struct{}{}
+		if n.logger != nil {
+			n.logger.Warn(nil, "unknown Zentao status %q is passed through as is, it may be mapped in statusAliases", status)
+		}
+	}
+	return status
+}
@@ -82,6 +82,8 @@ func CollectStory(taskCtx plugin.SubTaskContext) errors.Error {
 			return query, nil
 		},
 		GetTotalPages: GetTotalPagesFromResponse,
+		GetTotalCount: GetTotalCountFromResponse,
+		RetryOnEmpty:  data.Options.RetryOnEmptyPage,
 		ResponseParser: func(res *http.Response) ([]json.RawMessage, errors.Error) {
 			var data struct {
 				Story []json.RawMessage `json:"stories"`
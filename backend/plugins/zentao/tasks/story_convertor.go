@@ -45,8 +45,24 @@ func ConvertStory(taskCtx plugin.SubTaskContext) errors.Error {
 	data := taskCtx.GetData().(*ZentaoTaskData)
 	db := taskCtx.GetDal()
 	storyIdGen := didgen.NewDomainIdGenerator(&models.ZentaoStory{})
-	boardIdGen := didgen.NewDomainIdGenerator(&models.ZentaoProject{})
+	boardIdGen := newBoardIdGenerator(data.Options)
 	accountIdGen := didgen.NewDomainIdGenerator(&models.ZentaoAccount{})
+	productIdGen := didgen.NewDomainIdGenerator(&models.ZentaoProduct{})
+	// planned stories are put on their execution boards by ConvertExecutionStory
+	plannedStories := make(map[int64]bool)
+	if data.Options.BoardGrouping == BoardGroupingExecution {
+		var storyIds []int64
+		err := db.Pluck("story_id", &storyIds,
+			dal.From(&models.ZentaoExecutionStory{}),
+			dal.Where(`project_id = ? and connection_id = ?`, data.Options.ProjectId, data.Options.ConnectionId),
+		)
+		if err != nil {
+			return err
+		}
+		for _, storyId := range storyIds {
+			plannedStories[storyId] = true
+		}
+	}
 
 	cursor, err := db.Cursor(
 		dal.From(&models.ZentaoStory{}),
@@ -84,12 +100,24 @@ func ConvertStory(taskCtx plugin.SubTaskContext) errors.Error {
 				CreatedDate:             toolEntity.OpenedDate.ToNullableTime(),
 				UpdatedDate:             toolEntity.LastEditedDate.ToNullableTime(),
 				Priority:                getPriority(toolEntity.Pri),
+				PriorityColor:           getPriorityColor(toolEntity.Color, toolEntity.Pri),
+				PriorityOrder:           getPriorityOrder(toolEntity.PriOrder, toolEntity.Pri),
 				CreatorName:             toolEntity.OpenedByName,
 				AssigneeName:            toolEntity.AssignedToName,
 				Url:                     toolEntity.Url,
 				OriginalProject:         getOriginalProject(data),
 				Status:                  toolEntity.StdStatus,
 				OriginalEstimateMinutes: int64(toolEntity.Estimate) * 60,
+				StoryPoint:              toolEntity.StoryPoint,
+				StartDate:               toolEntity.StartDate,
+				Vision:                  getDiscriminator(toolEntity.Vision),
+			}
+			if toolEntity.TeamId != "" {
+				domainEntity.TeamId = &toolEntity.TeamId
+			}
+			// stories are requirements of products rather than projects
+			if toolEntity.Product != 0 {
+				domainEntity.ProjectId = productIdGen.Generate(data.Options.ConnectionId, toolEntity.Product)
 			}
 			if toolEntity.Parent != 0 {
 				domainEntity.ParentIssueId = storyIdGen.Generate(data.Options.ConnectionId, toolEntity.Parent)
@@ -117,11 +145,13 @@ func ConvertStory(taskCtx plugin.SubTaskContext) errors.Error {
 				domainEntity.LeadTimeMinutes = int64(toolEntity.ClosedDate.ToNullableTime().Sub(toolEntity.OpenedDate.ToTime()).Minutes())
 			}
 
-			domainBoardIssue := &ticket.BoardIssue{
-				BoardId: boardIdGen.Generate(data.Options.ConnectionId, data.Options.ProjectId),
-				IssueId: domainEntity.Id,
+			results = append(results, domainEntity)
+			if !plannedStories[toolEntity.ID] {
+				results = append(results, &ticket.BoardIssue{
+					BoardId: boardIdGen.generate(toolEntity.Product, 0),
+					IssueId: domainEntity.Id,
+				})
 			}
-			results = append(results, domainEntity, domainBoardIssue)
 			return results, nil
 		},
 	})
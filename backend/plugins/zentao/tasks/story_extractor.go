@@ -41,7 +41,9 @@ func ExtractStory(taskCtx plugin.SubTaskContext) errors.Error {
 	data := taskCtx.GetData().(*ZentaoTaskData)
 
 	statusMappings := getStoryStatusMapping(data)
+	normalizer := newStatusNormalizer(data.Options.StatusAliases, taskCtx.GetLogger())
 	stdTypeMappings := getStdTypeMappings(data)
+	fieldMappings := getFieldMappings(data)
 
 	extractor, err := api.NewApiExtractor(api.ApiExtractorArgs{
 		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
@@ -128,10 +130,17 @@ func ExtractStory(taskCtx plugin.SubTaskContext) errors.Error {
 				Url:              row.Url,
 			}
 
+			mapped, mappingErr := parseMappedFields(fieldMappings, row.Data)
+			if mappingErr != nil {
+				return nil, mappingErr
+			}
+			story.ZentaoMappedFields = mapped
+
 			story.StdType = stdTypeMappings[story.Type]
 			if story.StdType == "" {
 				story.StdType = ticket.REQUIREMENT
 			}
+			story.Status = normalizer.normalize(story.Status)
 			switch story.Status {
 			case "active", "closed", "draft", "changing", "reviewing":
 			default:
@@ -167,3 +176,16 @@ func ExtractStory(taskCtx plugin.SubTaskContext) errors.Error {
 
 	return extractor.Execute()
 }
+
+// getStoryStdStatus maps the status of a story following the status mappings. Without any, the stories take their
+// standard status from their stage, so the built-in rule only serves the status changelogs
+func getStoryStdStatus(statusMappings map[string]string, status string) string {
+	if len(statusMappings) != 0 {
+		return statusMappings[status]
+	}
+	return ticket.GetStatus(&ticket.StatusRule{
+		Done:    []string{"closed"},
+		Todo:    []string{"draft"},
+		Default: ticket.IN_PROGRESS,
+	}, status)
+}
@@ -0,0 +1,116 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/zentao/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ExtractTaskActions
+
+var ExtractTaskActionsMeta = plugin.SubTaskMeta{
+	Name:             "extractTaskActions",
+	EntryPoint:       ExtractTaskActions,
+	EnabledByDefault: true,
+	Description:      "extract the actions of Zentao tasks collected along with their commits into changelogs",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+// ExtractTaskActions turns the actions listed in the task details into the changelogs the RemoteDb collection
+// would get, the latter takes precedence when available since it covers stories and bugs as well
+func ExtractTaskActions(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*ZentaoTaskData)
+	if !data.Options.ExtractTaskActions || data.RemoteDb != nil {
+		return nil
+	}
+	logger := taskCtx.GetLogger()
+	extractor, err := api.NewApiExtractor(api.ApiExtractorArgs{
+		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
+			Ctx:     taskCtx,
+			Options: data.Options,
+			Table:   RAW_TASK_COMMITS_TABLE,
+		},
+		Extract: func(row *api.RawData) ([]interface{}, errors.Error) {
+			action := &models.ZentaoActionRes{}
+			err := json.Unmarshal(row.Data, action)
+			if err != nil {
+				return nil, errors.Default.WrapRaw(err)
+			}
+			results, err := toTaskActionChangelogs(action, data.Options.ConnectionId, data.Options.ProjectId, data.Location)
+			if err != nil {
+				logger.Warn(err, "skip action %d of task %d with an invalid date", action.ID, action.ObjectID)
+				return nil, nil
+			}
+			return results, nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return extractor.Execute()
+}
+
+// toTaskActionChangelogs returns the changelog and its details for an action of a task changing some fields, the
+// other actions such as comments have no history and give nothing. The date of the action is in the location of the
+// Zentao server
+func toTaskActionChangelogs(action *models.ZentaoActionRes, connectionId uint64, projectId int64, loc *time.Location) ([]interface{}, errors.Error) {
+	if action.ObjectType != "task" || len(action.History) == 0 {
+		return nil, nil
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	date, err := time.ParseInLocation("2006-01-02 15:04:05", action.Date, loc)
+	if err != nil {
+		return nil, errors.Default.WrapRaw(err)
+	}
+	results := make([]interface{}, 0, len(action.History)+1)
+	results = append(results, &models.ZentaoChangelog{
+		ConnectionId: connectionId,
+		Id:           action.ID,
+		ObjectId:     action.ObjectID,
+		Execution:    action.Execution,
+		Actor:        action.Actor,
+		Action:       action.Action,
+		Extra:        action.Extra,
+		ObjectType:   action.ObjectType,
+		Project:      projectId,
+		Vision:       action.Vision,
+		Comment:      action.Comment,
+		Date:         date,
+		Read:         action.Read,
+	})
+	for _, history := range action.History {
+		results = append(results, &models.ZentaoChangelogDetail{
+			ConnectionId: connectionId,
+			Id:           history.ID,
+			ChangelogId:  action.ID,
+			Field:        history.Field,
+			Old:          history.Old,
+			New:          history.New,
+			Diff:         history.Diff,
+		})
+	}
+	return results, nil
+}
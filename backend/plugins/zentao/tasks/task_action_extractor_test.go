@@ -0,0 +1,109 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/apache/incubator-devlake/plugins/zentao/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToTaskActionChangelogs(t *testing.T) {
+	shanghai := time.FixedZone("CST", 8*60*60)
+	action := func(payload string) *models.ZentaoActionRes {
+		res := &models.ZentaoActionRes{}
+		require.NoError(t, json.Unmarshal([]byte(payload), res))
+		return res
+	}
+
+	t.Run("assignment and status changes", func(t *testing.T) {
+		results, err := toTaskActionChangelogs(action(`{
+			"id": 101, "objectType": "task", "objectID": 7, "execution": 3, "actor": "alice",
+			"action": "edited", "date": "2023-08-01 09:30:00", "comment": "", "extra": "",
+			"history": [
+				{"id": 1001, "field": "assignedTo", "old": "bob", "new": "carol", "diff": ""},
+				{"id": 1002, "field": "status", "old": "wait", "new": "doing", "diff": ""}
+			]
+		}`), 1, 5, shanghai)
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+
+		changelog := results[0].(*models.ZentaoChangelog)
+		assert.Equal(t, uint64(1), changelog.ConnectionId)
+		assert.Equal(t, int64(101), changelog.Id)
+		assert.Equal(t, int64(7), changelog.ObjectId)
+		assert.Equal(t, "task", changelog.ObjectType)
+		assert.Equal(t, int64(3), changelog.Execution)
+		assert.Equal(t, int64(5), changelog.Project)
+		assert.Equal(t, "alice", changelog.Actor)
+		assert.True(t, changelog.Date.Equal(time.Date(2023, 8, 1, 1, 30, 0, 0, time.UTC)))
+
+		assignment := results[1].(*models.ZentaoChangelogDetail)
+		assert.Equal(t, int64(1001), assignment.Id)
+		assert.Equal(t, int64(101), assignment.ChangelogId)
+		assert.Equal(t, "assignedTo", assignment.Field)
+		assert.Equal(t, "bob", assignment.Old)
+		assert.Equal(t, "carol", assignment.New)
+
+		status := results[2].(*models.ZentaoChangelogDetail)
+		assert.Equal(t, "status", status.Field)
+		assert.Equal(t, "wait", status.Old)
+		assert.Equal(t, "doing", status.New)
+	})
+
+	t.Run("reassigned to a deleted account", func(t *testing.T) {
+		results, err := toTaskActionChangelogs(action(`{
+			"id": 102, "objectType": "task", "objectID": 7, "actor": "alice", "action": "assigned",
+			"date": "2023-08-02 10:00:00",
+			"history": [{"id": 1003, "field": "assignedTo", "old": "carol", "new": "gone.user"}]
+		}`), 1, 5, nil)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.True(t, results[0].(*models.ZentaoChangelog).Date.Equal(time.Date(2023, 8, 2, 10, 0, 0, 0, time.UTC)))
+		assert.Equal(t, "gone.user", results[1].(*models.ZentaoChangelogDetail).New)
+	})
+
+	t.Run("action without history", func(t *testing.T) {
+		results, err := toTaskActionChangelogs(action(`{
+			"id": 103, "objectType": "task", "objectID": 7, "action": "commented", "date": "2023-08-02 10:00:00"
+		}`), 1, 5, shanghai)
+		require.NoError(t, err)
+		assert.Empty(t, results)
+	})
+
+	t.Run("action of another object type", func(t *testing.T) {
+		results, err := toTaskActionChangelogs(action(`{
+			"id": 104, "objectType": "story", "objectID": 8, "action": "edited", "date": "2023-08-02 10:00:00",
+			"history": [{"id": 1004, "field": "status", "old": "draft", "new": "active"}]
+		}`), 1, 5, shanghai)
+		require.NoError(t, err)
+		assert.Empty(t, results)
+	})
+
+	t.Run("invalid date", func(t *testing.T) {
+		_, err := toTaskActionChangelogs(action(`{
+			"id": 105, "objectType": "task", "objectID": 7, "action": "edited", "date": "0000-00-00",
+			"history": [{"id": 1005, "field": "status", "old": "wait", "new": "doing"}]
+		}`), 1, 5, shanghai)
+		assert.Error(t, err)
+	})
+}
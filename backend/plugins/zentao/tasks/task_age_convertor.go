@@ -0,0 +1,105 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"time"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/plugins/zentao/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ConvertTaskAge
+
+var ConvertTaskAgeMeta = plugin.SubTaskMeta{
+	Name:             "convertTaskAge",
+	EntryPoint:       ConvertTaskAge,
+	EnabledByDefault: true,
+	Description:      "compute the age and staleness of Zentao tasks into issues.age_days and issues.stale_days",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+// ConvertTaskAge fills the age and staleness of the issues converted from the tasks of the project, counted in the
+// timezone of the connection. No other plugin computes them yet, stories and bugs are left null as well
+func ConvertTaskAge(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*ZentaoTaskData)
+	db := taskCtx.GetDal()
+	taskIdGen := didgen.NewDomainIdGenerator(&models.ZentaoTask{})
+	var tasks []*models.ZentaoTask
+	err := db.All(&tasks,
+		dal.Select("id, opened_date, closed_date, canceled_date, last_edited_date"),
+		dal.From(&models.ZentaoTask{}),
+		dal.Where("project = ? AND connection_id = ?", data.Options.ProjectId, data.Options.ConnectionId),
+	)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, task := range tasks {
+		age, stale := getTaskAge(task, now, data.Location)
+		err = db.UpdateColumns(&ticket.Issue{}, []dal.DalSet{
+			{ColumnName: "age_days", Value: age},
+			{ColumnName: "stale_days", Value: stale},
+		}, dal.Where("id = ?", taskIdGen.Generate(data.Options.ConnectionId, task.ID)))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getTaskAge returns the calendar days in the given location from the opening of the task to now, or to when it
+// got closed, and for open tasks the days since their last edit. Tasks never opened have no age
+func getTaskAge(task *models.ZentaoTask, now time.Time, loc *time.Location) (age *int, stale *int) {
+	opened := task.OpenedDate.ToNullableTime()
+	if opened == nil {
+		return nil, nil
+	}
+	end := task.ClosedDate.ToNullableTime()
+	if end == nil {
+		end = task.CanceledDate.ToNullableTime()
+	}
+	if end == nil {
+		if lastEdited := task.LastEditedDate.ToNullableTime(); lastEdited != nil {
+			stale = daysBetween(*lastEdited, now, loc)
+		} else {
+			stale = daysBetween(*opened, now, loc)
+		}
+		end = &now
+	}
+	return daysBetween(*opened, *end, loc), stale
+}
+
+// daysBetween counts the calendar days in the given location from `start` to `end`, never below zero
+func daysBetween(start, end time.Time, loc *time.Location) *int {
+	if loc == nil {
+		loc = time.UTC
+	}
+	start, end = start.In(loc), end.In(loc)
+	startDay := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, loc)
+	endDay := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, loc)
+	days := int(endDay.Sub(startDay).Hours()+12) / 24
+	if days < 0 {
+		days = 0
+	}
+	return &days
+}
@@ -0,0 +1,75 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apache/incubator-devlake/plugins/zentao/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTaskAge(t *testing.T) {
+	now := time.Date(2023, 8, 10, 20, 0, 0, 0, time.UTC)
+	shanghai := time.FixedZone("CST", 8*60*60)
+	days := func(d int) *int {
+		return &d
+	}
+	tests := []struct {
+		name       string
+		opened     string
+		closed     string
+		canceled   string
+		lastEdited string
+		loc        *time.Location
+		wantAge    *int
+		wantStale  *int
+	}{
+		{name: "never opened", wantAge: nil, wantStale: nil},
+		{name: "open and never edited", opened: "2023-08-01T09:00:00Z", wantAge: days(9), wantStale: days(9)},
+		{name: "open and edited", opened: "2023-08-01T09:00:00Z", lastEdited: "2023-08-07T09:00:00Z", wantAge: days(9), wantStale: days(3)},
+		{name: "opened today", opened: "2023-08-10T08:00:00Z", wantAge: days(0), wantStale: days(0)},
+		{name: "closed", opened: "2023-08-01T09:00:00Z", closed: "2023-08-05T09:00:00Z", lastEdited: "2023-08-05T09:00:00Z", wantAge: days(4), wantStale: nil},
+		{name: "canceled", opened: "2023-08-01T09:00:00Z", canceled: "2023-08-03T09:00:00Z", wantAge: days(2), wantStale: nil},
+		{name: "closed after being canceled", opened: "2023-08-01T09:00:00Z", canceled: "2023-08-03T09:00:00Z", closed: "2023-08-06T09:00:00Z", wantAge: days(5), wantStale: nil},
+		{name: "closed before opened", opened: "2023-08-05T09:00:00Z", closed: "2023-08-01T09:00:00Z", wantAge: days(0), wantStale: nil},
+		// 2023-08-10 20:00 UTC is already the 11th in Shanghai, 2023-08-01 17:00 UTC the 2nd and 2023-08-09 17:00 UTC the 10th
+		{name: "calendar days in the location", opened: "2023-08-01T17:00:00Z", lastEdited: "2023-08-09T17:00:00Z", loc: shanghai, wantAge: days(9), wantStale: days(1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &models.ZentaoTask{}
+			if tt.opened != "" {
+				task.OpenedDate = zentaoTime(t, tt.opened)
+			}
+			if tt.closed != "" {
+				task.ClosedDate = zentaoTime(t, tt.closed)
+			}
+			if tt.canceled != "" {
+				task.CanceledDate = zentaoTime(t, tt.canceled)
+			}
+			if tt.lastEdited != "" {
+				task.LastEditedDate = zentaoTime(t, tt.lastEdited)
+			}
+			age, stale := getTaskAge(task, now, tt.loc)
+			assert.Equal(t, tt.wantAge, age)
+			assert.Equal(t, tt.wantStale, stale)
+		})
+	}
+}
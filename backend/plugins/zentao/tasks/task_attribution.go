@@ -0,0 +1,54 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"github.com/apache/incubator-devlake/plugins/zentao/models"
+)
+
+const (
+	TaskAttributionAssignedTo = "assignedTo"
+	TaskAttributionFinishedBy = "finishedBy"
+	TaskAttributionOpenedBy   = "openedBy"
+)
+
+var taskAttributionSources = []string{TaskAttributionAssignedTo, TaskAttributionFinishedBy, TaskAttributionOpenedBy}
+
+// getTaskAttribution follows the attribution chain until it finds someone, it returns the id and the name of that
+// person along with the source they were found in, or an empty source if nobody was found
+func getTaskAttribution(task *models.ZentaoTask, chain []string) (int64, string, string) {
+	if len(chain) == 0 {
+		chain = []string{TaskAttributionAssignedTo}
+	}
+	for _, source := range chain {
+		var id int64
+		var name string
+		switch source {
+		case TaskAttributionAssignedTo:
+			id, name = task.AssignedToId, task.AssignedToName
+		case TaskAttributionFinishedBy:
+			id, name = task.FinishedId, task.FinishedByName
+		case TaskAttributionOpenedBy:
+			id, name = task.OpenedById, task.OpenedByName
+		}
+		if id != 0 || name != "" {
+			return id, name, source
+		}
+	}
+	return 0, "", ""
+}
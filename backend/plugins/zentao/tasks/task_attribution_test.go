@@ -0,0 +1,97 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+
+	"github.com/apache/incubator-devlake/plugins/zentao/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTaskAttribution(t *testing.T) {
+	fullChain := []string{TaskAttributionAssignedTo, TaskAttributionFinishedBy, TaskAttributionOpenedBy}
+	tests := []struct {
+		name       string
+		task       *models.ZentaoTask
+		chain      []string
+		wantId     int64
+		wantName   string
+		wantSource string
+	}{
+		{
+			name:       "assignee",
+			task:       &models.ZentaoTask{AssignedToId: 1, AssignedToName: "alice", FinishedId: 2, OpenedById: 3},
+			chain:      fullChain,
+			wantId:     1,
+			wantName:   "alice",
+			wantSource: TaskAttributionAssignedTo,
+		},
+		{
+			name:       "finisher of a task assigned to nobody, e.g. closed",
+			task:       &models.ZentaoTask{FinishedId: 2, FinishedByName: "bob", OpenedById: 3},
+			chain:      fullChain,
+			wantId:     2,
+			wantName:   "bob",
+			wantSource: TaskAttributionFinishedBy,
+		},
+		{
+			name:       "opener of a task neither assigned nor finished",
+			task:       &models.ZentaoTask{OpenedById: 3, OpenedByName: "carol"},
+			chain:      fullChain,
+			wantId:     3,
+			wantName:   "carol",
+			wantSource: TaskAttributionOpenedBy,
+		},
+		{
+			name:       "name without an account id",
+			task:       &models.ZentaoTask{FinishedByName: "bob", OpenedById: 3},
+			chain:      fullChain,
+			wantId:     0,
+			wantName:   "bob",
+			wantSource: TaskAttributionFinishedBy,
+		},
+		{
+			name:       "follows the order of the chain",
+			task:       &models.ZentaoTask{AssignedToId: 1, OpenedById: 3, OpenedByName: "carol"},
+			chain:      []string{TaskAttributionOpenedBy, TaskAttributionAssignedTo},
+			wantId:     3,
+			wantName:   "carol",
+			wantSource: TaskAttributionOpenedBy,
+		},
+		{
+			name:       "only the assignee by default",
+			task:       &models.ZentaoTask{FinishedId: 2, OpenedById: 3},
+			wantSource: "",
+		},
+		{
+			name:       "nobody",
+			task:       &models.ZentaoTask{},
+			chain:      fullChain,
+			wantSource: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, name, source := getTaskAttribution(tt.task, tt.chain)
+			assert.Equal(t, tt.wantId, id)
+			assert.Equal(t, tt.wantName, name)
+			assert.Equal(t, tt.wantSource, source)
+		})
+	}
+}
@@ -70,6 +70,8 @@ func CollectTask(taskCtx plugin.SubTaskContext) errors.Error {
 			return query, nil
 		},
 		GetTotalPages: GetTotalPagesFromResponse,
+		GetTotalCount: GetTotalCountFromResponse,
+		RetryOnEmpty:  data.Options.RetryOnEmptyPage,
 		ResponseParser: func(res *http.Response) ([]json.RawMessage, errors.Error) {
 			var data struct {
 				Task []json.RawMessage `json:"tasks"`
@@ -46,7 +46,8 @@ func ConvertTask(taskCtx plugin.SubTaskContext) errors.Error {
 	db := taskCtx.GetDal()
 	storyIdGen := didgen.NewDomainIdGenerator(&models.ZentaoStory{})
 	//bugIdGen := didgen.NewDomainIdGenerator(&models.ZentaoBug{})
-	boardIdGen := didgen.NewDomainIdGenerator(&models.ZentaoProject{})
+	boardIdGen := newBoardIdGenerator(data.Options)
+	projectIdGen := didgen.NewDomainIdGenerator(&models.ZentaoProject{})
 	executionIdGen := didgen.NewDomainIdGenerator(&models.ZentaoExecution{})
 	taskIdGen := didgen.NewDomainIdGenerator(&models.ZentaoTask{})
 	accountIdGen := didgen.NewDomainIdGenerator(&models.ZentaoAccount{})
@@ -83,23 +84,48 @@ func ConvertTask(taskCtx plugin.SubTaskContext) errors.Error {
 				CreatedDate:             toolEntity.OpenedDate.ToNullableTime(),
 				UpdatedDate:             toolEntity.LastEditedDate.ToNullableTime(),
 				Priority:                getPriority(toolEntity.Pri),
+				PriorityColor:           getPriorityColor(toolEntity.Color, toolEntity.Pri),
+				PriorityOrder:           getPriorityOrder(toolEntity.PriOrder, toolEntity.Pri),
 				CreatorName:             toolEntity.OpenedByName,
-				AssigneeName:            toolEntity.AssignedToName,
 				Url:                     toolEntity.Url,
 				OriginalProject:         getOriginalProject(data),
 				Status:                  toolEntity.StdStatus,
 				OriginalEstimateMinutes: int64(toolEntity.Estimate) * 60,
 				TimeSpentMinutes:        int64(toolEntity.Consumed) * 60,
+				ProjectId:               projectIdGen.Generate(toolEntity.ConnectionId, toolEntity.Project),
+				AwaitingConfirmation:    toolEntity.NeedConfirm,
+				DelayDays:               toolEntity.Delay,
+				Revision:                getRevision(toolEntity.Version),
+				StoryRevision:           getRevision(toolEntity.StoryVersion),
+				DesignRevision:          getRevision(toolEntity.DesignVersion),
+				Mode:                    getDiscriminator(toolEntity.Mode),
+				Vision:                  getDiscriminator(toolEntity.Vision),
+				StoryPoint:              toolEntity.StoryPoint,
+				StartDate:               toolEntity.StartDate,
+			}
+			if toolEntity.TeamId != "" {
+				domainEntity.TeamId = &toolEntity.TeamId
+			}
+			if data.Options.ScopeConfigs != nil && data.Options.ScopeConfigs.TaskKeyTemplate != "" {
+				domainEntity.OriginalKey = domainEntity.IssueKey
+				domainEntity.IssueKey = getTaskKey(data.Options.ScopeConfigs.TaskKeyTemplate, toolEntity)
 			}
 			domainEntity.TimeRemainingMinutes = domainEntity.OriginalEstimateMinutes - domainEntity.TimeSpentMinutes
+			planDuration, realDuration := toolEntity.PlanDuration, toolEntity.RealDuration
+			domainEntity.PlanDurationDays = &planDuration
+			domainEntity.RealDurationDays = &realDuration
+			domainEntity.PlanningAccuracy = getPlanningAccuracy(planDuration, realDuration)
 			if toolEntity.Parent != 0 {
 				domainEntity.ParentIssueId = storyIdGen.Generate(data.Options.ConnectionId, toolEntity.Parent)
 			}
 			if toolEntity.OpenedById != 0 {
 				domainEntity.CreatorId = accountIdGen.Generate(data.Options.ConnectionId, toolEntity.OpenedById)
 			}
-			if toolEntity.AssignedToId != 0 {
-				domainEntity.AssigneeId = accountIdGen.Generate(data.Options.ConnectionId, toolEntity.AssignedToId)
+			assigneeId, assigneeName, assigneeSource := getTaskAttribution(toolEntity, data.Options.TaskAttribution)
+			domainEntity.AssigneeName = assigneeName
+			domainEntity.AssigneeSource = assigneeSource
+			if assigneeId != 0 {
+				domainEntity.AssigneeId = accountIdGen.Generate(data.Options.ConnectionId, assigneeId)
 			}
 			if toolEntity.ClosedDate != nil {
 				domainEntity.LeadTimeMinutes = int64(toolEntity.ClosedDate.ToNullableTime().Sub(toolEntity.OpenedDate.ToTime()).Minutes())
@@ -114,7 +140,7 @@ func ConvertTask(taskCtx plugin.SubTaskContext) errors.Error {
 				results = append(results, issueAssignee)
 			}
 			domainBoardIssue := &ticket.BoardIssue{
-				BoardId: boardIdGen.Generate(data.Options.ConnectionId, data.Options.ProjectId),
+				BoardId: boardIdGen.generate(0, toolEntity.Execution),
 				IssueId: domainEntity.Id,
 			}
 
@@ -139,3 +165,12 @@ func ConvertTask(taskCtx plugin.SubTaskContext) errors.Error {
 
 	return convertor.Execute()
 }
+
+// getPlanningAccuracy returns the ratio of the real duration to the planned one, nil unless both are positive
+func getPlanningAccuracy(planDuration, realDuration int) *float64 {
+	if planDuration <= 0 || realDuration <= 0 {
+		return nil
+	}
+	accuracy := float64(realDuration) / float64(planDuration)
+	return &accuracy
+}
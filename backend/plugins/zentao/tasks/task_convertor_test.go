@@ -0,0 +1,57 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPlanningAccuracy(t *testing.T) {
+	tests := []struct {
+		name         string
+		planDuration int
+		realDuration int
+		want         *float64
+	}{
+		{name: "as planned", planDuration: 4, realDuration: 4, want: float64Ptr(1)},
+		{name: "longer than planned", planDuration: 4, realDuration: 6, want: float64Ptr(1.5)},
+		{name: "shorter than planned", planDuration: 4, realDuration: 1, want: float64Ptr(0.25)},
+		{name: "not planned", planDuration: 0, realDuration: 3, want: nil},
+		{name: "not started", planDuration: 3, realDuration: 0, want: nil},
+		{name: "negative plan", planDuration: -2, realDuration: 3, want: nil},
+		{name: "negative real duration", planDuration: 2, realDuration: -3, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := getPlanningAccuracy(tt.planDuration, tt.realDuration)
+			if tt.want == nil {
+				assert.Nil(t, got)
+				return
+			}
+			if assert.NotNil(t, got) {
+				assert.InDelta(t, *tt.want, *got, 1e-9)
+			}
+		})
+	}
+}
+
+func float64Ptr(f float64) *float64 {
+	return &f
+}
@@ -0,0 +1,115 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"time"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/plugins/zentao/models"
+)
+
+const (
+	CycleStartRealStarted = "realStarted"
+	CycleStartEstStarted  = "estStarted"
+	CycleStartOpenedDate  = "openedDate"
+)
+
+var cycleStartFields = []string{CycleStartRealStarted, CycleStartEstStarted, CycleStartOpenedDate}
+
+var _ plugin.SubTaskEntryPoint = ConvertTaskCycleTime
+
+var ConvertTaskCycleTimeMeta = plugin.SubTaskMeta{
+	Name:             "convertTaskCycleTime",
+	EntryPoint:       ConvertTaskCycleTime,
+	EnabledByDefault: true,
+	Description:      "compute the cycle time of Zentao tasks into issues.cycle_time_minutes",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+func ConvertTaskCycleTime(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*ZentaoTaskData)
+	db := taskCtx.GetDal()
+	taskIdGen := didgen.NewDomainIdGenerator(&models.ZentaoTask{})
+	var tasks []*models.ZentaoTask
+	err := db.All(&tasks,
+		dal.Select("id, est_started, real_started, opened_date, finished_date, closed_date"),
+		dal.From(&models.ZentaoTask{}),
+		dal.Where("project = ? AND connection_id = ?", data.Options.ProjectId, data.Options.ConnectionId),
+	)
+	if err != nil {
+		return err
+	}
+	for _, task := range tasks {
+		err = db.UpdateColumn(&ticket.Issue{}, "cycle_time_minutes",
+			getTaskCycleTime(task, data.Options.CycleStart, data.Location),
+			dal.Where("id = ?", taskIdGen.Generate(data.Options.ConnectionId, task.ID)),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getTaskCycleTime returns the minutes between the first start of the chain set on the task and the time it got
+// finished, or closed. Tasks closed without ever being started nor finished go through the chain like the others,
+// starting at their estimated start or opening as configured. Tasks with no start in the chain, or not ended yet,
+// have no cycle time. Date-only fields are read in the given location
+func getTaskCycleTime(task *models.ZentaoTask, chain []string, loc *time.Location) *int64 {
+	end := task.FinishedDate.ToNullableTime()
+	if end == nil {
+		end = task.ClosedDate.ToNullableTime()
+	}
+	if end == nil {
+		return nil
+	}
+	if len(chain) == 0 {
+		chain = cycleStartFields
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	var start *time.Time
+	for _, field := range chain {
+		switch field {
+		case CycleStartRealStarted:
+			start = task.RealStarted.ToNullableTime()
+		case CycleStartEstStarted:
+			if len(task.EstStarted) >= 10 && task.EstStarted[:10] != "0000-00-00" {
+				if estStarted, err := time.ParseInLocation("2006-01-02", task.EstStarted[:10], loc); err == nil {
+					start = &estStarted
+				}
+			}
+		case CycleStartOpenedDate:
+			start = task.OpenedDate.ToNullableTime()
+		}
+		if start != nil {
+			break
+		}
+	}
+	if start == nil || end.Before(*start) {
+		return nil
+	}
+	minutes := int64(end.Sub(*start).Minutes())
+	return &minutes
+}
@@ -0,0 +1,161 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/zentao/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// zentaoTime parses a time the way it comes from the Zentao api
+func zentaoTime(t *testing.T, value string) *api.Iso8601Time {
+	var result api.Iso8601Time
+	assert.Nil(t, json.Unmarshal([]byte(`"`+value+`"`), &result))
+	return &result
+}
+
+func TestGetTaskCycleTime(t *testing.T) {
+	minutes := func(m int64) *int64 {
+		return &m
+	}
+	shanghai := time.FixedZone("CST", 8*60*60)
+	tests := []struct {
+		name         string
+		realStarted  string
+		estStarted   string
+		openedDate   string
+		finishedDate string
+		closedDate   string
+		chain        []string
+		loc          *time.Location
+		want         *int64
+	}{
+		{
+			name:         "real start",
+			realStarted:  "2023-08-01T10:00:00Z",
+			estStarted:   "2023-07-01",
+			openedDate:   "2023-06-01T10:00:00Z",
+			finishedDate: "2023-08-01T12:00:00Z",
+			want:         minutes(120),
+		},
+		{
+			name:        "closed without being finished",
+			realStarted: "2023-08-01T10:00:00Z",
+			closedDate:  "2023-08-01T11:00:00Z",
+			want:        minutes(60),
+		},
+		{
+			name:         "finished date wins over the closed date",
+			realStarted:  "2023-08-01T10:00:00Z",
+			finishedDate: "2023-08-01T11:00:00Z",
+			closedDate:   "2023-08-03T11:00:00Z",
+			want:         minutes(60),
+		},
+		{
+			name:       "closed without ever being started",
+			estStarted: "2023-08-01",
+			openedDate: "2023-07-01T10:00:00Z",
+			closedDate: "2023-08-01T12:00:00Z",
+			want:       minutes(720),
+		},
+		{
+			name:       "closed without ever being started, from its opening",
+			openedDate: "2023-08-01T10:00:00Z",
+			closedDate: "2023-08-01T11:30:00Z",
+			want:       minutes(90),
+		},
+		{
+			name:       "closed without ever being started and only the real start in the chain",
+			openedDate: "2023-08-01T10:00:00Z",
+			closedDate: "2023-08-01T11:30:00Z",
+			chain:      []string{CycleStartRealStarted},
+			want:       nil,
+		},
+		{
+			name:        "not ended",
+			realStarted: "2023-08-01T10:00:00Z",
+			want:        nil,
+		},
+		{
+			name:         "falls back to the estimated start",
+			estStarted:   "2023-08-01",
+			openedDate:   "2023-07-01T10:00:00Z",
+			finishedDate: "2023-08-01T12:00:00Z",
+			want:         minutes(720),
+		},
+		{
+			name:         "falls back to the opened date when never estimated",
+			estStarted:   "0000-00-00",
+			openedDate:   "2023-08-01T08:00:00Z",
+			finishedDate: "2023-08-01T12:00:00Z",
+			want:         minutes(240),
+		},
+		{
+			name:         "follows the configured chain",
+			realStarted:  "2023-08-01T10:00:00Z",
+			openedDate:   "2023-08-01T08:00:00Z",
+			finishedDate: "2023-08-01T12:00:00Z",
+			chain:        []string{CycleStartOpenedDate, CycleStartRealStarted},
+			want:         minutes(240),
+		},
+		{
+			name:         "no start in the chain",
+			openedDate:   "2023-08-01T08:00:00Z",
+			finishedDate: "2023-08-01T12:00:00Z",
+			chain:        []string{CycleStartEstStarted},
+			want:         nil,
+		},
+		{
+			name:         "estimated start read in the location",
+			estStarted:   "2023-08-01",
+			finishedDate: "2023-08-01T00:00:00Z",
+			loc:          shanghai,
+			want:         minutes(480),
+		},
+		{
+			name:         "ended before it started",
+			realStarted:  "2023-08-01T12:00:00Z",
+			finishedDate: "2023-08-01T10:00:00Z",
+			want:         nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &models.ZentaoTask{EstStarted: tt.estStarted}
+			for _, field := range []struct {
+				value string
+				dest  **api.Iso8601Time
+			}{
+				{tt.realStarted, &task.RealStarted},
+				{tt.openedDate, &task.OpenedDate},
+				{tt.finishedDate, &task.FinishedDate},
+				{tt.closedDate, &task.ClosedDate},
+			} {
+				if field.value != "" {
+					*field.dest = zentaoTime(t, field.value)
+				}
+			}
+			assert.Equal(t, tt.want, getTaskCycleTime(task, tt.chain, tt.loc))
+		})
+	}
+}
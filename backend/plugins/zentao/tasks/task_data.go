@@ -27,6 +27,7 @@ import (
 	helper "github.com/apache/incubator-devlake/helpers/pluginhelper/api"
 	"github.com/apache/incubator-devlake/plugins/zentao/models"
 	"github.com/mitchellh/mapstructure"
+	"golang.org/x/exp/slices"
 )
 
 type ZentaoApiParams models.ZentaoApiParams
@@ -41,6 +42,27 @@ type ZentaoOptions struct {
 	TimeAfter     string              `json:"timeAfter" mapstructure:"timeAfter,omitempty"`
 	ScopeConfigId uint64              `json:"scopeConfigId" mapstructure:"scopeConfigId,omitempty"`
 	ScopeConfigs  *ZentaoScopeConfigs `json:"scopeConfig" mapstructure:"scopeConfig,omitempty"`
+	// RetryOnEmptyPage requests a page once more when it returns no item while the reported total says otherwise,
+	// some Zentao endpoints return an empty list intermittently
+	RetryOnEmptyPage bool `json:"retryOnEmptyPage" mapstructure:"retryOnEmptyPage,omitempty"`
+	// IgnoreNestedTasks skips the tasks nested in `children` of a task, for API versions returning them as
+	// top-level rows as well
+	IgnoreNestedTasks bool `json:"ignoreNestedTasks" mapstructure:"ignoreNestedTasks,omitempty"`
+	// TaskAttribution is the chain of people a task is attributed to, the first one set wins, among
+	// `assignedTo`, `finishedBy` and `openedBy`, defaults to `assignedTo` only
+	TaskAttribution []string `json:"taskAttribution" mapstructure:"taskAttribution,omitempty"`
+	// StatusAliases maps the status variants of the instance to canonical Zentao statuses, on top of the built-in
+	// ones, so that status mappings can be shared across instances
+	StatusAliases map[string]string `json:"statusAliases" mapstructure:"statusAliases,omitempty"`
+	// BoardGrouping is the Zentao entity becoming the domain board, among `project`, `product` and `execution`,
+	// defaults to `project`, see board_grouping.go for its effect on metrics
+	BoardGrouping string `json:"boardGrouping" mapstructure:"boardGrouping,omitempty"`
+	// CycleStart is the chain of fields the cycle of a task starts at, the first one set wins, among
+	// `realStarted`, `estStarted` and `openedDate`, defaults to all of them in that order
+	CycleStart []string `json:"cycleStart" mapstructure:"cycleStart,omitempty"`
+	// ExtractTaskActions extracts the actions of tasks collected along with their commits into changelogs, giving
+	// the assignee and status history of tasks to the instances without RemoteDb access
+	ExtractTaskActions bool `json:"extractTaskActions" mapstructure:"extractTaskActions,omitempty"`
 }
 
 func (o *ZentaoOptions) GetParams() any {
@@ -55,10 +77,12 @@ type TypeMappings map[string]string
 type StatusMappings map[string]string
 
 type ZentaoScopeConfigs struct {
-	TypeMappings        TypeMappings   `json:"typeMappings"`
-	BugStatusMappings   StatusMappings `json:"bugStatusMappings"`
-	StoryStatusMappings StatusMappings `json:"storyStatusMappings"`
-	TaskStatusMappings  StatusMappings `json:"taskStatusMappings"`
+	TypeMappings        TypeMappings         `json:"typeMappings"`
+	BugStatusMappings   StatusMappings       `json:"bugStatusMappings"`
+	StoryStatusMappings StatusMappings       `json:"storyStatusMappings"`
+	TaskStatusMappings  StatusMappings       `json:"taskStatusMappings"`
+	FieldMappings       helper.FieldMappings `json:"fieldMappings"`
+	TaskKeyTemplate     string               `json:"taskKeyTemplate"`
 }
 
 func MakeScopeConfigs(rule models.ZentaoScopeConfig) (*ZentaoScopeConfigs, errors.Error) {
@@ -66,6 +90,7 @@ func MakeScopeConfigs(rule models.ZentaoScopeConfig) (*ZentaoScopeConfigs, error
 	var storyStatusMapping StatusMappings
 	var taskStatusMapping StatusMappings
 	var typeMapping TypeMappings
+	var fieldMappings helper.FieldMappings
 	var err error
 	if len(rule.TypeMappings) > 0 {
 		err = json.Unmarshal(rule.TypeMappings, &typeMapping)
@@ -91,11 +116,25 @@ func MakeScopeConfigs(rule models.ZentaoScopeConfig) (*ZentaoScopeConfigs, error
 			return nil, errors.Default.Wrap(err, "unable to unmarshal the statusMapping")
 		}
 	}
+	if len(rule.FieldMappings) > 0 {
+		err = json.Unmarshal(rule.FieldMappings, &fieldMappings)
+		if err != nil {
+			return nil, errors.Default.Wrap(err, "unable to unmarshal the fieldMappings")
+		}
+		if err := fieldMappings.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	if err := validateTaskKeyTemplate(rule.TaskKeyTemplate); err != nil {
+		return nil, err
+	}
 	result := &ZentaoScopeConfigs{
 		TypeMappings:        typeMapping,
 		BugStatusMappings:   bugStatusMapping,
 		StoryStatusMappings: storyStatusMapping,
 		TaskStatusMappings:  taskStatusMapping,
+		FieldMappings:       fieldMappings,
+		TaskKeyTemplate:     rule.TaskKeyTemplate,
 	}
 	return result, nil
 }
@@ -111,6 +150,8 @@ type ZentaoTaskData struct {
 	Bugs         map[int64]struct{}
 	AccountCache *AccountCache
 	ApiClient    *helper.ApiAsyncClient
+	// Location is the timezone of the connection
+	Location *time.Location
 }
 
 func DecodeAndValidateTaskOptions(options map[string]interface{}) (*ZentaoOptions, error) {
@@ -126,6 +167,27 @@ func DecodeAndValidateTaskOptions(options map[string]interface{}) (*ZentaoOption
 	if op.ProjectId == 0 {
 		return nil, fmt.Errorf("please set projectId")
 	}
+	for _, source := range op.TaskAttribution {
+		if !slices.Contains(taskAttributionSources, source) {
+			return nil, fmt.Errorf("invalid taskAttribution %s, must be one of %v", source, taskAttributionSources)
+		}
+	}
+	for variant, status := range op.StatusAliases {
+		if !slices.Contains(zentaoStatuses, status) {
+			return nil, fmt.Errorf("invalid statusAliases %s: %s, must be one of %v", variant, status, zentaoStatuses)
+		}
+	}
+	for _, field := range op.CycleStart {
+		if !slices.Contains(cycleStartFields, field) {
+			return nil, fmt.Errorf("invalid cycleStart %s, must be one of %v", field, cycleStartFields)
+		}
+	}
+	if op.BoardGrouping == "" {
+		op.BoardGrouping = BoardGroupingProject
+	}
+	if !slices.Contains(boardGroupings, op.BoardGrouping) {
+		return nil, fmt.Errorf("invalid boardGrouping %s, must be one of %v", op.BoardGrouping, boardGroupings)
+	}
 	return &op, nil
 }
 
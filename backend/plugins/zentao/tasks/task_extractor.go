@@ -20,6 +20,7 @@ package tasks
 import (
 	"encoding/json"
 	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/log"
 	"github.com/apache/incubator-devlake/core/models/domainlayer/ticket"
 	"github.com/apache/incubator-devlake/core/plugin"
 	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
@@ -38,7 +39,8 @@ var ExtractTaskMeta = plugin.SubTaskMeta{
 
 func ExtractTask(taskCtx plugin.SubTaskContext) errors.Error {
 	data := taskCtx.GetData().(*ZentaoTaskData)
-	et := newTaskExtractor(data)
+	logger := taskCtx.GetLogger()
+	et := newTaskExtractor(data, logger)
 
 	extractor, err := api.NewApiExtractor(api.ApiExtractorArgs{
 		RawDataSubTaskArgs: api.RawDataSubTaskArgs{
@@ -53,14 +55,20 @@ func ExtractTask(taskCtx plugin.SubTaskContext) errors.Error {
 				return nil, errors.Default.WrapRaw(err)
 			}
 
-			data.Tasks[res.Id] = struct{}{}
-			for _, t := range res.Children {
-				data.Tasks[t.Id] = struct{}{}
+			// the fields mapped by the scope config are read out of the raw task, nested children included
+			var fields map[string]interface{}
+			if len(et.fieldMappings) > 0 {
+				err = json.Unmarshal(row.Data, &fields)
+				if err != nil {
+					return nil, errors.Default.WrapRaw(err)
+				}
 			}
 			var tasks []*models.ZentaoTask
-			et.toZentaoTasks(data.AccountCache, res, row.Url, &tasks)
+			et.toZentaoTasks(data.AccountCache, res, fields, row.Url, &tasks, map[int64]struct{}{})
+			et.flattened += len(tasks) - 1
 			var results []interface{}
 			for _, task := range tasks {
+				data.Tasks[task.ID] = struct{}{}
 				results = append(results, task)
 			}
 			return results, nil
@@ -71,23 +79,46 @@ func ExtractTask(taskCtx plugin.SubTaskContext) errors.Error {
 		return err
 	}
 
-	return extractor.Execute()
+	err = extractor.Execute()
+	if err != nil {
+		return err
+	}
+	if et.flattenChildren {
+		logger.Info("flattened %d nested tasks", et.flattened)
+	}
+	return nil
 }
 
 type taskExtractor struct {
 	connectionId    uint64
 	statusMappings  map[string]string
+	normalizer      *statusNormalizer
 	stdTypeMappings map[string]string
+	fieldMappings   api.FieldMappings
+	flattenChildren bool
+	// number of nested tasks persisted from `Children`
+	flattened int
 }
 
-func newTaskExtractor(data *ZentaoTaskData) *taskExtractor {
+func newTaskExtractor(data *ZentaoTaskData, logger log.Logger) *taskExtractor {
 	return &taskExtractor{
 		connectionId:    data.Options.ConnectionId,
 		statusMappings:  getTaskStatusMapping(data),
+		normalizer:      newStatusNormalizer(data.Options.StatusAliases, logger),
 		stdTypeMappings: getStdTypeMappings(data),
+		fieldMappings:   getFieldMappings(data),
+		flattenChildren: !data.Options.IgnoreNestedTasks,
 	}
 }
-func (c *taskExtractor) toZentaoTasks(accountCache *AccountCache, res *models.ZentaoTaskRes, url string, tasks *[]*models.ZentaoTask) {
+
+// toZentaoTasks converts the task and, unless disabled, its nested children recursively, visited guards against
+// cycles and children listed more than once. fields are the raw fields of the task, nil unless the scope config maps
+// some of them
+func (c *taskExtractor) toZentaoTasks(accountCache *AccountCache, res *models.ZentaoTaskRes, fields map[string]interface{}, url string, tasks *[]*models.ZentaoTask, visited map[int64]struct{}) {
+	if _, ok := visited[res.Id]; ok {
+		return
+	}
+	visited[res.Id] = struct{}{}
 	task := &models.ZentaoTask{
 		ConnectionId:       c.connectionId,
 		ID:                 res.Id,
@@ -110,7 +141,7 @@ func (c *taskExtractor) toZentaoTasks(accountCache *AccountCache, res *models.Ze
 		Consumed:           res.Consumed,
 		Left:               res.Left,
 		Deadline:           res.Deadline,
-		Status:             res.Status,
+		Status:             c.normalizer.normalize(res.Status),
 		SubStatus:          res.SubStatus,
 		Color:              res.Color,
 		Description:        res.Description,
@@ -124,6 +155,7 @@ func (c *taskExtractor) toZentaoTasks(accountCache *AccountCache, res *models.Ze
 		EstStarted:         res.EstStarted,
 		RealStarted:        res.RealStarted,
 		FinishedId:         accountCache.getAccountIDFromApiAccount(res.FinishedBy),
+		FinishedByName:     accountCache.getAccountNameFromApiAccount(res.FinishedBy),
 		FinishedDate:       res.FinishedDate,
 		FinishedList:       res.FinishedList,
 		CanceledId:         accountCache.getAccountIDFromApiAccount(res.CanceledBy),
@@ -153,22 +185,45 @@ func (c *taskExtractor) toZentaoTasks(accountCache *AccountCache, res *models.Ze
 		Progress:           res.Progress,
 		Url:                url,
 	}
+	// Zentao reports tasks ahead of their deadline with a negative delay
+	if res.Delay > 0 {
+		task.Delay = res.Delay
+	}
 
 	task.StdType = c.stdTypeMappings[task.Type]
 	if task.StdType == "" {
 		task.StdType = ticket.TASK
 	}
-	if len(c.statusMappings) != 0 {
-		task.StdStatus = c.statusMappings[task.Status]
-	} else {
-		task.StdStatus = ticket.GetStatus(&ticket.StatusRule{
-			Done:    []string{"done", "closed", "cancel"},
-			Todo:    []string{"wait"},
-			Default: ticket.IN_PROGRESS,
-		}, task.Status)
-	}
+	task.StdStatus = getTaskStdStatus(c.statusMappings, task.Status)
+	task.ZentaoMappedFields = resolveMappedFields(c.fieldMappings, fields)
 	*tasks = append(*tasks, task)
-	for _, child := range res.Children {
-		c.toZentaoTasks(accountCache, child, url, tasks)
+	if !c.flattenChildren {
+		return
+	}
+	childrenFields, _ := fields["children"].([]interface{})
+	for i, child := range res.Children {
+		if child == nil {
+			continue
+		}
+		if child.Parent == 0 {
+			child.Parent = res.Id
+		}
+		var childFields map[string]interface{}
+		if i < len(childrenFields) {
+			childFields, _ = childrenFields[i].(map[string]interface{})
+		}
+		c.toZentaoTasks(accountCache, child, childFields, url, tasks, visited)
+	}
+}
+
+// getTaskStdStatus maps the status of a task following the status mappings, or the built-in rule without any
+func getTaskStdStatus(statusMappings map[string]string, status string) string {
+	if len(statusMappings) != 0 {
+		return statusMappings[status]
 	}
+	return ticket.GetStatus(&ticket.StatusRule{
+		Done:    []string{"done", "closed", "cancel"},
+		Todo:    []string{"wait"},
+		Default: ticket.IN_PROGRESS,
+	}, status)
 }
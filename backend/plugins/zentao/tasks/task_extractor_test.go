@@ -0,0 +1,117 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/zentao/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToZentaoTasks(t *testing.T) {
+	// a parent task whose child has a child of its own, then the parent and the child listed once more
+	res := &models.ZentaoTaskRes{}
+	assert.Nil(t, json.Unmarshal([]byte(`{
+		"id": 1, "status": "doing",
+		"children": [
+			{"id": 2, "status": "wait", "children": [
+				{"id": 3, "parent": 2, "status": "done"},
+				{"id": 1, "status": "doing"}
+			]},
+			{"id": 2, "status": "wait"},
+			null
+		]
+	}`), res))
+	extract := func(ignoreNestedTasks bool) []*models.ZentaoTask {
+		et := newTaskExtractor(&ZentaoTaskData{
+			Options: &ZentaoOptions{ConnectionId: 1, IgnoreNestedTasks: ignoreNestedTasks},
+		}, nil)
+		var tasks []*models.ZentaoTask
+		et.toZentaoTasks(&AccountCache{}, res, nil, "http://zentao/task", &tasks, map[int64]struct{}{})
+		return tasks
+	}
+
+	tasks := extract(false)
+	if assert.Len(t, tasks, 3) {
+		for i, parent := range []int64{0, 1, 2} {
+			assert.Equal(t, int64(i+1), tasks[i].ID)
+			assert.Equal(t, parent, tasks[i].Parent)
+			assert.Equal(t, uint64(1), tasks[i].ConnectionId)
+			assert.Equal(t, "http://zentao/task", tasks[i].Url)
+		}
+		assert.Equal(t, "DONE", tasks[2].StdStatus)
+	}
+
+	tasks = extract(true)
+	if assert.Len(t, tasks, 1) {
+		assert.Equal(t, int64(1), tasks[0].ID)
+	}
+}
+
+func TestToZentaoTasksMappedFields(t *testing.T) {
+	raw := []byte(`{
+		"id": 1, "status": "doing", "points": "3", "begin": "2023-08-01", "team": {"id": 7, "name": "Platform"},
+		"children": [
+			null,
+			{"id": 2, "status": "wait", "points": 5}
+		]
+	}`)
+	res := &models.ZentaoTaskRes{}
+	assert.Nil(t, json.Unmarshal(raw, res))
+	var fields map[string]interface{}
+	assert.Nil(t, json.Unmarshal(raw, &fields))
+	et := newTaskExtractor(&ZentaoTaskData{
+		Options: &ZentaoOptions{
+			ConnectionId: 1,
+			ScopeConfigs: &ZentaoScopeConfigs{FieldMappings: api.FieldMappings{
+				api.FieldMappingStoryPoint: "points",
+				api.FieldMappingStartDate:  "begin",
+				api.FieldMappingTeamId:     "team.name",
+			}},
+		},
+	}, nil)
+	var tasks []*models.ZentaoTask
+	et.toZentaoTasks(&AccountCache{}, res, fields, "http://zentao/task", &tasks, map[int64]struct{}{})
+	if assert.Len(t, tasks, 2) {
+		assert.Equal(t, 3.0, tasks[0].StoryPoint)
+		if assert.NotNil(t, tasks[0].StartDate) {
+			assert.Equal(t, "2023-08-01", tasks[0].StartDate.Format("2006-01-02"))
+		}
+		assert.Equal(t, "Platform", tasks[0].TeamId)
+		// children are read out of their own fields
+		assert.Equal(t, 5.0, tasks[1].StoryPoint)
+		assert.Nil(t, tasks[1].StartDate)
+		assert.Equal(t, "", tasks[1].TeamId)
+	}
+}
+
+func TestParseMappedFields(t *testing.T) {
+	mapped, err := parseMappedFields(nil, []byte(`not parsed without mappings`))
+	assert.Nil(t, err)
+	assert.Equal(t, models.ZentaoMappedFields{}, mapped)
+
+	mapped, err = parseMappedFields(api.FieldMappings{api.FieldMappingStoryPoint: "estimate"}, []byte(`{"estimate": 2.5}`))
+	assert.Nil(t, err)
+	assert.Equal(t, 2.5, mapped.StoryPoint)
+
+	_, err = parseMappedFields(api.FieldMappings{api.FieldMappingStoryPoint: "estimate"}, []byte(`{`))
+	assert.NotNil(t, err)
+}
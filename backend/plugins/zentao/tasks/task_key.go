@@ -0,0 +1,51 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/plugins/zentao/models"
+)
+
+// task key templates may refer to the following placeholders, `{id}` is mandatory so that keys stay unique
+const (
+	taskKeyId        = "{id}"
+	taskKeyProject   = "{project}"
+	taskKeyExecution = "{execution}"
+	taskKeyStory     = "{story}"
+)
+
+func validateTaskKeyTemplate(template string) errors.Error {
+	if template != "" && !strings.Contains(template, taskKeyId) {
+		return errors.BadInput.New("taskKeyTemplate must contain " + taskKeyId)
+	}
+	return nil
+}
+
+// getTaskKey renders the key of the task out of the template, the numeric id of the task is kept as the original key
+func getTaskKey(template string, task *models.ZentaoTask) string {
+	return strings.NewReplacer(
+		taskKeyId, strconv.FormatInt(task.ID, 10),
+		taskKeyProject, strconv.FormatInt(task.Project, 10),
+		taskKeyExecution, strconv.FormatInt(task.Execution, 10),
+		taskKeyStory, strconv.FormatInt(task.Story, 10),
+	).Replace(template)
+}
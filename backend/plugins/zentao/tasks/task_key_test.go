@@ -0,0 +1,58 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+
+	"github.com/apache/incubator-devlake/plugins/zentao/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTaskKeyTemplate(t *testing.T) {
+	assert.Nil(t, validateTaskKeyTemplate(""))
+	assert.Nil(t, validateTaskKeyTemplate("{id}"))
+	assert.Nil(t, validateTaskKeyTemplate("T-{project}-{id}"))
+	assert.NotNil(t, validateTaskKeyTemplate("T-{project}"))
+	assert.NotNil(t, validateTaskKeyTemplate("T-{ID}"))
+	assert.NotNil(t, validateTaskKeyTemplate("T-id"))
+}
+
+func TestGetTaskKey(t *testing.T) {
+	task := &models.ZentaoTask{ID: 42, Project: 3, Execution: 7, Story: 15}
+	tests := []struct {
+		name     string
+		template string
+		want     string
+	}{
+		{name: "id only", template: "{id}", want: "42"},
+		{name: "prefixed id", template: "TASK-{id}", want: "TASK-42"},
+		{name: "project and id", template: "P{project}-{id}", want: "P3-42"},
+		{name: "every placeholder", template: "{project}/{execution}/{story}/{id}", want: "3/7/15/42"},
+		{name: "repeated placeholder", template: "{id}-{id}", want: "42-42"},
+		{name: "unknown placeholder kept", template: "{module}-{id}", want: "{module}-42"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, getTaskKey(tt.template, task))
+		})
+	}
+
+	// tasks without story nor execution render zeros
+	assert.Equal(t, "0-0-1", getTaskKey("{execution}-{story}-{id}", &models.ZentaoTask{ID: 1}))
+}
@@ -0,0 +1,105 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"time"
+
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/plugin"
+	"github.com/apache/incubator-devlake/helpers/pluginhelper/api"
+	"github.com/apache/incubator-devlake/plugins/zentao/models"
+)
+
+var _ plugin.SubTaskEntryPoint = ConvertTaskOverdue
+
+var ConvertTaskOverdueMeta = plugin.SubTaskMeta{
+	Name:             "convertTaskOverdue",
+	EntryPoint:       ConvertTaskOverdue,
+	EnabledByDefault: true,
+	Description:      "compute Overdue and DaysOverdue of Zentao tasks with a deadline",
+	DomainTypes:      []string{plugin.DOMAIN_TYPE_TICKET},
+}
+
+func ConvertTaskOverdue(taskCtx plugin.SubTaskContext) errors.Error {
+	data := taskCtx.GetData().(*ZentaoTaskData)
+	db := taskCtx.GetDal()
+	var tasks []*models.ZentaoTask
+	err := db.All(&tasks,
+		dal.Select("id, deadline, finished_date, closed_date, canceled_date, overdue, days_overdue"),
+		dal.From(&models.ZentaoTask{}),
+		dal.Where("project = ? AND connection_id = ?", data.Options.ProjectId, data.Options.ConnectionId),
+	)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	updater := api.NewBatchUpdater(db, &models.ZentaoTask{}, "id", dal.Where("connection_id = ?", data.Options.ConnectionId))
+	for _, task := range tasks {
+		overdue, days := getDaysOverdue(task.Deadline, getOverdueEnd(task, now), data.Location)
+		if overdue == task.Overdue && days == task.DaysOverdue {
+			continue
+		}
+		err = updater.Add(task.ID,
+			dal.DalSet{ColumnName: "overdue", Value: overdue},
+			dal.DalSet{ColumnName: "days_overdue", Value: days},
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return updater.Flush()
+}
+
+// getOverdueEnd returns the time a task stopped running late, closed, finished and canceled tasks are frozen at the
+// time they were closed, finished, or canceled
+func getOverdueEnd(task *models.ZentaoTask, now time.Time) time.Time {
+	if end := task.ClosedDate.ToNullableTime(); end != nil {
+		return *end
+	}
+	if end := task.FinishedDate.ToNullableTime(); end != nil {
+		return *end
+	}
+	if end := task.CanceledDate.ToNullableTime(); end != nil {
+		return *end
+	}
+	return now
+}
+
+// getDaysOverdue tells whether `end` falls after the date-only deadline in the given location, and by how many
+// calendar days. Empty and `0000-00-00` deadlines mean no deadline.
+func getDaysOverdue(deadline string, end time.Time, loc *time.Location) (bool, int) {
+	if len(deadline) < 10 || deadline[:10] == "0000-00-00" {
+		return false, 0
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	due, err := time.ParseInLocation("2006-01-02", deadline[:10], loc)
+	if err != nil {
+		return false, 0
+	}
+	end = end.In(loc)
+	endDay := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, loc)
+	days := int(endDay.Sub(due).Hours()+12) / 24
+	if days <= 0 {
+		return false, 0
+	}
+	return true, days
+}
@@ -0,0 +1,79 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apache/incubator-devlake/plugins/zentao/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDaysOverdue(t *testing.T) {
+	now := time.Date(2023, 8, 10, 20, 0, 0, 0, time.UTC)
+	shanghai := time.FixedZone("CST", 8*60*60)
+	newYork := time.FixedZone("EDT", -4*60*60)
+	tests := []struct {
+		name         string
+		deadline     string
+		closedDate   string
+		finishedDate string
+		canceledDate string
+		loc          *time.Location
+		wantOverdue  bool
+		wantDays     int
+	}{
+		{name: "no deadline", deadline: "", wantOverdue: false, wantDays: 0},
+		{name: "zero deadline", deadline: "0000-00-00", wantOverdue: false, wantDays: 0},
+		{name: "zero deadline with time", deadline: "0000-00-00 00:00:00", wantOverdue: false, wantDays: 0},
+		{name: "invalid deadline", deadline: "someday...", wantOverdue: false, wantDays: 0},
+		{name: "due later", deadline: "2023-08-20", wantOverdue: false, wantDays: 0},
+		{name: "due today", deadline: "2023-08-10", wantOverdue: false, wantDays: 0},
+		{name: "running late", deadline: "2023-08-07", wantOverdue: true, wantDays: 3},
+		{name: "deadline with time", deadline: "2023-08-07 00:00:00", wantOverdue: true, wantDays: 3},
+		// 2023-08-10 20:00 UTC is already the 11th in Shanghai, and still the 10th in New York
+		{name: "next calendar day in the location", deadline: "2023-08-10", loc: shanghai, wantOverdue: true, wantDays: 1},
+		{name: "same calendar day in the location", deadline: "2023-08-10", loc: newYork, wantOverdue: false, wantDays: 0},
+		{name: "earlier calendar day in the location", deadline: "2023-08-09", loc: newYork, wantOverdue: true, wantDays: 1},
+		{name: "closed late", deadline: "2023-08-01", closedDate: "2023-08-03T10:00:00Z", wantOverdue: true, wantDays: 2},
+		{name: "closed in time", deadline: "2023-08-05", closedDate: "2023-08-03T10:00:00Z", wantOverdue: false, wantDays: 0},
+		{name: "canceled late", deadline: "2023-08-01", canceledDate: "2023-08-04T10:00:00Z", wantOverdue: true, wantDays: 3},
+		{name: "finished late", deadline: "2023-08-01", finishedDate: "2023-08-05T10:00:00Z", wantOverdue: true, wantDays: 4},
+		{name: "finished in time", deadline: "2023-08-05", finishedDate: "2023-08-03T10:00:00Z", wantOverdue: false, wantDays: 0},
+		{name: "closed after being finished", deadline: "2023-08-01", finishedDate: "2023-08-05T10:00:00Z", closedDate: "2023-08-08T10:00:00Z", wantOverdue: true, wantDays: 7},
+		{name: "closed after being canceled", deadline: "2023-08-01", canceledDate: "2023-08-04T10:00:00Z", closedDate: "2023-08-06T10:00:00Z", wantOverdue: true, wantDays: 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &models.ZentaoTask{Deadline: tt.deadline}
+			if tt.closedDate != "" {
+				task.ClosedDate = zentaoTime(t, tt.closedDate)
+			}
+			if tt.finishedDate != "" {
+				task.FinishedDate = zentaoTime(t, tt.finishedDate)
+			}
+			if tt.canceledDate != "" {
+				task.CanceledDate = zentaoTime(t, tt.canceledDate)
+			}
+			overdue, days := getDaysOverdue(task.Deadline, getOverdueEnd(task, now), tt.loc)
+			assert.Equal(t, tt.wantOverdue, overdue)
+			assert.Equal(t, tt.wantDays, days)
+		})
+	}
+}
@@ -158,12 +158,20 @@ class DomainScope(DomainModel):
     pass
 
 
+# Namespaces the domain ids of a deployment shared by several tenants, see didgen.TenantPrefixEnvStr
+TENANT_PREFIX_ENV = 'DOMAIN_ID_TENANT_PREFIX'
+
+
 def domain_id(model_type, connection_id, *args):
     """
     Generate an identifier for domain entities
     originates from a model of type model_type.
+    The tenant prefix set by DevLake is prepended, as the Go plugins do.
     """
     segments = [_get_plugin_name(model_type), model_type.__name__, str(connection_id)]
+    tenant_prefix = os.environ.get(TENANT_PREFIX_ENV)
+    if tenant_prefix:
+        segments.insert(0, tenant_prefix)
     segments.extend(str(arg) for arg in args)
     return ':'.join(segments)
 
@@ -0,0 +1,30 @@
+# Licensed to the Apache Software Foundation (ASF) under one or more
+# contributor license agreements.  See the NOTICE file distributed with
+# this work for additional information regarding copyright ownership.
+# The ASF licenses this file to You under the Apache License, Version 2.0
+# (the "License"); you may not use this file except in compliance with
+# the License.  You may obtain a copy of the License at
+
+#     http://www.apache.org/licenses/LICENSE-2.0
+
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+from pydevlake.model import domain_id, TENANT_PREFIX_ENV
+
+
+class Repo:
+    pass
+
+
+def test_domain_id(monkeypatch):
+    monkeypatch.delenv(TENANT_PREFIX_ENV, raising=False)
+    assert domain_id(Repo, 1, 'abc') == 'tests:Repo:1:abc'
+
+
+def test_domain_id_with_tenant_prefix(monkeypatch):
+    monkeypatch.setenv(TENANT_PREFIX_ENV, 'tenant-a')
+    assert domain_id(Repo, 1, 'abc') == 'tenant-a:tests:Repo:1:abc'
@@ -0,0 +1,64 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package checkpoint
+
+import (
+	"net/http"
+
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/server/api/shared"
+	"github.com/apache/incubator-devlake/server/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Index returns the checkpoints of interrupted conversions
+// @Summary Get convertor checkpoints
+// @Description Get the checkpoints left by interrupted conversions, the next run of the subtask resumes from them
+// @Tags framework/checkpoints
+// @Param subtask query string false "subtask name"
+// @Success 200  {object} []models.ConvertorCheckpoint
+// @Failure 500  {object} shared.ApiBody "Internal Error"
+// @Router /convertor-checkpoints [get]
+func Index(c *gin.Context) {
+	checkpoints, err := services.GetConvertorCheckpoints(c.Query("subtask"))
+	if err != nil {
+		shared.ApiOutputError(c, errors.Default.Wrap(err, "error getting convertor checkpoints"))
+		return
+	}
+	shared.ApiOutputSuccess(c, checkpoints, http.StatusOK)
+}
+
+// Delete resets checkpoints to force a full reconversion
+// @Summary Delete convertor checkpoints
+// @Description Reset the checkpoints of a subtask, of a single scope if rawDataParams is given, to force a full reconversion
+// @Tags framework/checkpoints
+// @Param subtask query string true "subtask name"
+// @Param rawDataParams query string false "raw data params of the scope"
+// @Success 200
+// @Failure 400  {object} shared.ApiBody "Bad Request"
+// @Failure 500  {object} shared.ApiBody "Internal Error"
+// @Router /convertor-checkpoints [delete]
+func Delete(c *gin.Context) {
+	err := services.DeleteConvertorCheckpoints(c.Query("subtask"), c.Query("rawDataParams"))
+	if err != nil {
+		shared.ApiOutputError(c, errors.Default.Wrap(err, "error deleting convertor checkpoints"))
+		return
+	}
+	shared.ApiOutputSuccess(c, nil, http.StatusOK)
+}
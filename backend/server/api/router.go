@@ -26,6 +26,7 @@ import (
 
 	"github.com/apache/incubator-devlake/core/plugin"
 	"github.com/apache/incubator-devlake/server/api/blueprints"
+	"github.com/apache/incubator-devlake/server/api/checkpoint"
 	"github.com/apache/incubator-devlake/server/api/domainlayer"
 	"github.com/apache/incubator-devlake/server/api/pipelines"
 	"github.com/apache/incubator-devlake/server/api/plugininfo"
@@ -61,6 +62,8 @@ func RegisterRouter(r *gin.Engine) {
 	//r.GET("/version", version.Get)
 	r.POST("/push/:tableName", push.Post)
 	r.GET("/domainlayer/repos", domainlayer.ReposIndex)
+	r.GET("/convertor-checkpoints", checkpoint.Index)
+	r.DELETE("/convertor-checkpoints", checkpoint.Delete)
 
 	// plugin api
 	r.GET("/plugininfo", plugininfo.Get)
@@ -0,0 +1,51 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package services
+
+import (
+	"github.com/apache/incubator-devlake/core/dal"
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models"
+)
+
+// GetConvertorCheckpoints returns the checkpoints left by interrupted conversions
+func GetConvertorCheckpoints(subtaskName string) ([]*models.ConvertorCheckpoint, errors.Error) {
+	var clauses []dal.Clause
+	if subtaskName != "" {
+		clauses = append(clauses, dal.Where("subtask_name = ?", subtaskName))
+	}
+	checkpoints := make([]*models.ConvertorCheckpoint, 0)
+	err := db.All(&checkpoints, clauses...)
+	if err != nil {
+		return nil, err
+	}
+	return checkpoints, nil
+}
+
+// DeleteConvertorCheckpoints removes the checkpoints of the subtask so that its next run converts everything again,
+// all scopes are reset unless rawDataParams is specified
+func DeleteConvertorCheckpoints(subtaskName string, rawDataParams string) errors.Error {
+	if subtaskName == "" {
+		return errors.BadInput.New("subtask is required")
+	}
+	clauses := []dal.Clause{dal.Where("subtask_name = ?", subtaskName)}
+	if rawDataParams != "" {
+		clauses = append(clauses, dal.Where("raw_data_params = ?", rawDataParams))
+	}
+	return db.Delete(&models.ConvertorCheckpoint{}, clauses...)
+}
@@ -0,0 +1,158 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package services
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/apache/incubator-devlake/core/errors"
+	"github.com/apache/incubator-devlake/core/models"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+// gcsEndpoint is the S3 compatible endpoint of Google Cloud Storage, it authenticates with HMAC keys
+const gcsEndpoint = "https://storage.googleapis.com"
+
+// exportPipelineLogs keeps the archive of the logs of a finished pipeline for audits, it is copied into the local
+// directory PIPELINE_LOGS_EXPORT_DIR and/or uploaded to PIPELINE_LOGS_EXPORT_BUCKET (s3://bucket/prefix or
+// gs://bucket/prefix). Secrets were already redacted when the logs were written.
+func exportPipelineLogs(pipeline *models.Pipeline) errors.Error {
+	exportDir := cfg.GetString("PIPELINE_LOGS_EXPORT_DIR")
+	exportBucket := cfg.GetString("PIPELINE_LOGS_EXPORT_BUCKET")
+	if exportDir == "" && exportBucket == "" {
+		return nil
+	}
+	archive, err := GetPipelineLogsArchivePath(pipeline)
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(filepath.Dir(archive))
+	// a pipeline may be rerun, every run gets its own archive
+	name := pipelineLogsExportName(pipeline, time.Now())
+	if exportDir != "" {
+		if e := os.MkdirAll(exportDir, 0777); e != nil {
+			return errors.Convert(e)
+		}
+		if err = copyFile(archive, filepath.Join(exportDir, name)); err != nil {
+			return err
+		}
+	}
+	if exportBucket != "" {
+		return uploadFile(archive, exportBucket, name)
+	}
+	return nil
+}
+
+func pipelineLogsExportName(pipeline *models.Pipeline, exportedAt time.Time) string {
+	return fmt.Sprintf("pipeline-%d-%s.tar.gz", pipeline.ID, exportedAt.UTC().Format("20060102-150405"))
+}
+
+// parseExportBucket splits s3://bucket/prefix into the bucket and the prefix, gs:// buckets are reached through the
+// S3 compatible endpoint of Google Cloud Storage
+func parseExportBucket(bucketUrl string) (endpoint string, bucket string, prefix string, err errors.Error) {
+	u, e := url.Parse(bucketUrl)
+	if e != nil {
+		return "", "", "", errors.BadInput.Wrap(e, fmt.Sprintf("invalid PIPELINE_LOGS_EXPORT_BUCKET %s", bucketUrl))
+	}
+	switch u.Scheme {
+	case "s3":
+	case "gs":
+		endpoint = gcsEndpoint
+	default:
+		return "", "", "", errors.BadInput.New(fmt.Sprintf("invalid PIPELINE_LOGS_EXPORT_BUCKET %s, it must start with s3:// or gs://", bucketUrl))
+	}
+	if u.Host == "" {
+		return "", "", "", errors.BadInput.New(fmt.Sprintf("invalid PIPELINE_LOGS_EXPORT_BUCKET %s, the bucket is missing", bucketUrl))
+	}
+	return endpoint, u.Host, strings.Trim(u.Path, "/"), nil
+}
+
+// uploadFile uploads src into the bucket, the credentials are looked up the AWS way (AWS_ACCESS_KEY_ID and
+// AWS_SECRET_ACCESS_KEY, shared credentials or instance role), they are the HMAC keys for Google Cloud Storage
+func uploadFile(src, bucketUrl, name string) errors.Error {
+	endpoint, bucket, prefix, err := parseExportBucket(bucketUrl)
+	if err != nil {
+		return err
+	}
+	awsConfig := aws.NewConfig()
+	if region := cfg.GetString("PIPELINE_LOGS_EXPORT_REGION"); region != "" {
+		awsConfig = awsConfig.WithRegion(region)
+	} else if endpoint == gcsEndpoint {
+		awsConfig = awsConfig.WithRegion("auto")
+	}
+	if e := cfg.GetString("PIPELINE_LOGS_EXPORT_ENDPOINT"); e != "" {
+		endpoint = e
+	}
+	if endpoint != "" {
+		awsConfig = awsConfig.WithEndpoint(endpoint).WithS3ForcePathStyle(true)
+	}
+	sess, e := session.NewSessionWithOptions(session.Options{
+		Config:            *awsConfig,
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if e != nil {
+		return errors.Default.Wrap(e, "failed to create the object storage session")
+	}
+	in, e := os.Open(src)
+	if e != nil {
+		return errors.Convert(e)
+	}
+	defer in.Close()
+	key := path.Join(prefix, name)
+	_, e = s3manager.NewUploader(sess).Upload(&s3manager.UploadInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		Body:   in,
+	})
+	if e != nil {
+		return errors.Default.Wrap(e, fmt.Sprintf("failed to upload %s to %s", key, bucketUrl))
+	}
+	return nil
+}
+
+func copyFile(src, dest string) errors.Error {
+	in, e := os.Open(src)
+	if e != nil {
+		return errors.Convert(e)
+	}
+	defer in.Close()
+	// write to a temporary file first so that a partial archive never shows up in the export directory
+	tmp := dest + ".tmp"
+	out, e := os.Create(tmp)
+	if e != nil {
+		return errors.Convert(e)
+	}
+	_, e = io.Copy(out, in)
+	if closeErr := out.Close(); e == nil {
+		e = closeErr
+	}
+	if e != nil {
+		_ = os.Remove(tmp)
+		return errors.Default.Wrap(e, fmt.Sprintf("failed to write %s", dest))
+	}
+	return errors.Convert(os.Rename(tmp, dest))
+}
@@ -0,0 +1,54 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apache/incubator-devlake/core/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPipelineLogsExportName(t *testing.T) {
+	pipeline := &models.Pipeline{}
+	pipeline.ID = 12
+	first := time.Date(2023, 9, 1, 10, 0, 0, 0, time.UTC)
+	assert.Equal(t, "pipeline-12-20230901-100000.tar.gz", pipelineLogsExportName(pipeline, first))
+	// a rerun of the pipeline doesn't overwrite the archive of the first run
+	assert.NotEqual(t, pipelineLogsExportName(pipeline, first), pipelineLogsExportName(pipeline, first.Add(time.Minute)))
+}
+
+func TestParseExportBucket(t *testing.T) {
+	endpoint, bucket, prefix, err := parseExportBucket("s3://audits/devlake/logs/")
+	assert.Nil(t, err)
+	assert.Equal(t, "", endpoint)
+	assert.Equal(t, "audits", bucket)
+	assert.Equal(t, "devlake/logs", prefix)
+
+	endpoint, bucket, prefix, err = parseExportBucket("gs://audits")
+	assert.Nil(t, err)
+	assert.Equal(t, gcsEndpoint, endpoint)
+	assert.Equal(t, "audits", bucket)
+	assert.Equal(t, "", prefix)
+
+	_, _, _, err = parseExportBucket("https://audits")
+	assert.NotNil(t, err)
+	_, _, _, err = parseExportBucket("s3:///logs")
+	assert.NotNil(t, err)
+}
@@ -140,6 +140,10 @@ func runPipeline(pipelineId uint64) errors.Error {
 		globalPipelineLog.Error(err, "update pipeline state failed")
 		return err
 	}
+	// keep a copy of the logs for audits, failing to do so doesn't fail the pipeline
+	if e := exportPipelineLogs(dbPipeline); e != nil {
+		globalPipelineLog.Error(e, "export logs of pipeline %d failed", pipelineId)
+	}
 	// notify external webhook
 	return NotifyExternal(pipelineId)
 }
@@ -25,6 +25,7 @@ import (
 
 	"github.com/apache/incubator-devlake/core/errors"
 	"github.com/apache/incubator-devlake/core/log"
+	"github.com/apache/incubator-devlake/core/models/domainlayer/didgen"
 	"github.com/apache/incubator-devlake/core/plugin"
 	"github.com/apache/incubator-devlake/core/utils"
 )
@@ -50,6 +51,12 @@ func NewCmdInvoker(execPath string) *CmdInvoker {
 	}
 }
 
+// remoteEnv hands the tenant prefix over to the remote plugin, it may come from the .env file rather than the
+// environment which utils.StreamProcess passes on
+func remoteEnv() []string {
+	return []string{fmt.Sprintf("%s=%s", didgen.TenantPrefixEnvStr, didgen.TenantPrefix())}
+}
+
 func (c *CmdInvoker) Call(methodName string, ctx plugin.ExecContext, args ...any) *CallResult {
 	serializedArgs, err := serialize(args...)
 	if err != nil {
@@ -63,6 +70,7 @@ func (c *CmdInvoker) Call(methodName string, ctx plugin.ExecContext, args ...any
 	if c.workingPath != "" {
 		cmd.Dir = c.workingPath
 	}
+	cmd.Env = remoteEnv()
 	response, err := utils.RunProcess(cmd, &utils.RunProcessOptions{
 		OnStdout: func(b []byte) {
 			msg := string(b)
@@ -103,6 +111,7 @@ func (c *CmdInvoker) Stream(methodName string, ctx plugin.ExecContext, args ...a
 	if c.workingPath != "" {
 		cmd.Dir = c.workingPath
 	}
+	cmd.Env = remoteEnv()
 	processHandle, err := utils.StreamProcess(cmd, &utils.StreamProcessOptions{
 		OnStdout: func(b []byte) {
 			msg := string(b)